@@ -17,8 +17,10 @@ import (
 
 	"github.com/glebarez/sqlite"
 	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -123,6 +125,13 @@ func openDatabase(cfg *config.AppConfig) (*gorm.DB, error) {
 			cfg.Database.Port)
 		return gorm.Open(postgres.Open(dsn), gormCfg)
 
+	case "mysql", "mariadb":
+		dsn, err := app.MysqlDSN(cfg.Database)
+		if err != nil {
+			return nil, err
+		}
+		return gorm.Open(mysql.Open(dsn), gormCfg)
+
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", dbType)
 	}
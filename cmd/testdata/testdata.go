@@ -19,6 +19,7 @@
 // Database support:
 //   - SQLite (pure Go, no CGO)
 //   - PostgreSQL
+//   - MySQL/MariaDB
 package main
 
 import (
@@ -35,6 +36,7 @@ import (
 	"github.com/talkincode/toughradius/v9/config"
 	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -207,7 +209,7 @@ func run(action string, opts commandOptions) error {
 }
 
 // openDatabase establishes a GORM database connection based on configuration.
-// Supports both SQLite (default) and PostgreSQL.
+// Supports SQLite (default), PostgreSQL and MySQL.
 //
 // Parameters:
 //   - cfg: Application configuration with database settings
@@ -225,6 +227,8 @@ func openDatabase(cfg *config.AppConfig) (*gorm.DB, error) {
 		return openSqliteDatabase(cfg)
 	case "postgres", "postgresql":
 		return openPostgresDatabase(cfg)
+	case "mysql", "mariadb":
+		return openMysqlDatabase(cfg)
 	default:
 		return nil, fmt.Errorf("unsupported database type %q", cfg.Database.Type)
 	}
@@ -320,6 +324,47 @@ func openPostgresDatabase(cfg *config.AppConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
+// openMysqlDatabase opens a MySQL or MariaDB database connection.
+//
+// Parameters:
+//   - cfg: Application configuration with MySQL credentials or DSN
+//
+// Returns:
+//   - *gorm.DB: MySQL database instance
+//   - error: DSN, connection or authentication error; nil on success
+//
+// Side effects:
+//   - Configures connection pool based on cfg.Database.IdleConn and MaxConn
+func openMysqlDatabase(cfg *config.AppConfig) (*gorm.DB, error) {
+	dbCfg := cfg.Database
+	dsn, err := app.MysqlDSN(dbCfg)
+	if err != nil {
+		return nil, err
+	}
+	gormCfg := &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+		SkipDefaultTransaction:                   true,
+		PrepareStmt:                              true,
+		NamingStrategy:                           schema.NamingStrategy{SingularTable: true},
+		Logger:                                   logger.Default.LogMode(selectLogLevel(dbCfg.Debug)),
+	}
+	db, err := gorm.Open(mysql.Open(dsn), gormCfg)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if dbCfg.IdleConn > 0 {
+		sqlDB.SetMaxIdleConns(dbCfg.IdleConn)
+	}
+	if dbCfg.MaxConn > 0 {
+		sqlDB.SetMaxOpenConns(dbCfg.MaxConn)
+	}
+	return db, nil
+}
+
 // selectLogLevel converts boolean debug flag to GORM log level.
 //
 // Parameters:
@@ -30,16 +30,23 @@ import (
 
 // DBConfig holds database connection settings for ToughRADIUS.
 //
-// Supports two database backends:
+// Supports three database backends:
 //   - postgres: Full-featured PostgreSQL (production recommended)
+//   - mysql: MySQL 5.7+/8.x or MariaDB 10.3+, e.g. a database shared with FreeRADIUS
 //   - sqlite: Embedded database for development/testing (no CGO required)
 //
 // Connection pooling is configured via MaxConn and IdleConn to optimize
 // performance under high concurrent load from RADIUS requests.
 //
-// When Type is "postgres", Host/Port/User/Passwd are required.
+// When Type is "postgres" or "mysql", Host/Port/User/Passwd are required,
+// unless DSN is set: it is then passed to the driver as is, e.g.
+// "user:pass@tcp(db:3306)/toughradius?parseTime=true&loc=Local" for MySQL.
 // When Type is "sqlite", only Name is used (database file path).
 //
+// TLS applies to MySQL: "true" verifies the server against the system roots
+// or TLSCA, "skip-verify" encrypts without verifying, "preferred" uses TLS
+// when the server offers it. TLSCert and TLSKey add a client certificate.
+//
 // Environment variable overrides:
 //   - TOUGHRADIUS_DB_TYPE
 //   - TOUGHRADIUS_DB_HOST
@@ -47,17 +54,34 @@ import (
 //   - TOUGHRADIUS_DB_NAME
 //   - TOUGHRADIUS_DB_USER
 //   - TOUGHRADIUS_DB_PWD
+//   - TOUGHRADIUS_DB_DSN
+//   - TOUGHRADIUS_DB_TLS
+//   - TOUGHRADIUS_DB_TLS_CA
+//   - TOUGHRADIUS_DB_TLS_CERT
+//   - TOUGHRADIUS_DB_TLS_KEY
 //   - TOUGHRADIUS_DB_DEBUG
+//   - TOUGHRADIUS_DB_CHANGE_POLL
+//
+// ChangePoll is needed when several instances share the database: each
+// instance then polls the changes made through the admin API of the others
+// and evicts its caches, instead of serving stale users, profiles and NAS
+// secrets until they expire.
 type DBConfig struct {
-	Type     string `yaml:"type"`      // Database type: postgres or sqlite
-	Host     string `yaml:"host"`      // PostgreSQL host address
-	Port     int    `yaml:"port"`      // PostgreSQL port
-	Name     string `yaml:"name"`      // Database name or SQLite file path
-	User     string `yaml:"user"`      // PostgreSQL username
-	Passwd   string `yaml:"passwd"`    // PostgreSQL password
-	MaxConn  int    `yaml:"max_conn"`  // Maximum connections
-	IdleConn int    `yaml:"idle_conn"` // Idle connections
-	Debug    bool   `yaml:"debug"`     // Debug mode
+	Type       string `yaml:"type"`        // Database type: postgres, mysql or sqlite
+	Host       string `yaml:"host"`        // PostgreSQL/MySQL host address
+	Port       int    `yaml:"port"`        // PostgreSQL/MySQL port
+	Name       string `yaml:"name"`        // Database name or SQLite file path
+	User       string `yaml:"user"`        // PostgreSQL/MySQL username
+	Passwd     string `yaml:"passwd"`      // PostgreSQL/MySQL password
+	DSN        string `yaml:"dsn"`         // Driver DSN replacing the fields above
+	TLS        string `yaml:"tls"`         // MySQL TLS mode: false, true, skip-verify or preferred
+	TLSCA      string `yaml:"tls_ca"`      // MySQL server CA certificate file
+	TLSCert    string `yaml:"tls_cert"`    // MySQL client certificate file
+	TLSKey     string `yaml:"tls_key"`     // MySQL client key file
+	MaxConn    int    `yaml:"max_conn"`    // Maximum connections
+	IdleConn   int    `yaml:"idle_conn"`   // Idle connections
+	Debug      bool   `yaml:"debug"`       // Debug mode
+	ChangePoll int    `yaml:"change_poll"` // Seconds between polls of the changes of other instances, 0 for a single instance
 }
 
 // SysConfig holds system-level settings for the ToughRADIUS application.
@@ -117,6 +141,9 @@ type WebConfig struct {
 // Enabled allows disabling the RADIUS services while keeping the web interface
 // running for configuration management.
 //
+// DictDir points to a FreeRADIUS dictionary directory loaded at startup instead
+// of the bundled share/ dictionaries. Relative paths resolve against System.Workdir.
+//
 // Environment variable overrides:
 //   - TOUGHRADIUS_RADIUS_ENABLED
 //   - TOUGHRADIUS_RADIUS_HOST
@@ -127,6 +154,7 @@ type WebConfig struct {
 //   - TOUGHRADIUS_RADIUS_RADSEC_CA_CERT
 //   - TOUGHRADIUS_RADIUS_RADSEC_CERT
 //   - TOUGHRADIUS_RADIUS_RADSEC_KEY
+//   - TOUGHRADIUS_RADIUS_DICT_DIR
 //   - TOUGHRADIUS_RADIUS_DEBUG
 type RadiusdConfig struct {
 	Enabled      bool   `yaml:"enabled" json:"enabled"`
@@ -138,6 +166,7 @@ type RadiusdConfig struct {
 	RadsecCaCert string `yaml:"radsec_ca_cert" json:"radsec_ca_cert"` // RadSec CA certificate path
 	RadsecCert   string `yaml:"radsec_cert" json:"radsec_cert"`       // RadSec server certificate path
 	RadsecKey    string `yaml:"radsec_key" json:"radsec_key"`         // RadSec server private key path
+	DictDir      string `yaml:"dict_dir" json:"dict_dir"`             // FreeRADIUS dictionary directory, empty uses the bundled set
 	Debug        bool   `yaml:"debug" json:"debug"`
 }
 
@@ -295,6 +324,18 @@ func (c *AppConfig) GetRadsecKeyPath() string {
 	return path.Join(c.System.Workdir, c.Radiusd.RadsecKey)
 }
 
+// GetDictDir returns the full path to the FreeRADIUS dictionary directory.
+//
+// Returns an empty string when no directory is configured, in which case the
+// dictionaries bundled with the binary are used. Relative paths are resolved
+// against System.Workdir.
+func (c *AppConfig) GetDictDir() string {
+	if c.Radiusd.DictDir == "" || path.IsAbs(c.Radiusd.DictDir) {
+		return c.Radiusd.DictDir
+	}
+	return path.Join(c.System.Workdir, c.Radiusd.DictDir)
+}
+
 // initDirs creates the required runtime directory structure.
 //
 // Called automatically by LoadConfig() to ensure all necessary directories
@@ -537,7 +578,13 @@ func LoadConfig(cfile string) *AppConfig {
 	setEnvValue("TOUGHRADIUS_DB_USER", &cfg.Database.User)
 	setEnvValue("TOUGHRADIUS_DB_PWD", &cfg.Database.Passwd)
 	setEnvIntValue("TOUGHRADIUS_DB_PORT", &cfg.Database.Port)
+	setEnvValue("TOUGHRADIUS_DB_DSN", &cfg.Database.DSN)
+	setEnvValue("TOUGHRADIUS_DB_TLS", &cfg.Database.TLS)
+	setEnvValue("TOUGHRADIUS_DB_TLS_CA", &cfg.Database.TLSCA)
+	setEnvValue("TOUGHRADIUS_DB_TLS_CERT", &cfg.Database.TLSCert)
+	setEnvValue("TOUGHRADIUS_DB_TLS_KEY", &cfg.Database.TLSKey)
 	setEnvBoolValue("TOUGHRADIUS_DB_DEBUG", &cfg.Database.Debug)
+	setEnvIntValue("TOUGHRADIUS_DB_CHANGE_POLL", &cfg.Database.ChangePoll)
 
 	// toughradius
 	setEnvValue("TOUGHRADIUS_RADIUS_HOST", &cfg.Radiusd.Host)
@@ -548,6 +595,7 @@ func LoadConfig(cfile string) *AppConfig {
 	setEnvValue("TOUGHRADIUS_RADIUS_RADSEC_CA_CERT", &cfg.Radiusd.RadsecCaCert)
 	setEnvValue("TOUGHRADIUS_RADIUS_RADSEC_CERT", &cfg.Radiusd.RadsecCert)
 	setEnvValue("TOUGHRADIUS_RADIUS_RADSEC_KEY", &cfg.Radiusd.RadsecKey)
+	setEnvValue("TOUGHRADIUS_RADIUS_DICT_DIR", &cfg.Radiusd.DictDir)
 	setEnvBoolValue("TOUGHRADIUS_RADIUS_DEBUG", &cfg.Radiusd.Debug)
 	setEnvBoolValue("TOUGHRADIUS_RADIUS_ENABLED", &cfg.Radiusd.Enabled)
 
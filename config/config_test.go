@@ -131,12 +131,18 @@ func TestAppConfigGetters(t *testing.T) {
 			getter:   cfg.GetRadsecKeyPath,
 			expected: "/test/workdir/private/radsec.tls.key",
 		},
+		{
+			name:     "GetDictDir",
+			getter:   cfg.GetDictDir,
+			expected: "/test/workdir/dictionary",
+		},
 	}
 
 	// Set the RadSec certificate paths (relative path)
 	cfg.Radiusd.RadsecCaCert = "private/ca.crt"
 	cfg.Radiusd.RadsecCert = "private/radsec.tls.crt"
 	cfg.Radiusd.RadsecKey = "private/radsec.tls.key"
+	cfg.Radiusd.DictDir = "dictionary"
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
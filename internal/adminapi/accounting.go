@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"gorm.io/gorm"
 )
 
 // ListAccounting retrieves the accounting logs table
@@ -63,32 +64,32 @@ func ListAccounting(c echo.Context) error {
 
 	// Filter by username (使用转义防止 LIKE 通配符注入)
 	if username := c.QueryParam("username"); username != "" {
-		query = query.Where("username LIKE ?", "%"+escapeLikePattern(username)+"%")
+		query = query.Where("username LIKE ?"+likeEscape(db), "%"+escapeLikePattern(username)+"%")
 	}
 
 	// Filter by NAS address
 	if nasAddr := c.QueryParam("nas_addr"); nasAddr != "" {
-		query = query.Where("nas_addr LIKE ?", "%"+escapeLikePattern(nasAddr)+"%")
+		query = query.Where("nas_addr LIKE ?"+likeEscape(db), "%"+escapeLikePattern(nasAddr)+"%")
 	}
 
 	// Filter by session ID
 	if sessionId := c.QueryParam("acct_session_id"); sessionId != "" {
-		query = query.Where("acct_session_id LIKE ?", "%"+escapeLikePattern(sessionId)+"%")
+		query = query.Where("acct_session_id LIKE ?"+likeEscape(db), "%"+escapeLikePattern(sessionId)+"%")
 	}
 
 	// Filter by framed IP address
 	if framedIp := c.QueryParam("framed_ipaddr"); framedIp != "" {
-		query = query.Where("framed_ipaddr LIKE ?", "%"+escapeLikePattern(framedIp)+"%")
+		query = query.Where("framed_ipaddr LIKE ?"+likeEscape(db), "%"+escapeLikePattern(framedIp)+"%")
 	}
 
 	// Filter by MAC address
 	if macAddr := c.QueryParam("mac_addr"); macAddr != "" {
-		query = query.Where("mac_addr LIKE ?", "%"+escapeLikePattern(macAddr)+"%")
+		query = query.Where("mac_addr LIKE ?"+likeEscape(db), "%"+escapeLikePattern(macAddr)+"%")
 	}
 
 	// Filter by IPv6 address
 	if framedIpv6 := c.QueryParam("framed_ipv6addr"); framedIpv6 != "" {
-		query = query.Where("framed_ipv6addr LIKE ?", "%"+escapeLikePattern(framedIpv6)+"%")
+		query = query.Where("framed_ipv6addr LIKE ?"+likeEscape(db), "%"+escapeLikePattern(framedIpv6)+"%")
 	}
 
 	// Filter by start time range (支持 RFC3339 和 datetime-local 两种格式)
@@ -159,6 +160,16 @@ func escapeLikePattern(s string) string {
 	return s
 }
 
+// likeEscape returns the ESCAPE clause the backslash-escaped LIKE patterns
+// need: PostgreSQL and MySQL escape with a backslash by default, SQLite only
+// when it is named
+func likeEscape(db *gorm.DB) string {
+	if db.Name() == "sqlite" { //nolint:staticcheck
+		return ` ESCAPE '\'`
+	}
+	return ""
+}
+
 // registerAccountingRoutes registers accounting routes
 func registerAccountingRoutes() {
 	webserver.ApiGET("/accounting", ListAccounting)
@@ -21,6 +21,12 @@ import (
 //   - Vouchers: Prepaid voucher management
 //   - Hotspot: Hotspot profile and user management
 //   - PPPoE: PPPoE profile and user management
+//   - Wallet: Prepaid wallet recharge, renewal and ledger
+//   - Dictionary: Runtime RADIUS dictionary vendors and attributes
+//   - Attributes: Custom reply/check attributes of profiles and users
+//   - Auth logs: Accepted and rejected logins with reject reasons, search and export
+//   - Simulate: Authorization dry runs with a per-stage trace
+//   - Debug sessions: Live packet streams of selected users, devices and NAS
 func Init(appCtx app.AppContext) {
         registerAuthRoutes()
         registerUserRoutes()
@@ -35,4 +41,15 @@ func Init(appCtx app.AppContext) {
         registerVoucherRoutes()
         registerHotspotRoutes()
         registerPppoeRoutes()
+        registerWalletRoutes()
+        registerTotpRoutes()
+        registerDeviceRoutes()
+        registerPolicyRoutes()
+        registerLockoutRoutes()
+        registerAuthLogRoutes()
+        registerSimulateRoutes()
+        registerDebugSessionRoutes()
+        registerDictionaryRoutes()
+        registerAttributeRoutes()
+        registerBackupRoutes()
 }
@@ -0,0 +1,238 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"gorm.io/gorm"
+	"layeh.com/radius"
+)
+
+// AttributeLists holds the custom reply and check attributes of a profile or user.
+type AttributeLists struct {
+	Reply []domain.AttributeItem `json:"reply"`
+	Check []domain.AttributeItem `json:"check"`
+}
+
+var errDictionaryNotLoaded = errors.New("RADIUS dictionary is not loaded")
+
+func registerAttributeRoutes() {
+	webserver.ApiGET("/radius-profiles/:id/attributes", getProfileAttributes)
+	webserver.ApiPUT("/radius-profiles/:id/attributes", updateProfileAttributes)
+	webserver.ApiDELETE("/radius-profiles/:id/attributes", deleteProfileAttributes)
+	webserver.ApiGET("/users/:id/attributes", getUserAttributes)
+	webserver.ApiPUT("/users/:id/attributes", updateUserAttributes)
+	webserver.ApiDELETE("/users/:id/attributes", deleteUserAttributes)
+}
+
+func getProfileAttributes(c echo.Context) error {
+	profile, err := findAttributeProfile(c)
+	if profile == nil {
+		return err
+	}
+	return attributeListsResponse(c, profile.ReplyAttrs, profile.CheckAttrs)
+}
+
+func updateProfileAttributes(c echo.Context) error {
+	profile, err := findAttributeProfile(c)
+	if profile == nil {
+		return err
+	}
+	reply, check, valid, err := bindAttributeLists(c)
+	if !valid {
+		return err
+	}
+	if err := GetDB(c).Model(profile).Updates(map[string]interface{}{"reply_attrs": reply, "check_attrs": check}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to update profile attributes", err.Error())
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeProfile, ID: profile.ID})
+	return attributeListsResponse(c, reply, check)
+}
+
+func deleteProfileAttributes(c echo.Context) error {
+	profile, err := findAttributeProfile(c)
+	if profile == nil {
+		return err
+	}
+	if err := GetDB(c).Model(profile).Updates(map[string]interface{}{"reply_attrs": "", "check_attrs": ""}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to clear profile attributes", err.Error())
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeProfile, ID: profile.ID})
+	return attributeListsResponse(c, "", "")
+}
+
+func getUserAttributes(c echo.Context) error {
+	user, err := findAttributeUser(c)
+	if user == nil {
+		return err
+	}
+	return attributeListsResponse(c, user.ReplyAttrs, user.CheckAttrs)
+}
+
+func updateUserAttributes(c echo.Context) error {
+	user, err := findAttributeUser(c)
+	if user == nil {
+		return err
+	}
+	reply, check, valid, err := bindAttributeLists(c)
+	if !valid {
+		return err
+	}
+	if err := GetDB(c).Model(user).Updates(map[string]interface{}{"reply_attrs": reply, "check_attrs": check}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to update user attributes", err.Error())
+	}
+	publishUserChange(c, user)
+	return attributeListsResponse(c, reply, check)
+}
+
+func deleteUserAttributes(c echo.Context) error {
+	user, err := findAttributeUser(c)
+	if user == nil {
+		return err
+	}
+	if err := GetDB(c).Model(user).Updates(map[string]interface{}{"reply_attrs": "", "check_attrs": ""}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to clear user attributes", err.Error())
+	}
+	publishUserChange(c, user)
+	return attributeListsResponse(c, "", "")
+}
+
+// findAttributeProfile loads the profile of the :id parameter. On failure it
+// writes the error response and returns a nil profile.
+func findAttributeProfile(c echo.Context) (*domain.RadiusProfile, error) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid profile ID", nil)
+	}
+	var profile domain.RadiusProfile
+	if err := GetDB(c).Where("id = ?", id).First(&profile).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fail(c, http.StatusNotFound, "NOT_FOUND", "Profile not found", nil)
+	} else if err != nil {
+		return nil, fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query profiles", err.Error())
+	}
+	return &profile, nil
+}
+
+// findAttributeUser loads the user of the :id parameter. On failure it writes
+// the error response and returns a nil user.
+func findAttributeUser(c echo.Context) (*domain.RadiusUser, error) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return nil, fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+	return &user, nil
+}
+
+// bindAttributeLists parses and validates the request body and returns the
+// JSON encoded reply and check lists to store. When the body is invalid it
+// writes the error response and reports false.
+func bindAttributeLists(c echo.Context) (string, string, bool, error) {
+	var req AttributeLists
+	if err := c.Bind(&req); err != nil {
+		return "", "", false, fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse request parameters", err.Error())
+	}
+	reply, err := encodeAttributeItems(req.Reply)
+	if err == nil {
+		err = validateReplyAttributes(reply)
+	}
+	if err != nil {
+		return "", "", false, attributeValidationFail(c, "reply", err)
+	}
+	check, err := encodeAttributeItems(req.Check)
+	if err == nil {
+		err = validateCheckAttributes(check)
+	}
+	if err != nil {
+		return "", "", false, attributeValidationFail(c, "check", err)
+	}
+	return reply, check, true, nil
+}
+
+func attributeValidationFail(c echo.Context, kind string, err error) error {
+	if errors.Is(err, errDictionaryNotLoaded) {
+		return fail(c, http.StatusServiceUnavailable, "DICTIONARY_NOT_LOADED", err.Error(), nil)
+	}
+	return fail(c, http.StatusBadRequest, "INVALID_ATTRIBUTES", fmt.Sprintf("%s attributes: %s", kind, err), nil)
+}
+
+func encodeAttributeItems(items []domain.AttributeItem) (string, error) {
+	if len(items) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(items)
+	return string(data), err
+}
+
+// validateReplyAttributes checks the operators and that every value can be
+// encoded with the loaded dictionary.
+func validateReplyAttributes(s string) error {
+	items, err := domain.ParseReplyItems(s)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	dict := dictionary.Default()
+	if dict == nil {
+		return errDictionaryNotLoaded
+	}
+	packet := radius.New(radius.CodeAccessAccept, []byte("validate"))
+	for i, item := range items {
+		if err := dict.AddTo(packet, item.Attribute, item.Value); err != nil {
+			return fmt.Errorf("attribute %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// validateCheckAttributes checks the operators, that every attribute is known
+// and that compared values are valid for the attribute type.
+func validateCheckAttributes(s string) error {
+	items, err := domain.ParseCheckItems(s)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	dict := dictionary.Default()
+	if dict == nil {
+		return errDictionaryNotLoaded
+	}
+	for i, item := range items {
+		attr, found := dict.AttributeRef(item.Attribute)
+		if !found {
+			return fmt.Errorf("attribute %d: unknown attribute %q", i+1, item.Attribute)
+		}
+		switch item.Op {
+		case "=~", "!~", "=*", "!*":
+			continue
+		}
+		if attr.Encrypt != dictionary.EncryptNone {
+			return fmt.Errorf("attribute %d: encrypted attribute %s cannot be compared", i+1, attr.Name)
+		}
+		if _, _, err := dict.Encode(item.Attribute, item.Value); err != nil {
+			return fmt.Errorf("attribute %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func attributeListsResponse(c echo.Context, reply, check string) error {
+	lists := AttributeLists{Reply: []domain.AttributeItem{}, Check: []domain.AttributeItem{}}
+	if items, err := domain.ParseReplyItems(reply); err == nil && items != nil {
+		lists.Reply = items
+	}
+	if items, err := domain.ParseCheckItems(check); err == nil && items != nil {
+		lists.Check = items
+	}
+	return ok(c, lists)
+}
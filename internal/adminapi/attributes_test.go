@@ -0,0 +1,137 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/share"
+)
+
+func TestUpdateProfileAttributes(t *testing.T) {
+	db, e, appCtx := CreateTestAppContext(t)
+
+	prev := dictionary.Default()
+	defer dictionary.SetDefault(prev)
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+	dictionary.SetDefault(dict)
+
+	profile := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "attrs", Status: common.ENABLED}
+	require.NoError(t, db.Create(profile).Error)
+	profileID := fmt.Sprint(profile.ID)
+
+	tests := []struct {
+		name           string
+		profileID      string
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"valid lists", profileID, `{"reply":[{"attribute":"Filter-Id","op":":=","value":"vip"},{"attribute":"Cisco-AVPair","value":"ip:vrf=a"}],"check":[{"attribute":"Called-Station-Id","op":"=~","value":":corp$"}]}`, http.StatusOK, ""},
+		{"unknown attribute", profileID, `{"reply":[{"attribute":"Not-An-Attribute","value":"x"}]}`, http.StatusBadRequest, "INVALID_ATTRIBUTES"},
+		{"invalid value", profileID, `{"reply":[{"attribute":"Session-Timeout","value":"forever"}]}`, http.StatusBadRequest, "INVALID_ATTRIBUTES"},
+		{"invalid reply operator", profileID, `{"reply":[{"attribute":"Class","op":"==","value":"x"}]}`, http.StatusBadRequest, "INVALID_ATTRIBUTES"},
+		{"invalid regex", profileID, `{"check":[{"attribute":"Called-Station-Id","op":"=~","value":"("}]}`, http.StatusBadRequest, "INVALID_ATTRIBUTES"},
+		{"unknown check attribute", profileID, `{"check":[{"attribute":"Not-An-Attribute","op":"=*"}]}`, http.StatusBadRequest, "INVALID_ATTRIBUTES"},
+		{"unknown profile", "12345", `{}`, http.StatusNotFound, "NOT_FOUND"},
+		{"invalid id", "abc", `{}`, http.StatusBadRequest, "INVALID_ID"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/radius-profiles/"+tc.profileID+"/attributes", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c := CreateTestContext(e, db, req, rec, appCtx)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.profileID)
+
+			require.NoError(t, updateProfileAttributes(c))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				var resp ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, tc.expectedCode, resp.Error)
+			}
+		})
+	}
+
+	var got domain.RadiusProfile
+	require.NoError(t, db.First(&got, profile.ID).Error)
+	items, err := got.ReplyItemList()
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "=", items[1].Op)
+
+	// Reading returns the stored lists
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/radius-profiles/"+profileID+"/attributes", nil)
+	rec := httptest.NewRecorder()
+	c := CreateTestContext(e, db, req, rec, appCtx)
+	c.SetParamNames("id")
+	c.SetParamValues(profileID)
+	require.NoError(t, getProfileAttributes(c))
+	var resp struct {
+		Data AttributeLists `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Len(t, resp.Data.Reply, 2)
+	assert.Len(t, resp.Data.Check, 1)
+
+	// Deleting clears both lists
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/radius-profiles/"+profileID+"/attributes", nil)
+	rec = httptest.NewRecorder()
+	c = CreateTestContext(e, db, req, rec, appCtx)
+	c.SetParamNames("id")
+	c.SetParamValues(profileID)
+	require.NoError(t, deleteProfileAttributes(c))
+	require.NoError(t, db.First(&got, profile.ID).Error)
+	assert.Empty(t, got.ReplyAttrs)
+	assert.Empty(t, got.CheckAttrs)
+}
+
+func TestUpdateUserAttributes(t *testing.T) {
+	db, e, appCtx := CreateTestAppContext(t)
+
+	prev := dictionary.Default()
+	defer dictionary.SetDefault(prev)
+
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "attr-user", Password: "secret", Status: common.ENABLED}
+	require.NoError(t, db.Create(user).Error)
+	userID := fmt.Sprint(user.ID)
+	body := `{"reply":[{"attribute":"Framed-Route","op":"+=","value":"10.0.0.0/24 0.0.0.0 1"}]}`
+
+	put := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/users/"+userID+"/attributes", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		c.SetParamNames("id")
+		c.SetParamValues(userID)
+		require.NoError(t, updateUserAttributes(c))
+		return rec
+	}
+
+	// Validation needs the dictionary
+	dictionary.SetDefault(nil)
+	assert.Equal(t, http.StatusServiceUnavailable, put().Code)
+
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+	dictionary.SetDefault(dict)
+	assert.Equal(t, http.StatusOK, put().Code)
+
+	var got domain.RadiusUser
+	require.NoError(t, db.First(&got, user.ID).Error)
+	items, err := got.ReplyItemList()
+	require.NoError(t, err)
+	assert.Equal(t, []domain.AttributeItem{{Attribute: "Framed-Route", Op: "+=", Value: "10.0.0.0/24 0.0.0.0 1"}}, items)
+}
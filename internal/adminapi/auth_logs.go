@@ -0,0 +1,172 @@
+package adminapi
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+)
+
+const (
+	// authLogExportMax bounds the rows of one export
+	authLogExportMax = 100000
+	// recentLoginAttempts is the default length of a user's attempt list
+	recentLoginAttempts = 20
+)
+
+// authLogColumns are the exported columns, in order
+var authLogColumns = []string{
+	"auth_time", "username", "nas_id", "nas_addr", "mac_addr", "auth_method",
+	"result", "stage", "metrics_type", "reply_message",
+}
+
+// registerAuthLogRoutes registers the authentication log routes
+func registerAuthLogRoutes() {
+	webserver.ApiGET("/radius/auth-logs", listAuthLogs)
+	webserver.ApiGET("/radius/auth-logs/export", exportAuthLogs)
+	webserver.ApiGET("/users/:id/login-attempts", listUserLoginAttempts)
+}
+
+// authLogQuery applies the search filters of the request: username, nas_addr
+// and mac_addr match a part, result, stage, metrics_type and auth_method
+// match exactly, auth_time_gte and auth_time_lte bound the time.
+func authLogQuery(c echo.Context) *gorm.DB {
+	db := GetDB(c)
+	query := db.Model(&domain.RadiusAuthLog{})
+	for _, field := range []string{"username", "nas_addr", "mac_addr"} {
+		if value := strings.TrimSpace(c.QueryParam(field)); value != "" {
+			query = query.Where(field+" LIKE ?"+likeEscape(db), "%"+escapeLikePattern(value)+"%")
+		}
+	}
+	for _, field := range []string{"result", "stage", "metrics_type", "auth_method"} {
+		if value := strings.TrimSpace(c.QueryParam(field)); value != "" {
+			query = query.Where(field+" = ?", value)
+		}
+	}
+	if value := c.QueryParam("auth_time_gte"); value != "" {
+		if parsed, err := parseFlexibleTime(value); err == nil {
+			query = query.Where("auth_time >= ?", parsed)
+		}
+	}
+	if value := c.QueryParam("auth_time_lte"); value != "" {
+		if parsed, err := parseFlexibleTime(value); err == nil {
+			query = query.Where("auth_time <= ?", parsed)
+		}
+	}
+	return query
+}
+
+// listAuthLogs searches the authentication log, newest first
+func listAuthLogs(c echo.Context) error {
+	page, pageSize := parsePagination(c)
+
+	var total int64
+	if err := authLogQuery(c).Count(&total).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query authentication log", err.Error())
+	}
+
+	var entries []domain.RadiusAuthLog
+	if err := authLogQuery(c).
+		Order("auth_time DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query authentication log", err.Error())
+	}
+
+	return paged(c, entries, total, page, pageSize)
+}
+
+// exportAuthLogs downloads the matching entries as CSV, newest first and at
+// most authLogExportMax of them
+func exportAuthLogs(c echo.Context) error {
+	rows, err := authLogQuery(c).Order("auth_time DESC").Limit(authLogExportMax).Rows()
+	if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query authentication log", err.Error())
+	}
+	defer func() { _ = rows.Close() }() //nolint:errcheck
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	resp.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=radius_auth_log_%s.csv", time.Now().Format("20060102150405")))
+	resp.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(resp)
+	if err := writer.Write(authLogColumns); err != nil {
+		return nil
+	}
+	db := GetDB(c)
+	for rows.Next() {
+		var entry domain.RadiusAuthLog
+		if err := db.ScanRows(rows, &entry); err != nil {
+			break
+		}
+		if err := writer.Write([]string{
+			entry.AuthTime.Format(time.RFC3339),
+			csvCell(entry.Username),
+			csvCell(entry.NasId),
+			csvCell(entry.NasAddr),
+			csvCell(entry.MacAddr),
+			csvCell(entry.AuthMethod),
+			csvCell(entry.Result),
+			csvCell(entry.Stage),
+			csvCell(entry.MetricsType),
+			csvCell(entry.ReplyMessage),
+		}); err != nil {
+			return nil
+		}
+	}
+	writer.Flush()
+	return nil
+}
+
+// csvCell quotes a value sent by a RADIUS client, such as the username, so
+// a spreadsheet opening the export shows it as text instead of evaluating it
+// as a formula
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// listUserLoginAttempts retrieves the latest accepted and rejected logins of
+// a user, limit sets how many (default 20, at most 200)
+func listUserLoginAttempts(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 || limit > 200 {
+		limit = recentLoginAttempts
+	}
+
+	db := GetDB(c)
+	var user domain.RadiusUser
+	if err := db.Select("id", "username").Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+
+	var entries []domain.RadiusAuthLog
+	if err := db.Where("username = ?", user.Username).
+		Order("auth_time DESC").
+		Limit(limit).
+		Find(&entries).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query authentication log", err.Error())
+	}
+
+	return ok(c, entries)
+}
@@ -0,0 +1,122 @@
+package adminapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+func TestAuthLogRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	call := func(target string, handler echo.HandlerFunc, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1"+target, nil)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if id != "" {
+			c.SetParamNames("id")
+			c.SetParamValues(id)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder) ([]domain.RadiusAuthLog, int64) {
+		var resp struct {
+			Data []domain.RadiusAuthLog `json:"data"`
+			Meta struct {
+				Total int64 `json:"total"`
+			} `json:"meta"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp.Data, resp.Meta.Total
+	}
+
+	now := time.Now()
+	entries := []domain.RadiusAuthLog{
+		{ID: 1, AuthTime: now.Add(-48 * time.Hour), Username: "alice", NasAddr: "10.0.0.1", AuthMethod: "pap", Result: domain.AuthResultAccept},
+		{ID: 2, AuthTime: now.Add(-24 * time.Hour), Username: "alice", NasAddr: "10.0.0.1", AuthMethod: "pap", Result: domain.AuthResultReject,
+			Stage: "plugin_auth", MetricsType: app.MetricsRadiusRejectPasswdError, ReplyMessage: "password mismatch"},
+		{ID: 3, AuthTime: now.Add(-time.Hour), Username: "bob", NasAddr: "10.0.0.2", MacAddr: "aa:bb:cc:00:00:01", AuthMethod: "mac", Result: domain.AuthResultReject,
+			Stage: "load_user", MetricsType: app.MetricsRadiusRejectExpire, ReplyMessage: "user expired"},
+	}
+	require.NoError(t, db.Create(&entries).Error)
+
+	rec := call("/radius/auth-logs", listAuthLogs, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	list, total := decode(rec)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, list, 3)
+	assert.Equal(t, "bob", list[0].Username, "newest first")
+
+	rec = call("/radius/auth-logs?username=ali&result=reject", listAuthLogs, "")
+	list, total = decode(rec)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, list, 1)
+	assert.Equal(t, "password mismatch", list[0].ReplyMessage)
+
+	rec = call("/radius/auth-logs?auth_time_gte="+now.Add(-30*time.Hour).Format(time.RFC3339)+"&metrics_type="+app.MetricsRadiusRejectExpire, listAuthLogs, "")
+	list, _ = decode(rec)
+	require.Len(t, list, 1)
+	assert.Equal(t, "load_user", list[0].Stage)
+
+	rec = call("/radius/auth-logs/export?result=reject", exportAuthLogs, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+	records, err := csv.NewReader(strings.NewReader(rec.Body.String())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, authLogColumns, records[0])
+	assert.Equal(t, []string{"bob", "reject", "load_user", "user expired"},
+		[]string{records[1][1], records[1][6], records[1][7], records[1][9]})
+
+	user := domain.RadiusUser{ID: common.UUIDint64(), Username: "alice", Password: "secret"}
+	require.NoError(t, db.Create(&user).Error)
+	id := fmt.Sprint(user.ID)
+
+	rec = call("/users/"+id+"/login-attempts?limit=1", listUserLoginAttempts, id)
+	require.Equal(t, http.StatusOK, rec.Code)
+	list, _ = decode(rec)
+	require.Len(t, list, 1)
+	assert.Equal(t, domain.AuthResultReject, list[0].Result)
+
+	assert.Equal(t, http.StatusNotFound, call("/users/1/login-attempts", listUserLoginAttempts, "1").Code)
+	assert.Equal(t, http.StatusBadRequest, call("/users/x/login-attempts", listUserLoginAttempts, "x").Code)
+}
+
+func TestExportAuthLogsEscapesFormulas(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	entry := domain.RadiusAuthLog{ID: 1, AuthTime: time.Now(), Username: `=HYPERLINK("http://evil.example","x")`,
+		NasAddr: "10.0.0.1", Result: domain.AuthResultReject, ReplyMessage: "@SUM(1+1)"}
+	require.NoError(t, db.Create(&entry).Error)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/radius/auth-logs/export", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, exportAuthLogs(CreateTestContext(e, db, req, rec, appCtx)))
+	records, err := csv.NewReader(strings.NewReader(rec.Body.String())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, `'=HYPERLINK("http://evil.example","x")`, records[1][1])
+	assert.Equal(t, "10.0.0.1", records[1][3])
+	assert.Equal(t, "'@SUM(1+1)", records[1][9])
+
+	for value, want := range map[string]string{"+1": "'+1", "-1": "'-1", "\tx": "'\tx", "\rx": "'\rx", "alice": "alice", "": ""} {
+		assert.Equal(t, want, csvCell(value))
+	}
+}
@@ -0,0 +1,135 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/backup"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+)
+
+// backupRestorePayload selects the tables to restore, empty restores all
+type backupRestorePayload struct {
+	Tables []string `json:"tables" validate:"dive,required,max=64"`
+}
+
+// registerBackupRoutes registers the database backup routes
+func registerBackupRoutes() {
+	webserver.ApiGET("/system/backups", listBackups)
+	webserver.ApiPOST("/system/backups", createBackup)
+	webserver.ApiGET("/system/backups/:name/download", downloadBackup)
+	webserver.ApiPOST("/system/backups/:name/restore", restoreBackup)
+	webserver.ApiDELETE("/system/backups/:name", deleteBackup)
+}
+
+// backupService returns the backup service of the backup directory
+func backupService(c echo.Context) *backup.Service {
+	return backup.NewService(GetDB(c), GetAppContext(c).Config().GetBackupDir())
+}
+
+// isBackupOperator tells whether the current operator may manage backups.
+// Only super admins may: a backup holds the password hashes and NAS secrets.
+func isBackupOperator(c echo.Context) bool {
+	currentOpr, err := resolveOperatorFromContext(c)
+	return err == nil && currentOpr.Level == "super"
+}
+
+// listBackups lists the backup files, newest first
+func listBackups(c echo.Context) error {
+	if !isBackupOperator(c) {
+		return fail(c, http.StatusForbidden, "PERMISSION_DENIED", "Only super admins can manage backups", nil)
+	}
+	backups, err := backupService(c).List()
+	if err != nil {
+		return fail(c, http.StatusInternalServerError, "BACKUP_ERROR", "Failed to list backups", err.Error())
+	}
+	return ok(c, backups)
+}
+
+// createBackup takes a backup right away
+func createBackup(c echo.Context) error {
+	if !isBackupOperator(c) {
+		return fail(c, http.StatusForbidden, "PERMISSION_DENIED", "Only super admins can manage backups", nil)
+	}
+	info, err := backupService(c).Create(c.Request().Context())
+	if err != nil {
+		return fail(c, http.StatusInternalServerError, "BACKUP_ERROR", "Failed to create backup", err.Error())
+	}
+	return ok(c, info)
+}
+
+// downloadBackup sends a backup file
+func downloadBackup(c echo.Context) error {
+	if !isBackupOperator(c) {
+		return fail(c, http.StatusForbidden, "PERMISSION_DENIED", "Only super admins can manage backups", nil)
+	}
+	name := c.Param("name")
+	f, err := backupService(c).Open(name)
+	if err != nil {
+		return backupError(c, err, "Failed to open backup")
+	}
+	defer func() { _ = f.Close() }() //nolint:errcheck
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename="+name)
+	return c.Stream(http.StatusOK, "application/gzip", f)
+}
+
+// restoreBackup replaces the selected tables, or all tables, with the rows
+// of a backup, then has every instance drop its caches and the settings
+// reloaded
+func restoreBackup(c echo.Context) error {
+	if !isBackupOperator(c) {
+		return fail(c, http.StatusForbidden, "PERMISSION_DENIED", "Only super admins can manage backups", nil)
+	}
+	var payload backupRestorePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse restore parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	result, err := backupService(c).RestoreFile(c.Request().Context(), c.Param("name"), payload.Tables)
+	if err != nil {
+		return backupError(c, err, "Failed to restore backup")
+	}
+
+	for _, kind := range []string{app.ChangeUser, app.ChangeProfile, app.ChangeNas, app.ChangePolicy} {
+		publishChange(c, app.ChangeEvent{Kind: kind})
+	}
+	if _, restored := result.Rows["sys_config"]; restored {
+		GetConfig(c).ReloadAll()
+	}
+	return ok(c, result)
+}
+
+// deleteBackup removes a backup file
+func deleteBackup(c echo.Context) error {
+	if !isBackupOperator(c) {
+		return fail(c, http.StatusForbidden, "PERMISSION_DENIED", "Only super admins can manage backups", nil)
+	}
+	if err := backupService(c).Delete(c.Param("name")); err != nil {
+		return backupError(c, err, "Failed to delete backup")
+	}
+	return ok(c, map[string]interface{}{"name": c.Param("name")})
+}
+
+// backupError maps the errors of the backup service to responses
+func backupError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, backup.ErrInvalidName):
+		return fail(c, http.StatusBadRequest, "INVALID_NAME", "Invalid backup name", nil)
+	case errors.Is(err, backup.ErrNotFound):
+		return fail(c, http.StatusNotFound, "NOT_FOUND", "Backup not found", nil)
+	case errors.Is(err, backup.ErrUnknownTable):
+		return fail(c, http.StatusBadRequest, "UNKNOWN_TABLE", err.Error(), nil)
+	case errors.Is(err, backup.ErrInvalidBackup), errors.Is(err, backup.ErrNewerSchema),
+		errors.Is(err, backup.ErrUnknownColumn):
+		return fail(c, http.StatusUnprocessableEntity, "INVALID_BACKUP", err.Error(), nil)
+	default:
+		return fail(c, http.StatusInternalServerError, "BACKUP_ERROR", message, err.Error())
+	}
+}
@@ -0,0 +1,98 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/backup"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+)
+
+func TestBackupRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+	appCtx := setupTestApp(t, db)
+	appCtx.Config().System.Workdir = t.TempDir()
+	e := setupTestEcho()
+
+	call := func(method, body string, handler echo.HandlerFunc, name string, opr *domain.SysOpr) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1/system/backups", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if opr != nil {
+			c.Set("current_operator", opr)
+		}
+		if name != "" {
+			c.SetParamNames("name")
+			c.SetParamValues(name)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+
+	require.NoError(t, db.Create(&domain.RadiusUser{ID: 1, Username: "alice", Status: "enabled", ExpireTime: time.Now().Add(time.Hour)}).Error)
+
+	rec := call(http.MethodPost, "", createBackup, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var created struct {
+		Data backup.Info `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, int64(1), created.Data.Rows["radius_user"])
+	name := created.Data.Name
+
+	rec = call(http.MethodGet, "", listBackups, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed struct {
+		Data []backup.Info `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	require.Len(t, listed.Data, 1)
+	assert.Equal(t, name, listed.Data[0].Name)
+
+	rec = call(http.MethodGet, "", downloadBackup, name, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gzip", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), name)
+	assert.Equal(t, listed.Data[0].Size, int64(rec.Body.Len()))
+
+	// Restore the users only
+	require.NoError(t, db.Delete(&domain.RadiusUser{}, 1).Error)
+	require.NoError(t, db.Create(&domain.NetNas{ID: 2, Name: "nas", Ipaddr: "10.0.0.1"}).Error)
+	rec = call(http.MethodPost, `{"tables":["radius_user"]}`, restoreBackup, name, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var user domain.RadiusUser
+	require.NoError(t, db.First(&user, 1).Error)
+	assert.Equal(t, "alice", user.Username)
+	var nasCount int64
+	require.NoError(t, db.Model(&domain.NetNas{}).Count(&nasCount).Error)
+	assert.Equal(t, int64(1), nasCount)
+
+	rec = call(http.MethodPost, `{"tables":["nope"]}`, restoreBackup, name, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = call(http.MethodGet, "", downloadBackup, "../toughradius.yml", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = call(http.MethodGet, "", downloadBackup, "toughradius-20200101-000000.jsonl.gz", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Backups hold secrets: super admins only
+	admin := &domain.SysOpr{ID: 2, Username: "admin", Level: "admin", Status: "enabled"}
+	rec = call(http.MethodGet, "", listBackups, "", admin)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	rec = call(http.MethodGet, "", downloadBackup, name, admin)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = call(http.MethodDelete, "", deleteBackup, name, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = call(http.MethodGet, "", listBackups, "", nil)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	assert.Empty(t, listed.Data)
+}
@@ -3,6 +3,7 @@ package adminapi
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"gorm.io/gorm"
 )
 
@@ -23,3 +24,21 @@ func GetDB(c echo.Context) *gorm.DB {
 func GetConfig(c echo.Context) *app.ConfigManager {
 	return GetAppContext(c).ConfigMgr()
 }
+
+// publishChange announces a write to an entity the RADIUS service caches,
+// so every instance evicts it right away
+func publishChange(c echo.Context, event app.ChangeEvent) {
+	if appCtx, ok := c.Get("appCtx").(app.AppContext); ok && appCtx != nil {
+		appCtx.ChangeBus().Publish(event)
+	}
+}
+
+// publishUserChange announces a change of a user, keyed by its username and
+// bound MAC address plus the extra keys, e.g. the MAC of a device
+func publishUserChange(c echo.Context, user *domain.RadiusUser, keys ...string) {
+	keys = append(keys, user.Username)
+	if user.MacAddr != "" {
+		keys = append(keys, user.MacAddr)
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeUser, ID: user.ID, Keys: keys})
+}
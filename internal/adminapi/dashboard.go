@@ -224,6 +224,14 @@ func dateBucketExpression(db *gorm.DB, field, granularity string) string {
 		case "hour":
 			return fmt.Sprintf("TO_CHAR(date_trunc('hour', %s), 'YYYY-MM-DD HH24:00')", field)
 		}
+	case "mysql":
+		// The connection stores local times, as the bucket keys expect
+		switch granularity {
+		case "day":
+			return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d')", field)
+		case "hour":
+			return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:00')", field)
+		}
 	default:
 		switch granularity {
 		case "day":
@@ -0,0 +1,141 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/debugstream"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"github.com/talkincode/toughradius/v9/pkg/web"
+)
+
+// debugKeepalive is the interval of the ping events keeping idle streams
+// open through proxies
+const debugKeepalive = 15 * time.Second
+
+// debugSessionPayload defines the filter and lifetime of a debug session
+type debugSessionPayload struct {
+	Username string `json:"username" validate:"omitempty,max=255"`
+	MacAddr  string `json:"mac_addr" validate:"omitempty,max=64"`
+	NasIP    string `json:"nas_ip" validate:"omitempty,ip"`
+	Duration int    `json:"duration" validate:"min=0,max=1800"` // Seconds, 0 for the default of 5 minutes
+}
+
+// debugSessionView is a debug session as listed to operators
+type debugSessionView struct {
+	*debugstream.Session
+	Dropped int64 `json:"dropped"`
+}
+
+// registerDebugSessionRoutes registers the live packet debug routes
+func registerDebugSessionRoutes() {
+	webserver.ApiGET("/radius/debug-sessions", listDebugSessions)
+	webserver.ApiPOST("/radius/debug-sessions", createDebugSession)
+	webserver.ApiGET("/radius/debug-sessions/:id/stream", streamDebugSession)
+	webserver.ApiDELETE("/radius/debug-sessions/:id", closeDebugSession)
+}
+
+// listDebugSessions retrieves the open debug sessions
+func listDebugSessions(c echo.Context) error {
+	sessions := debugstream.Default().Sessions()
+	views := make([]debugSessionView, 0, len(sessions))
+	for _, session := range sessions {
+		views = append(views, debugSessionView{Session: session, Dropped: session.Dropped()})
+	}
+	return ok(c, views)
+}
+
+// createDebugSession opens a debug session. It collects the matching
+// traffic until it expires, the stream endpoint delivers it.
+func createDebugSession(c echo.Context) error {
+	var payload debugSessionPayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse debug session parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	session, err := debugstream.Default().Open(debugstream.Filter{
+		Username: payload.Username,
+		MacAddr:  payload.MacAddr,
+		NasIP:    payload.NasIP,
+	}, time.Duration(payload.Duration)*time.Second)
+	switch {
+	case errors.Is(err, debugstream.ErrEmptyFilter):
+		return fail(c, http.StatusBadRequest, "EMPTY_FILTER", "A username, MAC address or NAS IP is required", nil)
+	case errors.Is(err, debugstream.ErrTooManySessions):
+		return fail(c, http.StatusConflict, "TOO_MANY_DEBUG_SESSIONS", "Too many debug sessions are open", nil)
+	case err != nil:
+		return fail(c, http.StatusInternalServerError, "DEBUG_SESSION_FAILED", "Failed to open debug session", err.Error())
+	}
+
+	return ok(c, debugSessionView{Session: session})
+}
+
+// streamDebugSession streams the events of a debug session as server-sent
+// events until the session ends or the client goes away: "packet" events
+// carry a request, response or error, "end" closes the stream.
+func streamDebugSession(c echo.Context) error {
+	session, found := debugstream.Default().Get(c.Param("id"))
+	if !found {
+		return fail(c, http.StatusNotFound, "DEBUG_SESSION_NOT_FOUND", "Debug session not found or expired", nil)
+	}
+	if !session.Attach() {
+		return fail(c, http.StatusConflict, "DEBUG_SESSION_BUSY", "Debug session is streamed by another client", nil)
+	}
+	defer session.Detach()
+
+	sse := web.NewSSE(c)
+	c.Response().WriteHeader(http.StatusOK)
+	writeEvent := func(name string, data interface{}) error {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return sse.WriteEvent(name, raw)
+	}
+	if err := writeEvent("session", debugSessionView{Session: session, Dropped: session.Dropped()}); err != nil {
+		return nil
+	}
+
+	keepalive := time.NewTicker(debugKeepalive)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case event := <-session.Events():
+			if err := writeEvent("packet", event); err != nil {
+				return nil
+			}
+		case <-keepalive.C:
+			if err := writeEvent("ping", map[string]int64{"dropped": session.Dropped()}); err != nil {
+				return nil
+			}
+		case <-session.Done():
+			// Deliver what was collected before the end
+			for len(session.Events()) > 0 {
+				if err := writeEvent("packet", <-session.Events()); err != nil {
+					return nil
+				}
+			}
+			_ = writeEvent("end", map[string]int64{"dropped": session.Dropped()}) //nolint:errcheck
+			return nil
+		}
+	}
+}
+
+// closeDebugSession ends a debug session before it expires
+func closeDebugSession(c echo.Context) error {
+	if !debugstream.Default().Close(c.Param("id")) {
+		return fail(c, http.StatusNotFound, "DEBUG_SESSION_NOT_FOUND", "Debug session not found or expired", nil)
+	}
+	return ok(c, map[string]interface{}{
+		"closed": true,
+	})
+}
@@ -0,0 +1,79 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/debugstream"
+)
+
+func TestDebugSessionRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	call := func(method, target, body string, handler echo.HandlerFunc, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1"+target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if id != "" {
+			c.SetParamNames("id")
+			c.SetParamValues(id)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusBadRequest, call(http.MethodPost, "/radius/debug-sessions", `{}`, createDebugSession, "").Code)
+	assert.Equal(t, http.StatusBadRequest, call(http.MethodPost, "/radius/debug-sessions", `{"username":"alice","duration":7200}`, createDebugSession, "").Code)
+
+	rec := call(http.MethodPost, "/radius/debug-sessions", `{"username":"alice","duration":60}`, createDebugSession, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var created struct {
+		Data struct {
+			ID     string             `json:"id"`
+			Filter debugstream.Filter `json:"filter"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.NotEmpty(t, created.Data.ID)
+	assert.Equal(t, "alice", created.Data.Filter.Username)
+	id := created.Data.ID
+
+	rec = call(http.MethodGet, "/radius/debug-sessions", "", listDebugSessions, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), id)
+
+	rec = call(http.MethodDelete, "/radius/debug-sessions/"+id, "", closeDebugSession, id)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusNotFound, call(http.MethodGet, "/radius/debug-sessions/"+id+"/stream", "", streamDebugSession, id).Code)
+	assert.Equal(t, http.StatusNotFound, call(http.MethodDelete, "/radius/debug-sessions/"+id, "", closeDebugSession, id).Code)
+
+	// The stream delivers the collected events and ends with the session
+	session, err := debugstream.Default().Open(debugstream.Filter{Username: "alice"}, 200*time.Millisecond)
+	require.NoError(t, err)
+	subject := debugstream.Subject{Username: "alice", NasIP: "10.0.0.1"}
+	debugstream.Default().Publish(subject, debugstream.Event{Kind: debugstream.KindRequest, Service: "auth", Text: "Access-Request"})
+	debugstream.Default().Publish(subject, debugstream.Event{Kind: debugstream.KindError, Service: "auth", Error: "password mismatch"})
+
+	require.True(t, session.Attach())
+	assert.Equal(t, http.StatusConflict, call(http.MethodGet, "/radius/debug-sessions/"+session.ID+"/stream", "", streamDebugSession, session.ID).Code)
+	session.Detach()
+
+	rec = call(http.MethodGet, "/radius/debug-sessions/"+session.ID+"/stream", "", streamDebugSession, session.ID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	body := rec.Body.String()
+	assert.Contains(t, body, "event: session\n")
+	assert.Equal(t, 2, strings.Count(body, "event: packet\n"))
+	assert.Contains(t, body, "password mismatch")
+	assert.Contains(t, body, "event: end\n")
+}
@@ -0,0 +1,353 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/lockout"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+)
+
+// Self-service requests allowed per client IP: a burst of 10, then one
+// every 6 seconds
+const (
+	selfServiceRate  = 1.0 / 6
+	selfServiceBurst = 10
+)
+
+var (
+	selfServiceVerifierMu sync.RWMutex
+	selfServiceVerifier   = totp.NewVerifier()
+)
+
+// SetTOTPVerifier installs the TOTP verifier of the RADIUS service, so a
+// one-time code used for a self-service request cannot be replayed for a
+// RADIUS login and the other way round
+func SetTOTPVerifier(verifier *totp.Verifier) {
+	if verifier == nil {
+		return
+	}
+	selfServiceVerifierMu.Lock()
+	defer selfServiceVerifierMu.Unlock()
+	selfServiceVerifier = verifier
+}
+
+func getTOTPVerifier() *totp.Verifier {
+	selfServiceVerifierMu.RLock()
+	defer selfServiceVerifierMu.RUnlock()
+	return selfServiceVerifier
+}
+
+// devicePayload registers a device or renames it
+type devicePayload struct {
+	MacAddr string `json:"mac_addr" validate:"omitempty,max=32"`
+	Label   string `json:"label" validate:"omitempty,max=100"`
+}
+
+// selfDevicePayload authenticates a subscriber managing their own devices.
+// Users with an enrolled TOTP secret also send the current code.
+type selfDevicePayload struct {
+	Username string `json:"username" validate:"required,max=50"`
+	Password string `json:"password" validate:"required,max=128"`
+	Otp      string `json:"otp" validate:"omitempty,max=10"`
+	MacAddr  string `json:"mac_addr" validate:"omitempty,max=32"`
+}
+
+// registerDeviceRoutes registers the user device routes. The self-service
+// routes are authenticated with the subscriber password instead of an
+// operator token, and rate limited per client IP.
+func registerDeviceRoutes() {
+	webserver.ApiGET("/users/:id/devices", listUserDevices)
+	webserver.ApiPOST("/users/:id/devices", createUserDevice)
+	webserver.ApiPUT("/users/:id/devices/:device_id", updateUserDevice)
+	webserver.ApiDELETE("/users/:id/devices/:device_id", deleteUserDevice)
+
+	rateLimit := selfServiceRateLimit()
+	webserver.ApiPOST("/self/devices", listSelfDevices, rateLimit)
+	webserver.ApiPOST("/self/devices/remove", removeSelfDevice, rateLimit)
+}
+
+// selfServiceRateLimit limits the self-service requests of a client IP, the
+// password guesses of one client are slowed down before the lockout applies
+func selfServiceRateLimit() echo.MiddlewareFunc {
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:      selfServiceRate,
+			Burst:     selfServiceBurst,
+			ExpiresIn: 10 * time.Minute,
+		}),
+		ErrorHandler: func(c echo.Context, _ error) error {
+			return fail(c, http.StatusForbidden, "FORBIDDEN", "Unable to identify the client", nil)
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			return fail(c, http.StatusTooManyRequests, "TOO_MANY_REQUESTS", "Too many requests, try again later", nil)
+		},
+	})
+}
+
+// listUserDevices lists the devices registered to a user
+func listUserDevices(c echo.Context) error {
+	user, err := findDeviceUser(c)
+	if user == nil {
+		return err
+	}
+
+	var devices []domain.RadiusUserDevice
+	if err := GetDB(c).Where("user_id = ?", user.ID).Order("id ASC").Find(&devices).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query devices", err.Error())
+	}
+	return ok(c, devices)
+}
+
+// createUserDevice registers a device manually. Manually registered devices
+// count towards MaxDevices like learned ones.
+func createUserDevice(c echo.Context) error {
+	user, err := findDeviceUser(c)
+	if user == nil {
+		return err
+	}
+
+	var payload devicePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse device parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+	mac := domain.NormalizeMac(payload.MacAddr)
+	if mac == "" {
+		return fail(c, http.StatusBadRequest, "INVALID_MAC", "Invalid MAC address", nil)
+	}
+
+	db := GetDB(c)
+	var count int64
+	if err := db.Model(&domain.RadiusUserDevice{}).Where("user_id = ? AND mac_addr = ?", user.ID, mac).Count(&count).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query devices", err.Error())
+	}
+	if count > 0 {
+		return fail(c, http.StatusConflict, "DEVICE_EXISTS", "Device already registered", nil)
+	}
+
+	now := time.Now()
+	device := domain.RadiusUserDevice{
+		UserId:    user.ID,
+		Username:  user.Username,
+		MacAddr:   mac,
+		Label:     strings.TrimSpace(payload.Label),
+		Source:    domain.DeviceSourceManual,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	// A concurrent request may have registered the device since the check
+	res := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "mac_addr"}},
+		DoNothing: true,
+	}).Create(&device)
+	if res.Error != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to register device", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return fail(c, http.StatusConflict, "DEVICE_EXISTS", "Device already registered", nil)
+	}
+	publishUserChange(c, user, mac)
+	return ok(c, device)
+}
+
+// updateUserDevice renames a device
+func updateUserDevice(c echo.Context) error {
+	device, err := findUserDevice(c)
+	if device == nil {
+		return err
+	}
+
+	var payload devicePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse device parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	device.Label = strings.TrimSpace(payload.Label)
+	device.UpdatedAt = time.Now()
+	if err := GetDB(c).Model(device).Updates(map[string]interface{}{
+		"label":      device.Label,
+		"updated_at": device.UpdatedAt,
+	}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to update device", err.Error())
+	}
+	return ok(c, device)
+}
+
+// deleteUserDevice removes a device, which frees a slot for learning
+func deleteUserDevice(c echo.Context) error {
+	device, err := findUserDevice(c)
+	if device == nil {
+		return err
+	}
+	if err := GetDB(c).Delete(device).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to delete device", err.Error())
+	}
+	publishDeviceChange(c, device.UserId, device.Username, device.MacAddr)
+	return ok(c, map[string]interface{}{
+		"id": device.ID,
+	})
+}
+
+// listSelfDevices lets a subscriber see their own devices
+func listSelfDevices(c echo.Context) error {
+	user, err := authenticateSelfService(c, nil)
+	if user == nil {
+		return err
+	}
+
+	var devices []domain.RadiusUserDevice
+	if err := GetDB(c).Where("user_id = ?", user.ID).Order("id ASC").Find(&devices).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query devices", err.Error())
+	}
+	return ok(c, devices)
+}
+
+// removeSelfDevice lets a subscriber remove one of their devices, e.g. a
+// lost phone, so a new one can be learned
+func removeSelfDevice(c echo.Context) error {
+	var payload selfDevicePayload
+	user, err := authenticateSelfService(c, &payload)
+	if user == nil {
+		return err
+	}
+	mac := domain.NormalizeMac(payload.MacAddr)
+	if mac == "" {
+		return fail(c, http.StatusBadRequest, "INVALID_MAC", "Invalid MAC address", nil)
+	}
+
+	result := GetDB(c).Where("user_id = ? AND mac_addr = ?", user.ID, mac).Delete(&domain.RadiusUserDevice{})
+	if result.Error != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to delete device", result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fail(c, http.StatusNotFound, "DEVICE_NOT_FOUND", "Device not found", nil)
+	}
+	publishUserChange(c, user, mac)
+	return ok(c, map[string]interface{}{
+		"mac_addr": mac,
+	})
+}
+
+// authenticateSelfService checks the subscriber credentials of a
+// self-service request like a RADIUS login: locked usernames and client IPs
+// are refused, failures count towards the lockout, and disabled or expired
+// users and a missing or wrong one-time code are rejected. The same response
+// is returned for unknown users and wrong passwords. On failure it writes the
+// error response and returns a nil user.
+func authenticateSelfService(c echo.Context, payload *selfDevicePayload) (*domain.RadiusUser, error) {
+	if payload == nil {
+		payload = &selfDevicePayload{}
+	}
+	if err := c.Bind(payload); err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse request parameters", nil)
+	}
+	if err := c.Validate(payload); err != nil {
+		return nil, handleValidationError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	username := strings.TrimSpace(payload.Username)
+	lockouts := lockout.NewManager(GetDB(c), GetConfig(c))
+	subjects := lockout.Subjects{Username: username, Station: lockout.StationSubject(c.RealIP())}
+	if locked, err := lockouts.Locked(ctx, subjects); err != nil {
+		zap.L().Error("query lockouts failed", zap.String("namespace", "adminapi"), zap.String("username", username), zap.Error(err))
+	} else if locked != nil {
+		return nil, fail(c, http.StatusTooManyRequests, "LOGIN_LOCKED", "Too many failed logins, try again later", nil)
+	}
+	reject := func(status int, code, message string) (*domain.RadiusUser, error) {
+		if _, err := lockouts.Fail(ctx, subjects, "self-service: "+message); err != nil {
+			zap.L().Error("record login failure failed", zap.String("namespace", "adminapi"), zap.String("username", username), zap.Error(err))
+		}
+		return nil, fail(c, status, code, message, nil)
+	}
+
+	var user domain.RadiusUser
+	err := GetDB(c).Where("username = ?", username).First(&user).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+	if err != nil || user.AuthSource != domain.AuthSourceLocal || !passwd.Verify(user.Password, payload.Password) {
+		return reject(http.StatusUnauthorized, "INVALID_CREDENTIALS", "Invalid username or password")
+	}
+	if user.TotpSecret != "" {
+		if payload.Otp == "" {
+			return nil, fail(c, http.StatusUnauthorized, "OTP_REQUIRED", "A one-time code is required", nil)
+		}
+		if !getTOTPVerifier().Verify(user.Username, user.TotpSecret, strings.TrimSpace(payload.Otp)) {
+			return reject(http.StatusUnauthorized, "INVALID_OTP", "Invalid one-time code")
+		}
+	}
+	switch {
+	case user.Status == common.DISABLED || user.Status == domain.UserStatusSuspended:
+		return nil, fail(c, http.StatusForbidden, "USER_DISABLED", "User is disabled", nil)
+	case user.ExpireTime.Before(time.Now()):
+		return nil, fail(c, http.StatusForbidden, "USER_EXPIRED", "User has expired", nil)
+	}
+
+	if err := lockouts.Succeed(ctx, subjects); err != nil {
+		zap.L().Error("clear login failures failed", zap.String("namespace", "adminapi"), zap.String("username", username), zap.Error(err))
+	}
+	return &user, nil
+}
+
+// findDeviceUser loads the user of the :id parameter. On failure it writes
+// the error response and returns a nil user.
+func findDeviceUser(c echo.Context) (*domain.RadiusUser, error) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return nil, fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+	return &user, nil
+}
+
+// findUserDevice loads the :device_id device of the :id user. On failure it
+// writes the error response and returns a nil device.
+func findUserDevice(c echo.Context) (*domain.RadiusUserDevice, error) {
+	userId, err := parseIDParam(c, "id")
+	if err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	deviceId, err := parseIDParam(c, "device_id")
+	if err != nil {
+		return nil, fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid device ID", nil)
+	}
+	var device domain.RadiusUserDevice
+	if err := GetDB(c).Where("id = ? AND user_id = ?", deviceId, userId).First(&device).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fail(c, http.StatusNotFound, "DEVICE_NOT_FOUND", "Device not found", nil)
+	} else if err != nil {
+		return nil, fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query devices", err.Error())
+	}
+	return &device, nil
+}
+
+// publishDeviceChange announces that a device of the user was removed, so a
+// MAC authentication cached under it is evicted
+func publishDeviceChange(c echo.Context, userID int64, username, mac string) {
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeUser, ID: userID, Keys: []string{username, mac}})
+}
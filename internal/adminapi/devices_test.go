@@ -0,0 +1,180 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+)
+
+func TestUserDeviceRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	call := func(method, path, body string, handler echo.HandlerFunc, params ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1"+path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if len(params) > 0 {
+			names := []string{"id", "device_id"}
+			c.SetParamNames(names[:len(params)]...)
+			c.SetParamValues(params...)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder, v interface{}) {
+		var resp struct {
+			Data json.RawMessage `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NoError(t, json.Unmarshal(resp.Data, v))
+	}
+
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "family", Password: "secret123", Status: common.ENABLED, ExpireTime: time.Now().AddDate(0, 1, 0)}
+	require.NoError(t, db.Create(user).Error)
+	userID := fmt.Sprint(user.ID)
+
+	rec := call(http.MethodPost, "/users/"+userID+"/devices", `{"mac_addr":"not-a-mac"}`, createUserDevice, userID)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = call(http.MethodPost, "/users/"+userID+"/devices", `{"mac_addr":"AA-BB-CC-00-00-01","label":"laptop"}`, createUserDevice, userID)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var laptop domain.RadiusUserDevice
+	decode(rec, &laptop)
+	assert.Equal(t, "aa:bb:cc:00:00:01", laptop.MacAddr)
+	assert.Equal(t, domain.DeviceSourceManual, laptop.Source)
+
+	rec = call(http.MethodPost, "/users/"+userID+"/devices", `{"mac_addr":"aa:bb:cc:00:00:01"}`, createUserDevice, userID)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = call(http.MethodPost, "/users/"+userID+"/devices", `{"mac_addr":"aa:bb:cc:00:00:02","label":"phone"}`, createUserDevice, userID)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = call(http.MethodPut, "/users/"+userID+"/devices/"+fmt.Sprint(laptop.ID), `{"label":"work laptop"}`, updateUserDevice, userID, fmt.Sprint(laptop.ID))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = call(http.MethodGet, "/users/"+userID+"/devices", "", listUserDevices, userID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var devices []domain.RadiusUserDevice
+	decode(rec, &devices)
+	require.Len(t, devices, 2)
+	assert.Equal(t, "work laptop", devices[0].Label)
+
+	// Self-service requires the subscriber password
+	rec = call(http.MethodPost, "/self/devices", `{"username":"family","password":"wrong"}`, listSelfDevices)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = call(http.MethodPost, "/self/devices", `{"username":"nobody","password":"secret123"}`, listSelfDevices)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = call(http.MethodPost, "/self/devices", `{"username":"family","password":"secret123"}`, listSelfDevices)
+	require.Equal(t, http.StatusOK, rec.Code)
+	devices = nil
+	decode(rec, &devices)
+	assert.Len(t, devices, 2)
+
+	rec = call(http.MethodPost, "/self/devices/remove", `{"username":"family","password":"secret123","mac_addr":"AA:BB:CC:00:00:02"}`, removeSelfDevice)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = call(http.MethodPost, "/self/devices/remove", `{"username":"family","password":"secret123","mac_addr":"AA:BB:CC:00:00:02"}`, removeSelfDevice)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(http.MethodDelete, "/users/"+userID+"/devices/"+fmt.Sprint(laptop.ID), "", deleteUserDevice, userID, fmt.Sprint(laptop.ID))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusUserDevice{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	// Devices of other users are not reachable through a user
+	rec = call(http.MethodDelete, "/users/1/devices/"+fmt.Sprint(laptop.ID), "", deleteUserDevice, "1", fmt.Sprint(laptop.ID))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestSelfServiceAuthentication(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+	cfg := appCtx.ConfigMgr()
+	require.NoError(t, cfg.Set("lockout", "Enabled", "true"))
+	require.NoError(t, cfg.Set("lockout", "MaxFailures", "3"))
+	require.NoError(t, cfg.Set("lockout", "StationMaxFailures", "0"))
+
+	call := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/self/devices", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		handleTestError(rec, listSelfDevices(CreateTestContext(e, db, req, rec, appCtx)))
+		return rec
+	}
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	expire := time.Now().AddDate(0, 1, 0)
+	users := []*domain.RadiusUser{
+		{ID: common.UUIDint64(), Username: "locked", Password: "secret123", Status: common.ENABLED, ExpireTime: expire},
+		{ID: common.UUIDint64(), Username: "disabled", Password: "secret123", Status: common.DISABLED, ExpireTime: expire},
+		{ID: common.UUIDint64(), Username: "expired", Password: "secret123", Status: common.ENABLED, ExpireTime: time.Now().AddDate(0, 0, -1)},
+		{ID: common.UUIDint64(), Username: "twofactor", Password: "secret123", Status: common.ENABLED, ExpireTime: expire, TotpSecret: secret},
+	}
+	for _, user := range users {
+		require.NoError(t, db.Create(user).Error)
+	}
+
+	// Failures lock the username, even for the right password
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusUnauthorized, call(`{"username":"locked","password":"wrong"}`).Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, call(`{"username":"locked","password":"secret123"}`).Code)
+
+	// Disabled and expired users are refused after the password check
+	assert.Equal(t, http.StatusForbidden, call(`{"username":"disabled","password":"secret123"}`).Code)
+	assert.Equal(t, http.StatusForbidden, call(`{"username":"expired","password":"secret123"}`).Code)
+	assert.Equal(t, http.StatusUnauthorized, call(`{"username":"disabled","password":"wrong"}`).Code)
+
+	// Users with a TOTP secret need the current code, once
+	rec := call(`{"username":"twofactor","password":"secret123"}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "OTP_REQUIRED")
+	assert.Equal(t, http.StatusUnauthorized, call(`{"username":"twofactor","password":"secret123","otp":"000000x"}`).Code)
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, call(`{"username":"twofactor","password":"secret123","otp":"`+code+`"}`).Code)
+
+	// A success clears the failure counter
+	var lockouts int64
+	require.NoError(t, db.Model(&domain.RadiusLockout{}).Where("subject = ?", "twofactor").Count(&lockouts).Error)
+	assert.Zero(t, lockouts)
+
+	assert.Equal(t, http.StatusUnauthorized, call(`{"username":"twofactor","password":"secret123","otp":"`+code+`"}`).Code)
+}
+
+func TestSelfServiceRateLimit(t *testing.T) {
+	e := setupTestEcho()
+	handler := selfServiceRateLimit()(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	call := func(ip string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/self/devices", nil)
+		req.RemoteAddr = ip + ":40000"
+		rec := httptest.NewRecorder()
+		handleTestError(rec, handler(e.NewContext(req, rec)))
+		return rec.Code
+	}
+
+	for i := 0; i < selfServiceBurst; i++ {
+		require.Equal(t, http.StatusOK, call("192.0.2.10"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, call("192.0.2.10"))
+	// Other clients are not affected
+	assert.Equal(t, http.StatusOK, call("192.0.2.11"))
+}
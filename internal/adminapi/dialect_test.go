@@ -0,0 +1,94 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app/mysqltest"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupMysqlTestDB creates the tables of setupTestDB on an in-process
+// MySQL-compatible server
+func setupMysqlTestDB(t *testing.T) *gorm.DB {
+	srv := mysqltest.NewServer("toughradius")
+	t.Cleanup(srv.Close)
+
+	db, err := gorm.Open(mysql.Open(srv.DSN()), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(
+		&domain.RadiusProfile{},
+		&domain.RadiusUser{},
+		&domain.RadiusAccounting{},
+		&domain.RadiusAuthLog{},
+		&domain.RadiusOnline{},
+		&domain.SysConfig{},
+	))
+	return db
+}
+
+// TestDialectQueries runs the dialect-sensitive queries, the LIKE escaping
+// and the dashboard buckets, on SQLite and on MySQL
+func TestDialectQueries(t *testing.T) {
+	for name, open := range map[string]func(*testing.T) *gorm.DB{
+		"sqlite": setupTestDB,
+		"mysql":  setupMysqlTestDB,
+	} {
+		t.Run(name, func(t *testing.T) {
+			db := open(t)
+			appCtx := setupTestApp(t, db)
+			e := setupTestEcho()
+
+			list := func(path string, params url.Values, handler echo.HandlerFunc) int {
+				req := httptest.NewRequest(http.MethodGet, "/api/v1"+path+"?"+params.Encode(), nil)
+				rec := httptest.NewRecorder()
+				handleTestError(rec, handler(CreateTestContext(e, db, req, rec, appCtx)))
+				require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+				var resp struct {
+					Data []json.RawMessage `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				return len(resp.Data)
+			}
+
+			now := time.Now()
+			for _, username := range []string{"a_b", "axb", "Alice"} {
+				require.NoError(t, db.Create(&domain.RadiusUser{Username: username, Status: "enabled", ExpireTime: now.Add(time.Hour)}).Error)
+				require.NoError(t, db.Create(&domain.RadiusOnline{Username: username, AcctSessionId: "s-" + username, AcctStartTime: now, LastUpdate: now}).Error)
+			}
+			require.NoError(t, db.Create(&domain.RadiusAccounting{Username: "a_b", AcctSessionId: "s-a_b", AcctStartTime: now, AcctStopTime: now, LastUpdate: now,
+				AcctInputTotal: 1 << 30, AcctOutputTotal: 2 << 30}).Error)
+
+			// The underscore matches itself only
+			assert.Equal(t, 1, list("/users", url.Values{"username": {"a_b"}}, listRadiusUsers))
+			assert.Equal(t, 1, list("/users", url.Values{"q": {"a_b"}}, listRadiusUsers))
+			assert.Equal(t, 1, list("/users", url.Values{"username": {"ALICE"}}, listRadiusUsers))
+			assert.Equal(t, 1, list("/sessions", url.Values{"username": {"a_b"}}, ListOnlineSessions))
+			assert.Equal(t, 1, list("/accounting", url.Values{"username": {"a_b"}}, ListAccounting))
+			assert.Equal(t, 2, list("/sessions", url.Values{"username": {"b"}}, ListOnlineSessions))
+
+			trend := fetchAuthTrend(db, now)
+			assert.Equal(t, now.Format(dateKeyFormat), trend[len(trend)-1].Date)
+			assert.Equal(t, int64(1), trend[len(trend)-1].Count)
+
+			// SQLite buckets in the zone of the process, the sum holds anyway
+			var upload, download float64
+			for _, point := range fetchTrafficStats(db, now) {
+				upload += point.UploadGB
+				download += point.DownloadGB
+			}
+			assert.InDelta(t, 1, upload, 0.001)
+			assert.InDelta(t, 2, download, 0.001)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package adminapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+)
+
+// DictionaryVendor is a vendor of the loaded RADIUS dictionary.
+type DictionaryVendor struct {
+	ID         uint32 `json:"id"`
+	Name       string `json:"name"`
+	Attributes int    `json:"attributes"`
+}
+
+// DictionaryAttribute is an attribute of the loaded RADIUS dictionary.
+type DictionaryAttribute struct {
+	Name    string   `json:"name"`
+	Code    int      `json:"code"`
+	Type    string   `json:"type"`
+	Vendor  string   `json:"vendor,omitempty"`
+	HasTag  bool     `json:"has_tag,omitempty"`
+	Encrypt int      `json:"encrypt,omitempty"`
+	Values  []string `json:"values,omitempty"`
+}
+
+func registerDictionaryRoutes() {
+	webserver.ApiGET("/dictionary/vendors", listDictionaryVendors)
+	webserver.ApiGET("/dictionary/attributes", listDictionaryAttributes)
+}
+
+func listDictionaryVendors(c echo.Context) error {
+	dict := dictionary.Default()
+	if dict == nil {
+		return fail(c, http.StatusServiceUnavailable, "DICTIONARY_NOT_LOADED", "RADIUS dictionary is not loaded", nil)
+	}
+	vendors := dict.Vendors()
+	items := make([]DictionaryVendor, 0, len(vendors))
+	for _, v := range vendors {
+		items = append(items, DictionaryVendor{ID: v.ID, Name: v.Name, Attributes: len(v.Attributes())})
+	}
+	return ok(c, items)
+}
+
+// listDictionaryAttributes lists the standard attributes, or those of the
+// vendor given by name or enterprise number, optionally filtered by name.
+func listDictionaryAttributes(c echo.Context) error {
+	dict := dictionary.Default()
+	if dict == nil {
+		return fail(c, http.StatusServiceUnavailable, "DICTIONARY_NOT_LOADED", "RADIUS dictionary is not loaded", nil)
+	}
+
+	attrs := dict.Attributes()
+	if name := strings.TrimSpace(c.QueryParam("vendor")); name != "" {
+		vendor, found := dict.VendorByName(name)
+		if id, err := strconv.ParseUint(name, 10, 32); !found && err == nil {
+			vendor = dict.Vendor(uint32(id))
+			found = vendor != nil
+		}
+		if !found {
+			return fail(c, http.StatusNotFound, "VENDOR_NOT_FOUND", "Vendor not found", nil)
+		}
+		attrs = vendor.Attributes()
+	}
+
+	query := strings.ToLower(strings.TrimSpace(c.QueryParam("q")))
+	items := make([]DictionaryAttribute, 0, len(attrs))
+	for _, a := range attrs {
+		if query != "" && !strings.Contains(strings.ToLower(a.Name), query) {
+			continue
+		}
+		item := DictionaryAttribute{
+			Name:    a.Name,
+			Code:    a.Code,
+			Type:    string(a.Type),
+			HasTag:  a.HasTag,
+			Encrypt: a.Encrypt,
+			Values:  a.Values(),
+		}
+		if a.Vendor != nil {
+			item.Vendor = a.Vendor.Name
+		}
+		items = append(items, item)
+	}
+	return ok(c, items)
+}
@@ -0,0 +1,80 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/share"
+)
+
+func TestListDictionaryAttributes(t *testing.T) {
+	db, e, appCtx := CreateTestAppContext(t)
+
+	prev := dictionary.Default()
+	defer dictionary.SetDefault(prev)
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+	dictionary.SetDefault(dict)
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedName   string
+	}{
+		{"standard attributes", "?q=session-timeout", http.StatusOK, "Session-Timeout"},
+		{"vendor by name", "?vendor=cisco&q=avpair", http.StatusOK, "Cisco-AVPair"},
+		{"vendor by id", "?vendor=2011&q=input-average", http.StatusOK, "Huawei-Input-Average-Rate"},
+		{"unknown vendor", "?vendor=nobody", http.StatusNotFound, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/dictionary/attributes"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := CreateTestContext(e, db, req, rec, appCtx)
+
+			require.NoError(t, listDictionaryAttributes(c))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedName == "" {
+				return
+			}
+			var resp struct {
+				Data []DictionaryAttribute `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			require.NotEmpty(t, resp.Data)
+			assert.Equal(t, tc.expectedName, resp.Data[0].Name)
+		})
+	}
+}
+
+func TestListDictionaryVendors(t *testing.T) {
+	db, e, appCtx := CreateTestAppContext(t)
+
+	prev := dictionary.Default()
+	defer dictionary.SetDefault(prev)
+
+	dictionary.SetDefault(nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/dictionary/vendors", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, listDictionaryVendors(CreateTestContext(e, db, req, rec, appCtx)))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+	dictionary.SetDefault(dict)
+	rec = httptest.NewRecorder()
+	require.NoError(t, listDictionaryVendors(CreateTestContext(e, db, req, rec, appCtx)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data []DictionaryVendor `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Len(t, resp.Data, len(dict.Vendors()))
+}
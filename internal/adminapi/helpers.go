@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 )
 
 func parsePagination(c echo.Context) (int, int) {
@@ -60,3 +62,18 @@ func parseTimeInput(value string, fallback time.Time) (time.Time, error) {
 	}
 	return time.Time{}, errors.New("invalid time format")
 }
+
+// encodePassword stores password with the requested scheme, falling back to
+// the radius.PasswordScheme setting when no scheme is given.
+func encodePassword(c echo.Context, scheme, password string) (string, error) {
+	if strings.TrimSpace(scheme) == "" {
+		if appCtx, ok := c.Get("appCtx").(app.AppContext); ok && appCtx.ConfigMgr() != nil {
+			scheme = appCtx.ConfigMgr().GetString("radius", "PasswordScheme")
+		}
+	}
+	s, err := passwd.ParseScheme(scheme)
+	if err != nil {
+		return "", err
+	}
+	return passwd.Hash(s, password)
+}
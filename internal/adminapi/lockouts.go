@@ -0,0 +1,114 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/lockout"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+)
+
+// lockoutClearPayload selects the lockouts of a subject to clear
+type lockoutClearPayload struct {
+	Scope   string `json:"scope" validate:"omitempty,oneof=username station nas"`
+	Subject string `json:"subject" validate:"required,max=128"`
+}
+
+// registerLockoutRoutes registers the login lockout routes
+func registerLockoutRoutes() {
+	webserver.ApiGET("/radius-lockouts", listLockouts)
+	webserver.ApiPOST("/radius-lockouts/clear", clearSubjectLockouts)
+	webserver.ApiDELETE("/radius-lockouts/:id", clearLockout)
+}
+
+// listLockouts retrieves the failure counters and lockouts, active lockouts
+// first. active=true only lists the subjects locked now.
+func listLockouts(c echo.Context) error {
+	page, pageSize := parsePagination(c)
+
+	base := GetDB(c).Model(&domain.RadiusLockout{})
+	if scope := strings.TrimSpace(c.QueryParam("scope")); scope != "" {
+		base = base.Where("scope = ?", scope)
+	}
+	if subject := strings.TrimSpace(c.QueryParam("subject")); subject != "" {
+		base = base.Where("subject LIKE ?", "%"+subject+"%")
+	}
+	if c.QueryParam("active") == "true" {
+		base = base.Where("locked_until > ?", time.Now())
+	}
+
+	var total int64
+	if err := base.Count(&total).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query lockouts", err.Error())
+	}
+
+	var lockouts []domain.RadiusLockout
+	if err := base.
+		Order("locked_until DESC, last_failure DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&lockouts).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query lockouts", err.Error())
+	}
+
+	return paged(c, lockouts, total, page, pageSize)
+}
+
+// clearLockout lifts a lockout and resets its failure count and backoff
+func clearLockout(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid lockout ID", nil)
+	}
+
+	db := GetDB(c)
+	var record domain.RadiusLockout
+	if err := db.Where("id = ?", id).First(&record).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "LOCKOUT_NOT_FOUND", "Lockout not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query lockouts", err.Error())
+	}
+	if err := db.Delete(&record).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to clear lockout", err.Error())
+	}
+
+	return ok(c, map[string]interface{}{
+		"id": record.ID,
+	})
+}
+
+// clearSubjectLockouts lifts the lockouts of a username, station or NAS
+// address. Without a scope the subject is cleared in every scope.
+func clearSubjectLockouts(c echo.Context) error {
+	var payload lockoutClearPayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse lockout parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	subject := strings.TrimSpace(payload.Subject)
+	subjects := []string{subject}
+	if station := lockout.StationSubject(subject); station != subject {
+		subjects = append(subjects, station)
+	}
+	query := GetDB(c).Where("subject IN ?", subjects)
+	if payload.Scope != "" {
+		query = query.Where("scope = ?", payload.Scope)
+	}
+	result := query.Delete(&domain.RadiusLockout{})
+	if result.Error != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to clear lockouts", result.Error.Error())
+	}
+
+	return ok(c, map[string]interface{}{
+		"cleared": result.RowsAffected,
+	})
+}
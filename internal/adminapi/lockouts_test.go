@@ -0,0 +1,81 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+)
+
+func TestLockoutRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	call := func(method, target, body string, handler echo.HandlerFunc, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1"+target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if id != "" {
+			c.SetParamNames("id")
+			c.SetParamValues(id)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+	count := func() int64 {
+		var n int64
+		require.NoError(t, db.Model(&domain.RadiusLockout{}).Count(&n).Error)
+		return n
+	}
+
+	now := time.Now()
+	lockouts := []domain.RadiusLockout{
+		{Scope: domain.LockoutScopeUsername, Subject: "alice", Level: 1, LastFailure: now, LockedUntil: now.Add(time.Hour)},
+		{Scope: domain.LockoutScopeStation, Subject: "aa:bb:cc:00:00:01", Level: 1, LastFailure: now, LockedUntil: now.Add(time.Minute)},
+		{Scope: domain.LockoutScopeUsername, Subject: "bob", Failures: 2, LastFailure: now},
+	}
+	require.NoError(t, db.Create(&lockouts).Error)
+
+	rec := call(http.MethodGet, "/radius-lockouts?active=true", "", listLockouts, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data []domain.RadiusLockout `json:"data"`
+		Meta struct {
+			Total int64 `json:"total"`
+		} `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, int64(2), resp.Meta.Total)
+	require.Len(t, resp.Data, 2)
+	assert.Equal(t, "alice", resp.Data[0].Subject)
+
+	rec = call(http.MethodGet, "/radius-lockouts?scope=username", "", listLockouts, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, int64(2), resp.Meta.Total)
+
+	rec = call(http.MethodDelete, "/radius-lockouts/"+fmt.Sprint(lockouts[0].ID), "", clearLockout, fmt.Sprint(lockouts[0].ID))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(2), count())
+	rec = call(http.MethodDelete, "/radius-lockouts/"+fmt.Sprint(lockouts[0].ID), "", clearLockout, fmt.Sprint(lockouts[0].ID))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(http.MethodPost, "/radius-lockouts/clear", `{"scope":"device","subject":"x"}`, clearSubjectLockouts, "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Stations are matched in their normalized form
+	rec = call(http.MethodPost, "/radius-lockouts/clear", `{"subject":"AA-BB-CC-00-00-01"}`, clearSubjectLockouts, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"cleared":1`)
+	assert.Equal(t, int64(1), count())
+}
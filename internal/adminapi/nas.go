@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/webserver"
 )
@@ -260,6 +261,7 @@ func UpdateNAS(c echo.Context) error {
 	if err := GetDB(c).Save(&device).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to update NAS device", err.Error())
 	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeNas, ID: device.ID})
 
 	return ok(c, device)
 }
@@ -288,6 +290,7 @@ func DeleteNAS(c echo.Context) error {
 	if err := GetDB(c).Delete(&domain.NetNas{}, id).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "DELETE_FAILED", "Failed to delete NAS device", err.Error())
 	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeNas, ID: id})
 
 	return ok(c, map[string]interface{}{
 		"message": "Deletion successful",
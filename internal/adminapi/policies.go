@@ -0,0 +1,259 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+// policyRulePayload defines the authorization policy rule request structure
+type policyRulePayload struct {
+	Name     string `json:"name" validate:"required,min=1,max=100"`
+	Priority int    `json:"priority"`
+	Status   string `json:"status" validate:"omitempty,oneof=enabled disabled"`
+	Final    bool   `json:"final"`
+
+	NasId           int64  `json:"nas_id,string"`
+	NodeId          int64  `json:"node_id,string"`
+	CalledStationId string `json:"called_station_id" validate:"omitempty,max=255"`
+	Ssid            string `json:"ssid" validate:"omitempty,max=64"`
+	NasPortTypes    string `json:"nas_port_types" validate:"omitempty,max=100"`
+	TimeWindows     string `json:"time_windows"`
+	ProfileId       int64  `json:"profile_id,string"`
+	Realm           string `json:"realm" validate:"omitempty,max=100"`
+
+	Action        string `json:"action" validate:"required,oneof=accept reject"`
+	RejectMessage string `json:"reject_message" validate:"omitempty,max=253"`
+	SetProfileId  int64  `json:"set_profile_id,string"`
+	ReplyAttrs    string `json:"reply_attrs"`
+	EapMethod     string `json:"eap_method" validate:"omitempty,max=32"`
+
+	Remark string `json:"remark" validate:"omitempty,max=500"`
+}
+
+// policyEvaluatePayload describes the request a rule test is run against.
+// A username fills in the profile and realm, a NAS ID the node.
+type policyEvaluatePayload struct {
+	domain.PolicyRequest
+	Username string `json:"username"`
+}
+
+// registerPolicyRoutes registers authorization policy rule routes
+func registerPolicyRoutes() {
+	webserver.ApiGET("/radius-policies", listPolicyRules)
+	webserver.ApiPOST("/radius-policies/evaluate", evaluatePolicyRules)
+	webserver.ApiGET("/radius-policies/:id", getPolicyRule)
+	webserver.ApiPOST("/radius-policies", createPolicyRule)
+	webserver.ApiPUT("/radius-policies/:id", updatePolicyRule)
+	webserver.ApiDELETE("/radius-policies/:id", deletePolicyRule)
+}
+
+// listPolicyRules retrieves the rules in evaluation order
+func listPolicyRules(c echo.Context) error {
+	page, pageSize := parsePagination(c)
+
+	base := GetDB(c).Model(&domain.PolicyRule{})
+	if name := strings.TrimSpace(c.QueryParam("name")); name != "" {
+		if strings.EqualFold(base.Name(), "postgres") { //nolint:staticcheck
+			base = base.Where("name ILIKE ?", "%"+name+"%")
+		} else {
+			base = base.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(name)+"%")
+		}
+	}
+	if status := strings.TrimSpace(c.QueryParam("status")); status != "" {
+		base = base.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := base.Count(&total).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query policy rules", err.Error())
+	}
+
+	var rules []domain.PolicyRule
+	if err := base.
+		Order("priority ASC, id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&rules).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query policy rules", err.Error())
+	}
+
+	return paged(c, rules, total, page, pageSize)
+}
+
+// getPolicyRule retrieves a single rule
+func getPolicyRule(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid policy rule ID", nil)
+	}
+
+	var rule domain.PolicyRule
+	if err := GetDB(c).Where("id = ?", id).First(&rule).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "POLICY_NOT_FOUND", "Policy rule not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query policy rules", err.Error())
+	}
+
+	return ok(c, rule)
+}
+
+// createPolicyRule creates a rule
+func createPolicyRule(c echo.Context) error {
+	var payload policyRulePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse policy rule parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	rule := domain.PolicyRule{CreatedAt: time.Now()}
+	payload.applyTo(&rule)
+	if err := rule.Validate(); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_POLICY", err.Error(), nil)
+	}
+
+	if err := GetDB(c).Create(&rule).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to create policy rule", err.Error())
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangePolicy, ID: rule.ID})
+
+	return ok(c, rule)
+}
+
+// updatePolicyRule replaces the conditions and actions of a rule
+func updatePolicyRule(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid policy rule ID", nil)
+	}
+
+	var payload policyRulePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse policy rule parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	var rule domain.PolicyRule
+	if err := GetDB(c).Where("id = ?", id).First(&rule).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "POLICY_NOT_FOUND", "Policy rule not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query policy rules", err.Error())
+	}
+
+	payload.applyTo(&rule)
+	if err := rule.Validate(); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_POLICY", err.Error(), nil)
+	}
+
+	if err := GetDB(c).Save(&rule).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to update policy rule", err.Error())
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangePolicy, ID: rule.ID})
+
+	return ok(c, rule)
+}
+
+// deletePolicyRule deletes a rule
+func deletePolicyRule(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid policy rule ID", nil)
+	}
+
+	if err := GetDB(c).Where("id = ?", id).Delete(&domain.PolicyRule{}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to delete policy rule", err.Error())
+	}
+	publishChange(c, app.ChangeEvent{Kind: app.ChangePolicy, ID: id})
+
+	return ok(c, map[string]interface{}{
+		"id": id,
+	})
+}
+
+// evaluatePolicyRules runs the saved rules against a test request without
+// authenticating anyone, so operators can check a rule before relying on it
+func evaluatePolicyRules(c echo.Context) error {
+	var payload policyEvaluatePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse evaluation parameters", nil)
+	}
+	req := payload.PolicyRequest
+	if req.Time.IsZero() {
+		req.Time = time.Now()
+	}
+
+	db := GetDB(c)
+	if username := strings.TrimSpace(payload.Username); username != "" {
+		var user domain.RadiusUser
+		if err := db.Where("username = ?", username).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+			return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+		} else if err != nil {
+			return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+		}
+		if req.ProfileId == 0 {
+			req.ProfileId = user.ProfileId
+		}
+		if idx := strings.LastIndex(username, "@"); req.Realm == "" && idx >= 0 {
+			req.Realm = username[idx+1:]
+		}
+	}
+	if req.NasId != 0 && req.NodeId == 0 {
+		var nas domain.NetNas
+		if err := db.Where("id = ?", req.NasId).First(&nas).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+			return fail(c, http.StatusNotFound, "NAS_NOT_FOUND", "NAS not found", nil)
+		} else if err != nil {
+			return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query NAS devices", err.Error())
+		}
+		req.NodeId = nas.NodeId
+	}
+
+	// Read the table rather than the cache so the latest edits are tested
+	var rules []domain.PolicyRule
+	if err := db.Where("status = ?", common.ENABLED).Find(&rules).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query policy rules", err.Error())
+	}
+	set, err := domain.NewPolicyRuleSet(rules)
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_POLICY", err.Error(), nil)
+	}
+
+	return ok(c, set.Evaluate(req))
+}
+
+func (p *policyRulePayload) applyTo(rule *domain.PolicyRule) {
+	rule.Name = strings.TrimSpace(p.Name)
+	rule.Priority = p.Priority
+	rule.Status = p.Status
+	if rule.Status == "" {
+		rule.Status = common.ENABLED
+	}
+	rule.Final = p.Final
+	rule.NasId = p.NasId
+	rule.NodeId = p.NodeId
+	rule.CalledStationId = strings.TrimSpace(p.CalledStationId)
+	rule.Ssid = strings.TrimSpace(p.Ssid)
+	rule.NasPortTypes = strings.TrimSpace(p.NasPortTypes)
+	rule.TimeWindows = strings.TrimSpace(p.TimeWindows)
+	rule.ProfileId = p.ProfileId
+	rule.Realm = strings.TrimSpace(p.Realm)
+	rule.Action = p.Action
+	rule.RejectMessage = strings.TrimSpace(p.RejectMessage)
+	rule.SetProfileId = p.SetProfileId
+	rule.ReplyAttrs = strings.TrimSpace(p.ReplyAttrs)
+	rule.EapMethod = strings.ToLower(strings.TrimSpace(p.EapMethod))
+	rule.Remark = strings.TrimSpace(p.Remark)
+	rule.UpdatedAt = time.Now()
+}
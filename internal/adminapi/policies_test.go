@@ -0,0 +1,107 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+func TestPolicyRuleRoutes(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&domain.PolicyRule{}))
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	call := func(method, path, body string, handler echo.HandlerFunc, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1"+path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		if id != "" {
+			c.SetParamNames("id")
+			c.SetParamValues(id)
+		}
+		handleTestError(rec, handler(c))
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder, v interface{}) {
+		var resp struct {
+			Data json.RawMessage `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NoError(t, json.Unmarshal(resp.Data, v))
+	}
+
+	// Invalid rules are refused
+	rec := call(http.MethodPost, "/radius-policies", `{"name":"bad","action":"accept","called_station_id":"("}`, createPolicyRule, "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = call(http.MethodPost, "/radius-policies", `{"name":"bad","action":"drop"}`, createPolicyRule, "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = call(http.MethodPost, "/radius-policies",
+		`{"name":"guest","priority":20,"action":"accept","ssid":"Guest","reply_attrs":"[{\"attribute\":\"Filter-Id\",\"value\":\"guest\"}]"}`,
+		createPolicyRule, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var guest domain.PolicyRule
+	decode(rec, &guest)
+	assert.Equal(t, common.ENABLED, guest.Status)
+
+	rec = call(http.MethodPost, "/radius-policies",
+		`{"name":"partner","priority":10,"action":"reject","realm":"partner.example","reject_message":"partner access suspended"}`,
+		createPolicyRule, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var partner domain.PolicyRule
+	decode(rec, &partner)
+
+	// Listed in evaluation order
+	rec = call(http.MethodGet, "/radius-policies", "", listPolicyRules, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed []domain.PolicyRule
+	decode(rec, &listed)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "partner", listed[0].Name)
+
+	// Test evaluation, the username fills in the realm
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "carol@partner.example", ProfileId: 3, Status: common.ENABLED, ExpireTime: time.Now().AddDate(0, 1, 0)}
+	require.NoError(t, db.Create(user).Error)
+	rec = call(http.MethodPost, "/radius-policies/evaluate", `{"username":"carol@partner.example","ssid":"guest"}`, evaluatePolicyRules, "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var decision domain.PolicyDecision
+	decode(rec, &decision)
+	assert.True(t, decision.Reject)
+	assert.Equal(t, []string{"partner"}, decision.Matched)
+	assert.Equal(t, "partner access suspended", decision.RejectMessage)
+
+	// Disabling the rule takes it out of the evaluation
+	rec = call(http.MethodPut, "/radius-policies/"+fmt.Sprint(partner.ID),
+		`{"name":"partner","priority":10,"status":"disabled","action":"reject","realm":"partner.example"}`,
+		updatePolicyRule, fmt.Sprint(partner.ID))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = call(http.MethodPost, "/radius-policies/evaluate", `{"username":"carol@partner.example","ssid":"guest"}`, evaluatePolicyRules, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	decision = domain.PolicyDecision{}
+	decode(rec, &decision)
+	assert.False(t, decision.Reject)
+	assert.Equal(t, []string{"guest"}, decision.Matched)
+	require.Len(t, decision.ReplyAttrs, 1)
+
+	rec = call(http.MethodPost, "/radius-policies/evaluate", `{"username":"nobody"}`, evaluatePolicyRules, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(http.MethodGet, "/radius-policies/"+fmt.Sprint(guest.ID), "", getPolicyRule, fmt.Sprint(guest.ID))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = call(http.MethodDelete, "/radius-policies/"+fmt.Sprint(guest.ID), "", deletePolicyRule, fmt.Sprint(guest.ID))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = call(http.MethodGet, "/radius-policies/"+fmt.Sprint(guest.ID), "", getPolicyRule, fmt.Sprint(guest.ID))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -378,6 +378,7 @@ type PppoeUserRequest struct {
         ProfileId             interface{} `json:"profile_id" validate:"required"`
         Username              string      `json:"username" validate:"required,min=1,max=100"`
         Password              string      `json:"password" validate:"omitempty,min=6,max=128"`
+        PwdScheme             string      `json:"password_scheme" validate:"omitempty,max=20"`
         Realname              string      `json:"realname" validate:"omitempty,max=100"`
         Mobile                string      `json:"mobile" validate:"omitempty,max=20"`
         Email                 string      `json:"email" validate:"omitempty,email,max=100"`
@@ -606,6 +607,9 @@ func CreatePppoeUser(c echo.Context) error {
         }
         user.CreatedAt = time.Now()
         user.UpdatedAt = time.Now()
+        if user.Password, err = encodePassword(c, req.PwdScheme, user.Password); err != nil {
+                return fail(c, http.StatusBadRequest, "INVALID_PASSWORD_SCHEME", "Password cannot be stored with this scheme", err.Error())
+        }
 
         if err := GetDB(c).Create(user).Error; err != nil {
                 return fail(c, http.StatusInternalServerError, "CREATE_FAILED", "Failed to create user", err.Error())
@@ -664,7 +668,11 @@ func UpdatePppoeUser(c echo.Context) error {
                 updates["username"] = updateData.Username
         }
         if req.Password != "" {
-                updates["password"] = req.Password
+                password, err := encodePassword(c, req.PwdScheme, req.Password)
+                if err != nil {
+                        return fail(c, http.StatusBadRequest, "INVALID_PASSWORD_SCHEME", "Password cannot be stored with this scheme", err.Error())
+                }
+                updates["password"] = password
         }
         if updateData.Realname != "" {
                 updates["realname"] = updateData.Realname
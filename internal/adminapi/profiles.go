@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/webserver"
 )
@@ -111,12 +112,22 @@ type ProfileRequest struct {
 	Status         interface{} `json:"status"` // Can be string or boolean
 	AddrPool       string      `json:"addr_pool" validate:"omitempty,addrpool"`
 	ActiveNum      int         `json:"active_num" validate:"gte=0,lte=100"`
+	SessionPolicy  string      `json:"session_policy" validate:"omitempty,oneof=reject_new disconnect_oldest disconnect_others"`
 	UpRate         int         `json:"up_rate" validate:"gte=0,lte=10000000"`
 	DownRate       int         `json:"down_rate" validate:"gte=0,lte=10000000"`
 	Domain         string      `json:"domain" validate:"omitempty,max=50"`
 	IPv6PrefixPool string      `json:"ipv6_prefix_pool" validate:"omitempty"`
 	BindMac        interface{} `json:"bind_mac"`  // Can be int or boolean
 	BindVlan       interface{} `json:"bind_vlan"` // Can be int or boolean
+	MaxDevices     int         `json:"max_devices" validate:"gte=0,lte=100"`
+	Price          int64       `json:"price" validate:"gte=0"`
+	RenewPeriod    int         `json:"renew_period" validate:"gte=0,lte=3650"`
+	AccessWindows  string      `json:"access_windows"` // JSON list of allowed login windows
+	RateSchedule   string      `json:"rate_schedule"`  // JSON list of time-banded rates
+	RoleName       string      `json:"role_name" validate:"omitempty,max=64"`
+	DynamicVlan    int         `json:"dynamic_vlan" validate:"gte=0,lte=4094"`
+	NodeBind       int         `json:"node_bind" validate:"gte=0,lte=2"` // 0=system setting 1=on 2=off
+	AllowedNodes   string      `json:"allowed_nodes" validate:"omitempty,max=255"`
 	Remark         string      `json:"remark" validate:"omitempty,max=500"`
 	NodeId         interface{} `json:"node_id"` // Can be int64 or string
 }
@@ -127,10 +138,20 @@ func (pr *ProfileRequest) toRadiusProfile() *domain.RadiusProfile {
 		Name:           pr.Name,
 		AddrPool:       pr.AddrPool,
 		ActiveNum:      pr.ActiveNum,
+		SessionPolicy:  pr.SessionPolicy,
 		UpRate:         pr.UpRate,
 		DownRate:       pr.DownRate,
 		Domain:         pr.Domain,
 		IPv6PrefixPool: pr.IPv6PrefixPool,
+		MaxDevices:     pr.MaxDevices,
+		Price:          pr.Price,
+		RenewPeriod:    pr.RenewPeriod,
+		AccessWindows:  pr.AccessWindows,
+		RateSchedule:   pr.RateSchedule,
+		RoleName:       pr.RoleName,
+		DynamicVlan:    pr.DynamicVlan,
+		NodeBind:       pr.NodeBind,
+		AllowedNodes:   strings.TrimSpace(pr.AllowedNodes),
 		Remark:         pr.Remark,
 	}
 
@@ -190,12 +211,22 @@ type ProfileUpdateRequest struct {
 	Status         interface{} `json:"status"` // Can be string or boolean
 	AddrPool       string      `json:"addr_pool" validate:"omitempty,addrpool"`
 	ActiveNum      int         `json:"active_num" validate:"gte=0,lte=100"`
+	SessionPolicy  *string     `json:"session_policy" validate:"omitempty,oneof=reject_new disconnect_oldest disconnect_others"`
 	UpRate         int         `json:"up_rate" validate:"gte=0,lte=10000000"`
 	DownRate       int         `json:"down_rate" validate:"gte=0,lte=10000000"`
 	Domain         string      `json:"domain" validate:"omitempty,max=50"`
 	IPv6PrefixPool string      `json:"ipv6_prefix_pool" validate:"omitempty"`
 	BindMac        interface{} `json:"bind_mac"`  // Can be int or boolean
 	BindVlan       interface{} `json:"bind_vlan"` // Can be int or boolean
+	MaxDevices     *int        `json:"max_devices" validate:"omitempty,gte=0,lte=100"`
+	Price          *int64      `json:"price" validate:"omitempty,gte=0"`
+	RenewPeriod    *int        `json:"renew_period" validate:"omitempty,gte=0,lte=3650"`
+	AccessWindows  *string     `json:"access_windows"` // Empty string clears the windows
+	RateSchedule   *string     `json:"rate_schedule"`  // Empty string clears the schedule
+	RoleName       *string     `json:"role_name" validate:"omitempty,max=64"`
+	DynamicVlan    *int        `json:"dynamic_vlan" validate:"omitempty,gte=0,lte=4094"`
+	NodeBind       *int        `json:"node_bind" validate:"omitempty,gte=0,lte=2"`
+	AllowedNodes   *string     `json:"allowed_nodes" validate:"omitempty,max=255"` // Empty string clears the list
 	Remark         string      `json:"remark" validate:"omitempty,max=500"`
 	NodeId         interface{} `json:"node_id"` // Can be int64 or string
 }
@@ -283,6 +314,13 @@ func CreateProfile(c echo.Context) error {
 	// Convert to RadiusProfile
 	profile := req.toRadiusProfile()
 
+	if err := validateProfileSchedules(profile.AccessWindows, profile.RateSchedule); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_SCHEDULE", err.Error(), nil)
+	}
+	if _, err := profile.AllowedNodeList(); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ALLOWED_NODES", err.Error(), nil)
+	}
+
 	// Check whether a profile with the same name already exists (business logic validation)
 	var count int64
 	GetDB(c).Model(&domain.RadiusProfile{}).Where("name = ?", profile.Name).Count(&count)
@@ -379,13 +417,55 @@ func UpdateProfile(c echo.Context) error {
 	if updateData.NodeId > 0 {
 		updates["node_id"] = updateData.NodeId
 	}
+	if req.MaxDevices != nil {
+		updates["max_devices"] = *req.MaxDevices
+	}
+	if req.Price != nil {
+		updates["price"] = *req.Price
+	}
+	if req.RenewPeriod != nil {
+		updates["renew_period"] = *req.RenewPeriod
+	}
+	if req.RoleName != nil {
+		updates["role_name"] = strings.TrimSpace(*req.RoleName)
+	}
+	if req.DynamicVlan != nil {
+		updates["dynamic_vlan"] = *req.DynamicVlan
+	}
+	if req.NodeBind != nil {
+		updates["node_bind"] = *req.NodeBind
+	}
+	if req.SessionPolicy != nil {
+		updates["session_policy"] = *req.SessionPolicy
+	}
+	if req.AllowedNodes != nil {
+		allowed := &domain.RadiusProfile{AllowedNodes: strings.TrimSpace(*req.AllowedNodes)}
+		if _, err := allowed.AllowedNodeList(); err != nil {
+			return fail(c, http.StatusBadRequest, "INVALID_ALLOWED_NODES", err.Error(), nil)
+		}
+		updates["allowed_nodes"] = allowed.AllowedNodes
+	}
+	if req.AccessWindows != nil || req.RateSchedule != nil {
+		accessWindows, rateSchedule := profile.AccessWindows, profile.RateSchedule
+		if req.AccessWindows != nil {
+			accessWindows = strings.TrimSpace(*req.AccessWindows)
+			updates["access_windows"] = accessWindows
+		}
+		if req.RateSchedule != nil {
+			rateSchedule = strings.TrimSpace(*req.RateSchedule)
+			updates["rate_schedule"] = rateSchedule
+		}
+		if err := validateProfileSchedules(accessWindows, rateSchedule); err != nil {
+			return fail(c, http.StatusBadRequest, "INVALID_SCHEDULE", err.Error(), nil)
+		}
+	}
 
 	if err := GetDB(c).Model(&profile).Updates(updates).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "UPDATE_FAILED", "Failed to update profile", err.Error())
 	}
 
 	// Invalidate profile cache for dynamic users
-	GetAppContext(c).ProfileCache().Invalidate(id)
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeProfile, ID: id})
 
 	// Re-query latest data
 	GetDB(c).First(&profile, id)
@@ -419,7 +499,7 @@ func DeleteProfile(c echo.Context) error {
 	}
 
 	// Invalidate profile cache
-	GetAppContext(c).ProfileCache().Invalidate(id)
+	publishChange(c, app.ChangeEvent{Kind: app.ChangeProfile, ID: id})
 
 	return ok(c, map[string]interface{}{
 		"message": "Deletion successful",
@@ -434,3 +514,14 @@ func registerProfileRoutes() {
 	webserver.ApiPUT("/radius-profiles/:id", UpdateProfile)
 	webserver.ApiDELETE("/radius-profiles/:id", DeleteProfile)
 }
+
+// validateProfileSchedules checks the access window and rate schedule JSON
+func validateProfileSchedules(accessWindows, rateSchedule string) error {
+	if _, err := domain.ParseTimeWindows(accessWindows); err != nil {
+		return err
+	}
+	if _, err := domain.ParseRateBands(rateSchedule); err != nil {
+		return err
+	}
+	return nil
+}
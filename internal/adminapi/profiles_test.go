@@ -405,6 +405,73 @@ func TestUpdateProfile(t *testing.T) {
 				assert.Equal(t, "disabled", p.Status)
 			},
 		},
+		{
+			name:      "Update access windows and rate schedule",
+			profileID: "1",
+			requestBody: `{
+				"access_windows": "[{\"days\":\"mon-fri\",\"start\":\"08:00\",\"end\":\"18:00\"}]",
+				"rate_schedule": "[{\"days\":\"*\",\"start\":\"00:00\",\"end\":\"06:00\",\"up_rate\":40960,\"down_rate\":81920}]"
+			}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, p *domain.RadiusProfile) {
+				windows, err := p.AccessWindowList()
+				require.NoError(t, err)
+				assert.Len(t, windows, 1)
+				bands, err := p.RateBandList()
+				require.NoError(t, err)
+				assert.Equal(t, 81920, bands[0].DownRate)
+			},
+		},
+		{
+			name:           "Update WLAN role and dynamic VLAN",
+			profileID:      "1",
+			requestBody:    `{"role_name": "staff", "dynamic_vlan": 120}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, p *domain.RadiusProfile) {
+				assert.Equal(t, "staff", p.RoleName)
+				assert.Equal(t, 120, p.DynamicVlan)
+			},
+		},
+		{
+			name:           "Update node binding",
+			profileID:      "1",
+			requestBody:    `{"node_bind": 1, "allowed_nodes": " 3,7 "}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, p *domain.RadiusProfile) {
+				assert.Equal(t, domain.NodeBindOn, p.NodeBind)
+				assert.Equal(t, "3,7", p.AllowedNodes)
+			},
+		},
+		{
+			name:           "Update session policy",
+			profileID:      "1",
+			requestBody:    `{"session_policy": "disconnect_oldest"}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, p *domain.RadiusProfile) {
+				assert.Equal(t, domain.SessionPolicyDisconnectOldest, p.SessionPolicy)
+			},
+		},
+		{
+			name:           "Invalid session policy",
+			profileID:      "1",
+			requestBody:    `{"session_policy": "disconnect_newest"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "VALIDATION_ERROR",
+		},
+		{
+			name:           "Invalid allowed nodes",
+			profileID:      "1",
+			requestBody:    `{"allowed_nodes": "branch-b"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_ALLOWED_NODES",
+		},
+		{
+			name:           "Invalid access windows",
+			profileID:      "1",
+			requestBody:    `{"access_windows": "[{\"days\":\"someday\",\"start\":\"08:00\",\"end\":\"18:00\"}]"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_SCHEDULE",
+		},
 		{
 			name:      "Name conflict",
 			profileID: "1",
@@ -66,6 +66,7 @@ func parseSessionTime(s string) (time.Time, error) {
 // @Param framed_ipaddr query string false "User IP address"
 // @Param framed_ipv6addr query string false "User IPv6 address"
 // @Param mac_addr query string false "MAC address"
+// @Param circuit_id query string false "Agent-Circuit-Id of the access line"
 // @Param acct_session_id query string false "Session ID"
 // @Param acct_start_time_gte query string false "Start time from (RFC3339 or datetime-local)"
 // @Param acct_start_time_lte query string false "Start time to (RFC3339 or datetime-local)"
@@ -101,7 +102,7 @@ func ListOnlineSessions(c echo.Context) error {
 
 	// Filter by username (LIKE with escaped pattern)
 	if username := c.QueryParam("username"); username != "" {
-		query = query.Where("username LIKE ?", "%"+escapeSessionLikePattern(username)+"%")
+		query = query.Where("username LIKE ?"+likeEscape(db), "%"+escapeSessionLikePattern(username)+"%")
 	}
 
 	// Filter by NAS address
@@ -116,17 +117,22 @@ func ListOnlineSessions(c echo.Context) error {
 
 	// Filter by IPv6 address (LIKE with escaped pattern)
 	if framedIpv6 := c.QueryParam("framed_ipv6addr"); framedIpv6 != "" {
-		query = query.Where("framed_ipv6addr LIKE ?", "%"+escapeSessionLikePattern(framedIpv6)+"%")
+		query = query.Where("framed_ipv6addr LIKE ?"+likeEscape(db), "%"+escapeSessionLikePattern(framedIpv6)+"%")
 	}
 
 	// Filter by MAC address (LIKE with escaped pattern)
 	if macAddr := c.QueryParam("mac_addr"); macAddr != "" {
-		query = query.Where("mac_addr LIKE ?", "%"+escapeSessionLikePattern(macAddr)+"%")
+		query = query.Where("mac_addr LIKE ?"+likeEscape(db), "%"+escapeSessionLikePattern(macAddr)+"%")
+	}
+
+	// Filter by access line (LIKE with escaped pattern)
+	if circuitId := c.QueryParam("circuit_id"); circuitId != "" {
+		query = query.Where("circuit_id LIKE ?"+likeEscape(db), "%"+escapeSessionLikePattern(circuitId)+"%")
 	}
 
 	// Filter by Session ID (LIKE with escaped pattern)
 	if acctSessionId := c.QueryParam("acct_session_id"); acctSessionId != "" {
-		query = query.Where("acct_session_id LIKE ?", "%"+escapeSessionLikePattern(acctSessionId)+"%")
+		query = query.Where("acct_session_id LIKE ?"+likeEscape(db), "%"+escapeSessionLikePattern(acctSessionId)+"%")
 	}
 
 	// Filter by start time range
@@ -268,7 +268,11 @@ func applySettingsFilters(db *gorm.DB, c echo.Context) *gorm.DB {
 	}
 
 	if name := strings.TrimSpace(c.QueryParam("name")); name != "" {
-		db = db.Where("name ILIKE ?", "%"+name+"%")
+		if strings.EqualFold(db.Name(), "postgres") { //nolint:staticcheck
+			db = db.Where("name ILIKE ?", "%"+name+"%")
+		} else {
+			db = db.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(name)+"%")
+		}
 	}
 
 	return db
@@ -0,0 +1,91 @@
+package adminapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+)
+
+// AuthSimulator runs authorization dry runs, implemented by *radiusd.AuthService
+type AuthSimulator interface {
+	Simulate(ctx context.Context, req radiusd.SimulateRequest) (*radiusd.SimulateResult, error)
+}
+
+var (
+	authSimulatorMu sync.RWMutex
+	authSimulator   AuthSimulator
+)
+
+// SetAuthSimulator installs the service answering authorization dry runs.
+// The RADIUS service is started after the admin API, until it is set the
+// simulate endpoint is unavailable.
+func SetAuthSimulator(simulator AuthSimulator) {
+	authSimulatorMu.Lock()
+	defer authSimulatorMu.Unlock()
+	authSimulator = simulator
+}
+
+func getAuthSimulator() AuthSimulator {
+	authSimulatorMu.RLock()
+	defer authSimulatorMu.RUnlock()
+	return authSimulator
+}
+
+// simulatePayload describes the Access-Request of a dry run
+type simulatePayload struct {
+	Username         string `json:"username" validate:"max=255"`
+	Password         string `json:"password" validate:"max=128"`
+	Method           string `json:"method" validate:"omitempty,oneof=pap chap mac eap"`
+	NasIP            string `json:"nas_ip" validate:"required,ip"`
+	NasIdentifier    string `json:"nas_identifier" validate:"max=253"`
+	MacAddr          string `json:"mac_addr" validate:"max=64"`
+	Vlanid1          int64  `json:"vlanid1" validate:"min=0,max=4095"`
+	Vlanid2          int64  `json:"vlanid2" validate:"min=0,max=4095"`
+	CallingStationID string `json:"calling_station_id" validate:"max=253"`
+	EapMethod        string `json:"eap_method" validate:"max=32"`
+}
+
+// registerSimulateRoutes registers the authorization dry run route
+func registerSimulateRoutes() {
+	webserver.ApiPOST("/radius/simulate", simulateAuth)
+}
+
+// simulateAuth runs the auth pipeline for a synthetic request and returns
+// the trace of every stage, policy checker and response enhancer. Nothing is
+// changed and no NAS is contacted, so support can replay a login at will.
+func simulateAuth(c echo.Context) error {
+	simulator := getAuthSimulator()
+	if simulator == nil {
+		return fail(c, http.StatusServiceUnavailable, "RADIUS_NOT_RUNNING", "RADIUS service is not running", nil)
+	}
+
+	var payload simulatePayload
+	if err := c.Bind(&payload); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse simulation parameters", nil)
+	}
+	if err := c.Validate(&payload); err != nil {
+		return handleValidationError(c, err)
+	}
+
+	result, err := simulator.Simulate(c.Request().Context(), radiusd.SimulateRequest{
+		Username:         payload.Username,
+		Password:         payload.Password,
+		Method:           payload.Method,
+		NasIP:            payload.NasIP,
+		NasIdentifier:    payload.NasIdentifier,
+		MacAddr:          payload.MacAddr,
+		Vlanid1:          payload.Vlanid1,
+		Vlanid2:          payload.Vlanid2,
+		CallingStationID: payload.CallingStationID,
+		EapMethod:        payload.EapMethod,
+	})
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", err.Error(), nil)
+	}
+	return ok(c, result)
+}
@@ -0,0 +1,69 @@
+package adminapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd"
+)
+
+// stubSimulator answers every dry run with a fixed result
+type stubSimulator struct {
+	got radiusd.SimulateRequest
+}
+
+func (s *stubSimulator) Simulate(_ context.Context, req radiusd.SimulateRequest) (*radiusd.SimulateResult, error) {
+	s.got = req
+	if req.Username == "broken" {
+		return nil, fmt.Errorf("username is required")
+	}
+	return &radiusd.SimulateResult{
+		Decision: radiusd.SimulateReject,
+		Stage:    radiusd.StageLoadUser,
+		Stages:   []radiusd.SimulateStep{{Name: radiusd.StageLoadUser, Result: radiusd.SimulateResultReject}},
+	}, nil
+}
+
+func TestSimulateAuth(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+	t.Cleanup(func() { SetAuthSimulator(nil) })
+
+	call := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/radius/simulate", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		handleTestError(rec, simulateAuth(CreateTestContext(e, db, req, rec, appCtx)))
+		return rec
+	}
+
+	SetAuthSimulator(nil)
+	assert.Equal(t, http.StatusServiceUnavailable, call(`{"username":"alice","nas_ip":"10.0.0.1"}`).Code)
+
+	simulator := &stubSimulator{}
+	SetAuthSimulator(simulator)
+
+	rec := call(`{"username":"alice","password":"secret","nas_ip":"10.0.0.1","mac_addr":"AA:BB:CC:00:00:01","vlanid1":100}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Data radiusd.SimulateResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, radiusd.SimulateReject, resp.Data.Decision)
+	require.Len(t, resp.Data.Stages, 1)
+	assert.Equal(t, "AA:BB:CC:00:00:01", simulator.got.MacAddr)
+	assert.Equal(t, int64(100), simulator.got.Vlanid1)
+
+	assert.Equal(t, http.StatusBadRequest, call(`{"username":"alice","nas_ip":"nas-1"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, call(`{"username":"alice","nas_ip":"10.0.0.1","method":"mschap"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, call(`{"username":"broken","nas_ip":"10.0.0.1"}`).Code)
+}
@@ -31,9 +31,12 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	err = db.AutoMigrate(
 		&domain.RadiusProfile{},
 		&domain.RadiusUser{},
+		&domain.RadiusUserDevice{},
+		&domain.RadiusLockout{},
 		&domain.NetNode{},
 		&domain.NetNas{},
 		&domain.RadiusAccounting{},
+		&domain.RadiusAuthLog{},
 		&domain.RadiusOnline{},
 		&domain.SysOpr{},
 		&domain.SysConfig{},
@@ -95,9 +98,12 @@ func CreateTestAppContext(t *testing.T) (*gorm.DB, *echo.Echo, app.AppContext) {
 	err := db.AutoMigrate(
 		&domain.RadiusProfile{},
 		&domain.RadiusUser{},
+		&domain.RadiusUserDevice{},
+		&domain.RadiusLockout{},
 		&domain.NetNode{},
 		&domain.NetNas{},
 		&domain.RadiusAccounting{},
+		&domain.RadiusAuthLog{},
 		&domain.RadiusOnline{},
 		&domain.SysOpr{},
 		&domain.SysConfig{},
@@ -0,0 +1,103 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+	"gorm.io/gorm"
+)
+
+// totpIssuer labels the account in authenticator apps
+const totpIssuer = "ToughRADIUS"
+
+// TotpEnrollment is returned when a TOTP secret is generated for a user. The
+// secret is only shown once, it is never returned by the user endpoints.
+type TotpEnrollment struct {
+	UserId   int64  `json:"user_id,string"`
+	Username string `json:"username"`
+	Enabled  bool   `json:"enabled"`
+	Secret   string `json:"secret,omitempty"`
+	URI      string `json:"uri,omitempty"`
+}
+
+func registerTotpRoutes() {
+	webserver.ApiGET("/users/:id/totp", getUserTotp)
+	webserver.ApiPOST("/users/:id/totp", enrollUserTotp)
+	webserver.ApiDELETE("/users/:id/totp", disableUserTotp)
+}
+
+func getUserTotp(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+	return ok(c, TotpEnrollment{UserId: user.ID, Username: user.Username, Enabled: user.TotpSecret != ""})
+}
+
+// enrollUserTotp generates a new secret, replacing any previous one
+func enrollUserTotp(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return fail(c, http.StatusInternalServerError, "TOTP_ERROR", "Failed to generate TOTP secret", err.Error())
+	}
+	if err := updateTotpSecret(c, &user, secret); err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to update user", err.Error())
+	}
+	return ok(c, TotpEnrollment{
+		UserId:   user.ID,
+		Username: user.Username,
+		Enabled:  true,
+		Secret:   secret,
+		URI:      totp.URI(totpIssuer, user.Username, secret),
+	})
+}
+
+func disableUserTotp(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+	if err := updateTotpSecret(c, &user, ""); err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to update user", err.Error())
+	}
+	return ok(c, TotpEnrollment{UserId: user.ID, Username: user.Username, Enabled: false})
+}
+
+func updateTotpSecret(c echo.Context, user *domain.RadiusUser, secret string) error {
+	if err := GetDB(c).Model(user).Updates(map[string]interface{}{
+		"totp_secret": secret,
+		"updated_at":  time.Now(),
+	}).Error; err != nil {
+		return err
+	}
+	publishUserChange(c, user)
+	return nil
+}
@@ -0,0 +1,83 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+)
+
+func TestUserTotpEnrollment(t *testing.T) {
+	db := setupTestDB(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	user := &domain.RadiusUser{
+		ID:         common.UUIDint64(),
+		Username:   "totp-user",
+		Password:   "secret",
+		Status:     common.ENABLED,
+		ExpireTime: time.Now().AddDate(0, 1, 0),
+	}
+	require.NoError(t, db.Create(user).Error)
+
+	call := func(method string, handler echo.HandlerFunc, userID string) (*httptest.ResponseRecorder, TotpEnrollment) {
+		req := httptest.NewRequest(method, "/api/v1/users/"+userID+"/totp", nil)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		c.SetParamNames("id")
+		c.SetParamValues(userID)
+		require.NoError(t, handler(c))
+
+		var resp struct {
+			Data TotpEnrollment `json:"data"`
+		}
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		}
+		return rec, resp.Data
+	}
+	userID := fmt.Sprint(user.ID)
+
+	rec, status := call(http.MethodGet, getUserTotp, userID)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, status.Enabled)
+
+	rec, enrolled := call(http.MethodPost, enrollUserTotp, userID)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, enrolled.Enabled)
+	assert.Contains(t, enrolled.URI, "otpauth://totp/ToughRADIUS:totp-user")
+	_, err := totp.ParseSecret(enrolled.Secret)
+	require.NoError(t, err)
+
+	var stored domain.RadiusUser
+	require.NoError(t, db.First(&stored, user.ID).Error)
+	assert.Equal(t, enrolled.Secret, stored.TotpSecret)
+
+	// The secret is never shown again
+	rec, status = call(http.MethodGet, getUserTotp, userID)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, status.Enabled)
+	assert.Empty(t, status.Secret)
+	assert.NotContains(t, rec.Body.String(), enrolled.Secret)
+
+	rec, status = call(http.MethodDelete, disableUserTotp, userID)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.False(t, status.Enabled)
+	require.NoError(t, db.First(&stored, user.ID).Error)
+	assert.Empty(t, stored.TotpSecret)
+
+	rec, _ = call(http.MethodPost, enrollUserTotp, "12345")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec, _ = call(http.MethodGet, getUserTotp, "abc")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
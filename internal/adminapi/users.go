@@ -48,14 +48,20 @@ type UserRequest struct {
 	Address    string      `json:"address" validate:"omitempty,max=255"`        // addresses
 	Username   string      `json:"username" validate:"required,min=3,max=50"`   // Username
 	Password   string      `json:"password" validate:"omitempty,min=6,max=128"` // Password
+	PwdScheme  string      `json:"password_scheme" validate:"omitempty,max=20"` // Password storage scheme
 	AddrPool   string      `json:"addr_pool" validate:"omitempty,max=50"`       // Address pool
 	Vlanid1    int         `json:"vlanid1" validate:"gte=0,lte=4096"`           // VLAN ID 1
 	Vlanid2    int         `json:"vlanid2" validate:"gte=0,lte=4096"`           // VLAN ID 2
 	IpAddr     string      `json:"ip_addr" validate:"omitempty,ipv4"`           // IPv4addresses
 	Ipv6Addr   string      `json:"ipv6_addr" validate:"omitempty"`              // IPv6addresses
 	MacAddr    string      `json:"mac_addr" validate:"omitempty,mac"`           // MACaddresses
+	CircuitId  string      `json:"circuit_id" validate:"omitempty,max=253"`     // Bound Agent-Circuit-Id
+	RemoteId   string      `json:"remote_id" validate:"omitempty,max=253"`      // Bound Agent-Remote-Id
+	BindLine   int         `json:"bind_line" validate:"gte=0,lte=2"`            // Line binding mode
+	Roaming    int         `json:"roaming" validate:"gte=0,lte=1"`              // 1=may log in on the NAS of any node
 	BindVlan   interface{} `json:"bind_vlan"`                                   // Can be int or boolean
 	BindMac    interface{} `json:"bind_mac"`                                    // Can be int or boolean
+	MaxDevices int         `json:"max_devices" validate:"gte=0,lte=100"`        // Devices learned in device binding mode
 	ExpireTime string      `json:"expire_time" validate:"omitempty"`            // Expiration time
 	Status     interface{} `json:"status"`                                      // Can be string or boolean
 	Remark     string      `json:"remark" validate:"omitempty,max=500"`         // Remark
@@ -64,16 +70,21 @@ type UserRequest struct {
 // toRadiusUser Convert UserRequest Convert to RadiusUser
 func (ur *UserRequest) toRadiusUser() *domain.RadiusUser {
 	user := &domain.RadiusUser{
-		Realname: ur.Realname,
-		Mobile:   ur.Mobile,
-		Username: strings.TrimSpace(ur.Username),
-		Password: ur.Password,
-		AddrPool: ur.AddrPool,
-		Vlanid1:  ur.Vlanid1,
-		Vlanid2:  ur.Vlanid2,
-		IpAddr:   ur.IpAddr,
-		MacAddr:  ur.MacAddr,
-		Remark:   ur.Remark,
+		Realname:   ur.Realname,
+		Mobile:     ur.Mobile,
+		Username:   strings.TrimSpace(ur.Username),
+		Password:   ur.Password,
+		AddrPool:   ur.AddrPool,
+		Vlanid1:    ur.Vlanid1,
+		Vlanid2:    ur.Vlanid2,
+		IpAddr:     ur.IpAddr,
+		MacAddr:    ur.MacAddr,
+		Remark:     ur.Remark,
+		CircuitId:  strings.TrimSpace(ur.CircuitId),
+		RemoteId:   strings.TrimSpace(ur.RemoteId),
+		BindLine:   ur.BindLine,
+		Roaming:    ur.Roaming,
+		MaxDevices: ur.MaxDevices,
 	}
 
 	// Handle profile_id
@@ -139,28 +150,34 @@ func (ur *UserRequest) toRadiusUser() *domain.RadiusUser {
 
 // UserUpdateRequest Used to handle user update data
 type UserUpdateRequest struct {
-	NodeID          interface{} `json:"node_id"`                                       // Can be int64 or string
-	ProfileID       interface{} `json:"profile_id"`                                    // Can be int64 or string
-	Realname        string      `json:"realname" validate:"omitempty,max=100"`         // Real name
-	Email           string      `json:"email" validate:"omitempty,email,max=100"`      // Email
-	Mobile          string      `json:"mobile" validate:"omitempty,max=20"`            // Mobile number (optional, max 20 characters)
-	Address         string      `json:"address" validate:"omitempty,max=255"`          // addresses
-	Username        string      `json:"username" validate:"omitempty,min=3,max=50"`    // Username
-	Password        string      `json:"password" validate:"omitempty,min=6,max=128"`   // Password
-	AddrPool        string      `json:"addr_pool" validate:"omitempty,max=50"`         // Address pool
-	Vlanid1         int         `json:"vlanid1" validate:"gte=0,lte=4096"`             // VLAN ID 1
-	Vlanid2         int         `json:"vlanid2" validate:"gte=0,lte=4096"`             // VLAN ID 2
-	IpAddr          string      `json:"ip_addr" validate:"omitempty,ipv4"`             // IPv4addresses
-	Ipv6Addr        string      `json:"ipv6_addr" validate:"omitempty"`                // IPv6addresses
-	MacAddr         string      `json:"mac_addr" validate:"omitempty,mac"`             // MACaddresses
-	BindVlan        interface{} `json:"bind_vlan"`                                     // Can be int or boolean
-	BindMac         interface{} `json:"bind_mac"`                                      // Can be int or boolean
-	ExpireTime      string      `json:"expire_time" validate:"omitempty"`              // Expiration time
-	Status          interface{} `json:"status"`                                        // Can be string or boolean
-	Remark          string      `json:"remark" validate:"omitempty,max=500"`           // Remark
-	IPv6PrefixPool  string      `json:"ipv6_prefix_pool" validate:"omitempty,max=100"` // IPv6 prefix pool name
-	Domain          string      `json:"domain" validate:"omitempty,max=100"`           // User domain
-	ProfileLinkMode int         `json:"profile_link_mode" validate:"gte=0,lte=1"`      // Profile link mode (0=static, 1=dynamic)
+	NodeID          interface{} `json:"node_id"`                                        // Can be int64 or string
+	ProfileID       interface{} `json:"profile_id"`                                     // Can be int64 or string
+	Realname        string      `json:"realname" validate:"omitempty,max=100"`          // Real name
+	Email           string      `json:"email" validate:"omitempty,email,max=100"`       // Email
+	Mobile          string      `json:"mobile" validate:"omitempty,max=20"`             // Mobile number (optional, max 20 characters)
+	Address         string      `json:"address" validate:"omitempty,max=255"`           // addresses
+	Username        string      `json:"username" validate:"omitempty,min=3,max=50"`     // Username
+	Password        string      `json:"password" validate:"omitempty,min=6,max=128"`    // Password
+	PwdScheme       string      `json:"password_scheme" validate:"omitempty,max=20"`    // Password storage scheme, applied with password
+	AddrPool        string      `json:"addr_pool" validate:"omitempty,max=50"`          // Address pool
+	Vlanid1         int         `json:"vlanid1" validate:"gte=0,lte=4096"`              // VLAN ID 1
+	Vlanid2         int         `json:"vlanid2" validate:"gte=0,lte=4096"`              // VLAN ID 2
+	IpAddr          string      `json:"ip_addr" validate:"omitempty,ipv4"`              // IPv4addresses
+	Ipv6Addr        string      `json:"ipv6_addr" validate:"omitempty"`                 // IPv6addresses
+	MacAddr         string      `json:"mac_addr" validate:"omitempty,mac"`              // MACaddresses
+	CircuitId       *string     `json:"circuit_id" validate:"omitempty,max=253"`        // Bound Agent-Circuit-Id, empty string clears it
+	RemoteId        *string     `json:"remote_id" validate:"omitempty,max=253"`         // Bound Agent-Remote-Id, empty string clears it
+	BindLine        *int        `json:"bind_line" validate:"omitempty,gte=0,lte=2"`     // Line binding mode
+	Roaming         *int        `json:"roaming" validate:"omitempty,gte=0,lte=1"`       // 1=may log in on the NAS of any node
+	BindVlan        interface{} `json:"bind_vlan"`                                      // Can be int or boolean
+	BindMac         interface{} `json:"bind_mac"`                                       // Can be int or boolean
+	MaxDevices      *int        `json:"max_devices" validate:"omitempty,gte=0,lte=100"` // Devices learned in device binding mode
+	ExpireTime      string      `json:"expire_time" validate:"omitempty"`               // Expiration time
+	Status          interface{} `json:"status"`                                         // Can be string or boolean
+	Remark          string      `json:"remark" validate:"omitempty,max=500"`            // Remark
+	IPv6PrefixPool  string      `json:"ipv6_prefix_pool" validate:"omitempty,max=100"`  // IPv6 prefix pool name
+	Domain          string      `json:"domain" validate:"omitempty,max=100"`            // User domain
+	ProfileLinkMode int         `json:"profile_link_mode" validate:"gte=0,lte=1"`       // Profile link mode (0=static, 1=dynamic)
 }
 
 // toRadiusUser Convert UserUpdateRequest Convert to RadiusUser
@@ -375,6 +392,9 @@ func createRadiusUser(c echo.Context) error {
 	}
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
+	if user.Password, err = encodePassword(c, req.PwdScheme, req.Password); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_PASSWORD_SCHEME", "Password cannot be stored with this scheme", err.Error())
+	}
 
 	if err := GetDB(c).Create(&user).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to create user", err.Error())
@@ -459,7 +479,11 @@ func updateRadiusUser(c echo.Context) error {
 		updates["username"] = updateData.Username
 	}
 	if req.Password != "" {
-		updates["password"] = req.Password
+		password, err := encodePassword(c, req.PwdScheme, req.Password)
+		if err != nil {
+			return fail(c, http.StatusBadRequest, "INVALID_PASSWORD_SCHEME", "Password cannot be stored with this scheme", err.Error())
+		}
+		updates["password"] = password
 	}
 	if updateData.AddrPool != "" {
 		updates["addr_pool"] = updateData.AddrPool
@@ -485,6 +509,21 @@ func updateRadiusUser(c echo.Context) error {
 	if updateData.Domain != "" {
 		updates["domain"] = updateData.Domain
 	}
+	if req.CircuitId != nil {
+		updates["circuit_id"] = strings.TrimSpace(*req.CircuitId)
+	}
+	if req.RemoteId != nil {
+		updates["remote_id"] = strings.TrimSpace(*req.RemoteId)
+	}
+	if req.BindLine != nil {
+		updates["bind_line"] = *req.BindLine
+	}
+	if req.Roaming != nil {
+		updates["roaming"] = *req.Roaming
+	}
+	if req.MaxDevices != nil {
+		updates["max_devices"] = *req.MaxDevices
+	}
 	if req.BindVlan != nil {
 		updates["bind_vlan"] = updateData.BindVlan
 	}
@@ -544,12 +583,14 @@ func updateRadiusUser(c echo.Context) error {
 
 	updates["updated_at"] = time.Now()
 
+	previous := user
 	if err := GetDB(c).Model(&user).Updates(updates).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to update user", err.Error())
 	}
 
 	// Re-query latest data
 	GetDB(c).Where("id = ?", id).First(&user)
+	publishUserChange(c, &user, previous.Username, previous.MacAddr)
 	user.Password = ""
 	return ok(c, user)
 }
@@ -559,9 +600,23 @@ func deleteRadiusUser(c echo.Context) error {
 	if err != nil {
 		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
 	}
+	// Keep the keys of the user and its devices to evict them from the caches
+	var user domain.RadiusUser
+	var deviceMacs []string
+	found := GetDB(c).Where("id = ?", id).First(&user).Error == nil
+	if found {
+		GetDB(c).Model(&domain.RadiusUserDevice{}).Where("user_id = ?", id).Pluck("mac_addr", &deviceMacs)
+	}
+
 	if err := GetDB(c).Where("id = ?", id).Delete(&domain.RadiusUser{}).Error; err != nil {
 		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to delete user", err.Error())
 	}
+	if err := GetDB(c).Where("user_id = ?", id).Delete(&domain.RadiusUserDevice{}).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to delete user devices", err.Error())
+	}
+	if found {
+		publishUserChange(c, &user, deviceMacs...)
+	}
 	return ok(c, map[string]interface{}{
 		"id": id,
 	})
@@ -578,7 +633,7 @@ func applyUserFilters(db *gorm.DB, c echo.Context) *gorm.DB {
 		if strings.EqualFold(db.Name(), "postgres") { //nolint:staticcheck
 			db = db.Where("radius_user.username ILIKE ?", "%"+escaped+"%")
 		} else {
-			db = db.Where("LOWER(radius_user.username) LIKE ?", "%"+strings.ToLower(escaped)+"%")
+			db = db.Where("LOWER(radius_user.username) LIKE ?"+likeEscape(db), "%"+strings.ToLower(escaped)+"%")
 		}
 	}
 
@@ -588,7 +643,7 @@ func applyUserFilters(db *gorm.DB, c echo.Context) *gorm.DB {
 		if strings.EqualFold(db.Name(), "postgres") { //nolint:staticcheck
 			db = db.Where("radius_user.realname ILIKE ?", "%"+escaped+"%")
 		} else {
-			db = db.Where("LOWER(radius_user.realname) LIKE ?", "%"+strings.ToLower(escaped)+"%")
+			db = db.Where("LOWER(radius_user.realname) LIKE ?"+likeEscape(db), "%"+strings.ToLower(escaped)+"%")
 		}
 	}
 
@@ -598,14 +653,14 @@ func applyUserFilters(db *gorm.DB, c echo.Context) *gorm.DB {
 		if strings.EqualFold(db.Name(), "postgres") { //nolint:staticcheck
 			db = db.Where("radius_user.email ILIKE ?", "%"+escaped+"%")
 		} else {
-			db = db.Where("LOWER(radius_user.email) LIKE ?", "%"+strings.ToLower(escaped)+"%")
+			db = db.Where("LOWER(radius_user.email) LIKE ?"+likeEscape(db), "%"+strings.ToLower(escaped)+"%")
 		}
 	}
 
 	// Handle mobile filter (partial match with escaped pattern)
 	if mobile := strings.TrimSpace(c.QueryParam("mobile")); mobile != "" {
 		escaped := escapeUserLikePattern(mobile)
-		db = db.Where("radius_user.mobile LIKE ?", "%"+escaped+"%")
+		db = db.Where("radius_user.mobile LIKE ?"+likeEscape(db), "%"+escaped+"%")
 	}
 
 	// Handle IP address filter
@@ -622,7 +677,8 @@ func applyUserFilters(db *gorm.DB, c echo.Context) *gorm.DB {
 		} else {
 			qLower := strings.ToLower(escaped)
 			like := "%" + qLower + "%"
-			db = db.Where("(LOWER(radius_user.username) LIKE ? OR LOWER(radius_user.realname) LIKE ? OR LOWER(radius_user.mobile) LIKE ?)", like, like, like)
+			esc := likeEscape(db)
+			db = db.Where("(LOWER(radius_user.username) LIKE ?"+esc+" OR LOWER(radius_user.realname) LIKE ?"+esc+" OR LOWER(radius_user.mobile) LIKE ?"+esc+")", like, like, like)
 		}
 	}
 
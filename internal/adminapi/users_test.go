@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"gorm.io/gorm"
 )
 
@@ -451,6 +452,33 @@ func TestCreateUser(t *testing.T) {
 				assert.Equal(t, "enabled", user.Status) // true -> "enabled"
 			},
 		},
+		{
+			name: "Hashed password scheme",
+			requestBody: `{
+				"username": "hasheduser",
+				"password": "password123",
+				"password_scheme": "bcrypt",
+				"profile_id": "` + fmt.Sprintf("%d", profile.ID) + `"
+			}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, user *domain.RadiusUser) {
+				var stored domain.RadiusUser
+				require.NoError(t, db.First(&stored, user.ID).Error)
+				assert.Equal(t, passwd.SchemeBcrypt, passwd.Identify(stored.Password))
+				assert.True(t, passwd.Verify(stored.Password, "password123"))
+			},
+		},
+		{
+			name: "Unsupported password scheme",
+			requestBody: `{
+				"username": "md5user",
+				"password": "password123",
+				"password_scheme": "md5",
+				"profile_id": "` + fmt.Sprintf("%d", profile.ID) + `"
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_PASSWORD_SCHEME",
+		},
 	}
 
 	for _, tt := range tests {
@@ -585,6 +613,47 @@ func TestUpdateUser(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  "PROFILE_NOT_FOUND",
 		},
+		{
+			name:   "Update line binding",
+			userID: fmt.Sprintf("%d", user.ID),
+			requestBody: `{
+				"circuit_id": " olt1 0/1/0/3:100 ",
+				"bind_line": 2
+			}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, u *domain.RadiusUser) {
+				assert.Equal(t, "olt1 0/1/0/3:100", u.CircuitId)
+				assert.Equal(t, domain.LineBindStrict, u.BindLine)
+			},
+		},
+		{
+			name:   "Clear learned line",
+			userID: fmt.Sprintf("%d", user.ID),
+			requestBody: `{
+				"circuit_id": ""
+			}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, u *domain.RadiusUser) {
+				assert.Empty(t, u.CircuitId)
+				assert.Equal(t, domain.LineBindStrict, u.BindLine)
+			},
+		},
+		{
+			name:           "Allow roaming",
+			userID:         fmt.Sprintf("%d", user.ID),
+			requestBody:    `{"roaming": 1}`,
+			expectedStatus: http.StatusOK,
+			checkResult: func(t *testing.T, u *domain.RadiusUser) {
+				assert.Equal(t, 1, u.Roaming)
+			},
+		},
+		{
+			name:           "Invalid line binding mode",
+			userID:         fmt.Sprintf("%d", user.ID),
+			requestBody:    `{"bind_line": 3}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "VALIDATION_ERROR",
+		},
 		{
 			name:   "Frontend format - boolean update",
 			userID: fmt.Sprintf("%d", user.ID),
@@ -26,6 +26,7 @@ type VoucherBatchRequest struct {
         TotalCount int         `json:"total_count" validate:"required,gte=1,lte=10000"`
         ExpireTime string      `json:"expire_time" validate:"required"`
         ValidDays  int         `json:"valid_days" validate:"gte=0,lte=3650"`
+        Amount     int64       `json:"amount" validate:"gte=0"`
         Prefix     string      `json:"prefix" validate:"omitempty,max=10"`
         CodeLength int         `json:"code_length" validate:"gte=6,lte=32"`
         Status     interface{} `json:"status"`
@@ -38,6 +39,7 @@ func (req *VoucherBatchRequest) toVoucherBatch() *domain.VoucherBatch {
                 Name:       strings.TrimSpace(req.Name),
                 TotalCount: req.TotalCount,
                 ValidDays:  req.ValidDays,
+                Amount:     req.Amount,
                 Prefix:     req.Prefix,
                 CodeLength: req.CodeLength,
                 Remark:     req.Remark,
@@ -92,6 +94,7 @@ type VoucherBatchUpdateRequest struct {
         ProfileId  interface{} `json:"profile_id"`
         ExpireTime string      `json:"expire_time"`
         ValidDays  int         `json:"valid_days" validate:"gte=0,lte=3650"`
+        Amount     *int64      `json:"amount" validate:"omitempty,gte=0"`
         Status     interface{} `json:"status"`
         Remark     string      `json:"remark" validate:"omitempty,max=500"`
 }
@@ -368,6 +371,9 @@ func UpdateVoucherBatch(c echo.Context) error {
         if updateData.ValidDays > 0 {
                 updates["valid_days"] = updateData.ValidDays
         }
+        if req.Amount != nil {
+                updates["amount"] = *req.Amount
+        }
         if updateData.Remark != "" {
                 updates["remark"] = updateData.Remark
         }
@@ -588,6 +594,11 @@ func RedeemVoucher(c echo.Context) error {
                 expireTime = *voucher.ExpireTime
         }
 
+        password, err := encodePassword(c, "", req.Password2)
+        if err != nil {
+                return fail(c, http.StatusBadRequest, "INVALID_PASSWORD_SCHEME", "Password cannot be stored with this scheme", err.Error())
+        }
+
         // Start transaction
         tx := GetDB(c).Begin()
         defer func() {
@@ -601,7 +612,7 @@ func RedeemVoucher(c echo.Context) error {
                 ID:         common.UUIDint64(),
                 ProfileId:  voucher.ProfileId,
                 Username:   strings.TrimSpace(req.Username),
-                Password:   req.Password2,
+                Password:   password,
                 Realname:   req.Realname,
                 Mobile:     req.Mobile,
                 Email:      req.Email,
@@ -0,0 +1,241 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/toughradius/v9/internal/billing"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/webserver"
+	"gorm.io/gorm"
+)
+
+// WalletRechargeRequest is the body of an operator wallet recharge.
+type WalletRechargeRequest struct {
+	Amount int64  `json:"amount"`
+	Remark string `json:"remark"`
+}
+
+// WalletVoucherRequest is the body of a voucher wallet recharge.
+type WalletVoucherRequest struct {
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// WalletSummary is the wallet view of a single user.
+type WalletSummary struct {
+	UserId      int64     `json:"user_id,string"`
+	Username    string    `json:"username"`
+	Status      string    `json:"status"`
+	Balance     int64     `json:"balance"`
+	ExpireTime  time.Time `json:"expire_time"`
+	ProfileId   int64     `json:"profile_id,string"`
+	ProfileName string    `json:"profile_name"`
+	Price       int64     `json:"price"`
+	RenewPeriod int       `json:"renew_period"`
+}
+
+func registerWalletRoutes() {
+	webserver.ApiGET("/users/:id/wallet", getUserWallet)
+	webserver.ApiPOST("/users/:id/wallet/recharge", rechargeUserWallet)
+	webserver.ApiPOST("/users/:id/wallet/recharge-voucher", rechargeUserWalletByVoucher)
+	webserver.ApiPOST("/users/:id/wallet/renew", renewUserWallet)
+	webserver.ApiGET("/wallet/transactions", listWalletTransactions)
+	webserver.ApiGET("/wallet/transactions/:id/receipt", getWalletReceipt)
+}
+
+func getUserWallet(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var user domain.RadiusUser
+	if err := GetDB(c).Where("id = ?", id).First(&user).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query users", err.Error())
+	}
+
+	summary := WalletSummary{
+		UserId:     user.ID,
+		Username:   user.Username,
+		Status:     user.Status,
+		Balance:    user.Balance,
+		ExpireTime: user.ExpireTime,
+		ProfileId:  user.ProfileId,
+	}
+	var profile domain.RadiusProfile
+	if err := GetDB(c).Where("id = ?", user.ProfileId).First(&profile).Error; err == nil {
+		summary.ProfileName = profile.Name
+		summary.Price = profile.Price
+		summary.RenewPeriod = profile.RenewPeriod
+	}
+	return ok(c, summary)
+}
+
+func rechargeUserWallet(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var req WalletRechargeRequest
+	if err := c.Bind(&req); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse recharge parameters", err.Error())
+	}
+	if req.Amount <= 0 {
+		return fail(c, http.StatusBadRequest, "INVALID_AMOUNT", "Amount must be greater than zero", nil)
+	}
+
+	result, err := billing.NewService(GetDB(c)).
+		Recharge(c.Request().Context(), id, req.Amount, walletOperatorName(c), strings.TrimSpace(req.Remark))
+	if err != nil {
+		return walletFail(c, err)
+	}
+	publishWalletUserChange(c, id)
+	return ok(c, result)
+}
+
+func rechargeUserWalletByVoucher(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	var req WalletVoucherRequest
+	if err := c.Bind(&req); err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_REQUEST", "Unable to parse voucher parameters", err.Error())
+	}
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return fail(c, http.StatusBadRequest, "MISSING_CODE", "Voucher code is required", nil)
+	}
+
+	result, err := billing.NewService(GetDB(c)).
+		RechargeByVoucher(c.Request().Context(), id, code, req.Password, walletOperatorName(c))
+	if err != nil {
+		return walletFail(c, err)
+	}
+	publishWalletUserChange(c, id)
+	return ok(c, result)
+}
+
+func renewUserWallet(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+	}
+	txn, err := billing.NewService(GetDB(c)).
+		Renew(c.Request().Context(), id, domain.WalletSourceOperator, walletOperatorName(c))
+	if err != nil {
+		return walletFail(c, err)
+	}
+	publishWalletUserChange(c, id)
+	return ok(c, txn)
+}
+
+func listWalletTransactions(c echo.Context) error {
+	page, pageSize := parsePagination(c)
+
+	query := GetDB(c).Model(&domain.WalletTransaction{})
+	if userID := strings.TrimSpace(c.QueryParam("user_id")); userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if username := strings.TrimSpace(c.QueryParam("username")); username != "" {
+		query = query.Where("username = ?", username)
+	}
+	if txnType := strings.TrimSpace(c.QueryParam("type")); txnType != "" {
+		query = query.Where("type = ?", txnType)
+	}
+	if source := strings.TrimSpace(c.QueryParam("source")); source != "" {
+		query = query.Where("source = ?", source)
+	}
+	if start := strings.TrimSpace(c.QueryParam("start_time")); start != "" {
+		t, err := parseTimeInput(start, time.Time{})
+		if err != nil {
+			return fail(c, http.StatusBadRequest, "INVALID_TIME", "Invalid start time format", nil)
+		}
+		query = query.Where("created_at >= ?", t)
+	}
+	if end := strings.TrimSpace(c.QueryParam("end_time")); end != "" {
+		t, err := parseTimeInput(end, time.Time{})
+		if err != nil {
+			return fail(c, http.StatusBadRequest, "INVALID_TIME", "Invalid end time format", nil)
+		}
+		query = query.Where("created_at <= ?", t)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query transactions", err.Error())
+	}
+
+	var txns []domain.WalletTransaction
+	if err := query.Order("created_at DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&txns).Error; err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query transactions", err.Error())
+	}
+
+	return paged(c, txns, total, page, pageSize)
+}
+
+func getWalletReceipt(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return fail(c, http.StatusBadRequest, "INVALID_ID", "Invalid transaction ID", nil)
+	}
+	receipt, err := billing.NewService(GetDB(c)).Receipt(c.Request().Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(c, http.StatusNotFound, "NOT_FOUND", "Transaction not found", nil)
+	} else if err != nil {
+		return fail(c, http.StatusInternalServerError, "DATABASE_ERROR", "Failed to query transaction", err.Error())
+	}
+	return ok(c, receipt)
+}
+
+// walletOperatorName returns the username recorded on ledger entries.
+func walletOperatorName(c echo.Context) string {
+	if opr, err := resolveOperatorFromContext(c); err == nil && opr != nil {
+		return opr.Username
+	}
+	return ""
+}
+
+// walletFail maps billing errors to API responses.
+func walletFail(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(c, http.StatusNotFound, "USER_NOT_FOUND", "User or profile not found", nil)
+	case errors.Is(err, billing.ErrInvalidAmount):
+		return fail(c, http.StatusBadRequest, "INVALID_AMOUNT", err.Error(), nil)
+	case errors.Is(err, billing.ErrInsufficientBalance):
+		return fail(c, http.StatusConflict, "INSUFFICIENT_BALANCE", err.Error(), nil)
+	case errors.Is(err, billing.ErrAlreadyRenewed):
+		return fail(c, http.StatusConflict, "ALREADY_RENEWED", err.Error(), nil)
+	case errors.Is(err, billing.ErrProfileNotBillable):
+		return fail(c, http.StatusBadRequest, "PROFILE_NOT_BILLABLE", err.Error(), nil)
+	case errors.Is(err, billing.ErrUserDisabled):
+		return fail(c, http.StatusConflict, "USER_DISABLED", err.Error(), nil)
+	case errors.Is(err, billing.ErrVoucherUnavailable):
+		return fail(c, http.StatusBadRequest, "VOUCHER_NOT_AVAILABLE", err.Error(), nil)
+	case errors.Is(err, billing.ErrVoucherExpired):
+		return fail(c, http.StatusBadRequest, "VOUCHER_EXPIRED", err.Error(), nil)
+	case errors.Is(err, billing.ErrVoucherPassword):
+		return fail(c, http.StatusUnauthorized, "INVALID_PASSWORD", "Invalid voucher password", nil)
+	default:
+		return fail(c, http.StatusInternalServerError, "WALLET_FAILED", "Wallet operation failed", err.Error())
+	}
+}
+
+// publishWalletUserChange announces a user whose expiry or status a wallet
+// operation may have changed
+func publishWalletUserChange(c echo.Context, id int64) {
+	var user domain.RadiusUser
+	if err := GetDB(c).Select("id", "username", "mac_addr").Where("id = ?", id).First(&user).Error; err != nil {
+		return
+	}
+	publishUserChange(c, &user)
+}
@@ -0,0 +1,187 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"gorm.io/gorm"
+)
+
+func setupWalletTest(t *testing.T) (*gorm.DB, *domain.RadiusUser) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&domain.VoucherBatch{}, &domain.Voucher{}, &domain.WalletTransaction{}))
+
+	profile := &domain.RadiusProfile{
+		ID:          common.UUIDint64(),
+		Name:        "monthly",
+		Status:      common.ENABLED,
+		Price:       1000,
+		RenewPeriod: 30,
+	}
+	require.NoError(t, db.Create(profile).Error)
+	user := &domain.RadiusUser{
+		ID:         common.UUIDint64(),
+		Username:   "wallet-user",
+		Password:   "secret",
+		ProfileId:  profile.ID,
+		Status:     common.ENABLED,
+		ExpireTime: time.Now().AddDate(0, 0, 5),
+	}
+	require.NoError(t, db.Create(user).Error)
+	return db, user
+}
+
+func TestRechargeUserWallet(t *testing.T) {
+	db, user := setupWalletTest(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	tests := []struct {
+		name           string
+		userID         string
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"valid recharge", fmt.Sprint(user.ID), `{"amount": 2500, "remark": "cash"}`, http.StatusOK, ""},
+		{"zero amount", fmt.Sprint(user.ID), `{"amount": 0}`, http.StatusBadRequest, "INVALID_AMOUNT"},
+		{"unknown user", "12345", `{"amount": 100}`, http.StatusNotFound, "USER_NOT_FOUND"},
+		{"invalid id", "abc", `{"amount": 100}`, http.StatusBadRequest, "INVALID_ID"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/"+tc.userID+"/wallet/recharge", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c := CreateTestContext(e, db, req, rec, appCtx)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.userID)
+
+			require.NoError(t, rechargeUserWallet(c))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				var resp ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, tc.expectedCode, resp.Error)
+			}
+		})
+	}
+
+	var got domain.RadiusUser
+	require.NoError(t, db.First(&got, user.ID).Error)
+	assert.Equal(t, int64(2500), got.Balance)
+
+	var txn domain.WalletTransaction
+	require.NoError(t, db.Where("user_id = ?", user.ID).First(&txn).Error)
+	assert.Equal(t, "superadmin", txn.OprName)
+	assert.Equal(t, "cash", txn.Remark)
+}
+
+func TestRenewUserWallet(t *testing.T) {
+	db, user := setupWalletTest(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	renew := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/"+fmt.Sprint(user.ID)+"/wallet/renew", nil)
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(user.ID))
+		require.NoError(t, renewUserWallet(c))
+		return rec
+	}
+
+	rec := renew()
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	require.NoError(t, db.Model(&domain.RadiusUser{}).Where("id = ?", user.ID).Update("balance", 1000).Error)
+	rec = renew()
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var got domain.RadiusUser
+	require.NoError(t, db.First(&got, user.ID).Error)
+	assert.Equal(t, int64(0), got.Balance)
+	assert.True(t, got.ExpireTime.After(time.Now().AddDate(0, 0, 34)))
+}
+
+func TestRechargeUserWalletByVoucher(t *testing.T) {
+	db, user := setupWalletTest(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	batch := &domain.VoucherBatch{ID: common.UUIDint64(), Name: "topup", ProfileId: user.ProfileId,
+		TotalCount: 1, Amount: 700, Status: domain.VoucherBatchStatusEnabled, ExpireTime: time.Now().AddDate(0, 1, 0)}
+	require.NoError(t, db.Create(batch).Error)
+	require.NoError(t, db.Create(&domain.Voucher{ID: common.UUIDint64(), BatchId: batch.ID, Code: "WALLET-700",
+		ProfileId: user.ProfileId, Status: domain.VoucherStatusAvailable}).Error)
+
+	redeem := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/"+fmt.Sprint(user.ID)+"/wallet/recharge-voucher", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		c := CreateTestContext(e, db, req, rec, appCtx)
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(user.ID))
+		require.NoError(t, rechargeUserWalletByVoucher(c))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusBadRequest, redeem(`{"code": ""}`).Code)
+	assert.Equal(t, http.StatusOK, redeem(`{"code": "WALLET-700"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, redeem(`{"code": "WALLET-700"}`).Code)
+
+	var got domain.RadiusUser
+	require.NoError(t, db.First(&got, user.ID).Error)
+	assert.Equal(t, int64(700), got.Balance)
+}
+
+func TestListWalletTransactionsAndReceipt(t *testing.T) {
+	db, user := setupWalletTest(t)
+	appCtx := setupTestApp(t, db)
+	e := setupTestEcho()
+
+	txn := &domain.WalletTransaction{ID: common.UUIDint64(), UserId: user.ID, Username: user.Username,
+		Type: domain.WalletTxnRecharge, Source: domain.WalletSourceOperator, Amount: 500, Balance: 500,
+		ReceiptNo: "R-TEST-1", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(txn).Error)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/wallet/transactions?username=wallet-user&type=recharge", nil)
+	rec := httptest.NewRecorder()
+	c := CreateTestContext(e, db, req, rec, appCtx)
+	require.NoError(t, listWalletTransactions(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Meta)
+	assert.Equal(t, int64(1), resp.Meta.Total)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/wallet/transactions/"+fmt.Sprint(txn.ID)+"/receipt", nil)
+	rec = httptest.NewRecorder()
+	c = CreateTestContext(e, db, req, rec, appCtx)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(txn.ID))
+	require.NoError(t, getWalletReceipt(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "R-TEST-1")
+	assert.Contains(t, rec.Body.String(), "monthly")
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/wallet/transactions/1/receipt", nil)
+	rec = httptest.NewRecorder()
+	c = CreateTestContext(e, db, req, rec, appCtx)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	require.NoError(t, getWalletReceipt(c))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"os"
 	"runtime/debug"
+	"sync"
 	"time"
 	_ "time/tzdata"
 
@@ -26,6 +27,11 @@ type Application struct {
 	sched         *cron.Cron
 	configManager *ConfigManager
 	profileCache  *ProfileCache
+	policyCache   *PolicyCache
+	changeBus     *ChangeBus
+	changePoller  *ChangePoller
+	backupMu      sync.Mutex // Held by the scheduled backup
+	backupChecked time.Time  // Last check of the backup schedule
 }
 
 // Ensure Application implements all interfaces
@@ -35,6 +41,7 @@ var (
 	_ SettingsProvider      = (*Application)(nil)
 	_ SchedulerProvider     = (*Application)(nil)
 	_ ConfigManagerProvider = (*Application)(nil)
+	_ ChangeBusProvider     = (*Application)(nil)
 	_ AppContext            = (*Application)(nil)
 )
 
@@ -53,6 +60,7 @@ func (a *Application) DB() *gorm.DB {
 // OverrideDB replaces the application's database handle (used in tests).
 func (a *Application) OverrideDB(db *gorm.DB) {
 	a.gormDB = db
+	a.policyCache = NewPolicyCache(db, DefaultPolicyCacheTTL)
 }
 
 func (a *Application) Init(cfg *config.AppConfig) {
@@ -142,9 +150,26 @@ func (a *Application) Init(cfg *config.AppConfig) {
 	// Initialize profile cache for dynamic profile linking
 	a.profileCache = NewProfileCache(a.gormDB, DefaultProfileCacheTTL)
 
+	// Initialize the authorization policy rule cache
+	a.policyCache = NewPolicyCache(a.gormDB, DefaultPolicyCacheTTL)
+
+	// Announce admin changes to the caches, and to the other instances
+	// sharing the database when polling is configured
+	a.changeBus = NewChangeBus()
+	a.changeBus.Subscribe(a.evictCaches)
+	if cfg.Database.ChangePoll > 0 {
+		a.changePoller = NewChangePoller(a.gormDB, a.changeBus, time.Duration(cfg.Database.ChangePoll)*time.Second)
+		a.changeBus.SetTransport(a.changePoller)
+		a.changePoller.Start()
+	}
+
 	a.initJob()
 }
 
+// MigrateDB migrates the schema at startup. A failed versioned migration is
+// logged and does not stop startup: AutoMigrate still creates the new tables
+// and columns, as before versioned migrations existed, and the migration is
+// retried on the next start or with -migrate up.
 func (a *Application) MigrateDB(track bool) (err error) {
 	defer func() {
 		if err1 := recover(); err1 != nil {
@@ -158,14 +183,12 @@ func (a *Application) MigrateDB(track bool) (err error) {
 			}
 		}
 	}()
+	db := a.gormDB
 	if track {
-		if err := a.gormDB.Debug().Migrator().AutoMigrate(domain.Tables...); err != nil {
-			zap.S().Error(err)
-		}
-	} else {
-		if err := a.gormDB.Migrator().AutoMigrate(domain.Tables...); err != nil {
-			zap.S().Error(err)
-		}
+		db = db.Debug()
+	}
+	if _, err := migrateSchema(db); err != nil {
+		zap.S().Error(err)
 	}
 	return nil
 }
@@ -176,8 +199,7 @@ func (a *Application) DropAll() {
 
 func (a *Application) InitDb() {
 	_ = a.gormDB.Migrator().DropTable(domain.Tables...)
-	err := a.gormDB.Migrator().AutoMigrate(domain.Tables...)
-	if err != nil {
+	if _, err := migrateSchema(a.gormDB); err != nil {
 		zap.S().Error(err)
 	}
 }
@@ -219,6 +241,34 @@ func (a *Application) ProfileCache() *ProfileCache {
 	return a.profileCache
 }
 
+// PolicyCache returns the authorization policy rule cache
+func (a *Application) PolicyCache() *PolicyCache {
+	return a.policyCache
+}
+
+// ChangeBus returns the bus announcing changes to cached entities
+func (a *Application) ChangeBus() *ChangeBus {
+	return a.changeBus
+}
+
+// evictCaches drops the cached profiles and policy rules named by a change
+func (a *Application) evictCaches(event ChangeEvent) {
+	switch event.Kind {
+	case ChangeProfile:
+		if a.profileCache != nil {
+			if event.ID == 0 {
+				a.profileCache.InvalidateAll()
+			} else {
+				a.profileCache.Invalidate(event.ID)
+			}
+		}
+	case ChangePolicy:
+		if a.policyCache != nil {
+			a.policyCache.Invalidate()
+		}
+	}
+}
+
 // checkDefaultPNode check default node
 func (a *Application) checkDefaultPNode() {
 	var pnode domain.NetNode
@@ -243,6 +293,10 @@ func (a *Application) Release() {
 		a.profileCache.Stop()
 	}
 
+	if a.changePoller != nil {
+		a.changePoller.Stop()
+	}
+
 	_ = metrics.Close()
 	_ = zap.L().Sync()
 }
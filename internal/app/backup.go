@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/backup"
+	"gorm.io/gorm"
+)
+
+// RunBackup takes a backup of the configured database into the backup
+// directory without starting the application
+func RunBackup(cfg *config.AppConfig, out io.Writer) error {
+	db, closeDB := openCommandDatabase(cfg)
+	defer closeDB()
+
+	info, err := backup.NewService(db, cfg.GetBackupDir()).Create(context.Background())
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "backup %s written to %s (%d bytes)\n", info.Name, cfg.GetBackupDir(), info.Size) //nolint:errcheck
+	return nil
+}
+
+// RunRestore restores a backup file into the configured database, all of
+// its tables or the given ones. The schema is migrated first, so a backup
+// restores into an empty database of any supported type.
+func RunRestore(cfg *config.AppConfig, file string, tables []string, out io.Writer) error {
+	db, closeDB := openCommandDatabase(cfg)
+	defer closeDB()
+
+	if _, err := migrateSchema(db); err != nil {
+		return err
+	}
+	f, err := os.Open(file) //nolint:gosec // G304: path given by the operator
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }() //nolint:errcheck
+	return restoreBackup(db, f, tables, out)
+}
+
+func restoreBackup(db *gorm.DB, r io.Reader, tables []string, out io.Writer) error {
+	result, err := backup.NewService(db, "").Restore(context.Background(), r, tables)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(result.Rows))
+	for name := range result.Rows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	_, _ = fmt.Fprintf(out, "restored backup of %s taken %s\n", //nolint:errcheck
+		result.Header.Database, result.Header.CreatedAt.Format(time.DateTime))
+	for _, name := range names {
+		_, _ = fmt.Fprintf(out, "  %-20s %d rows\n", name, result.Rows[name]) //nolint:errcheck
+	}
+
+	// Running instances polling for changes drop their caches
+	poller := NewChangePoller(db, NewChangeBus(), time.Minute)
+	for _, kind := range []string{ChangeUser, ChangeProfile, ChangeNas, ChangePolicy} {
+		if err := poller.Send(ChangeEvent{Kind: kind}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/backup"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newBackupTestApplication(t *testing.T) *Application {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "app.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+	a := &Application{gormDB: db, appConfig: &config.AppConfig{System: config.SysConfig{Workdir: t.TempDir()}}}
+	a.configManager = NewConfigManager(a)
+	return a
+}
+
+func TestSchedBackupTask(t *testing.T) {
+	a := newBackupTestApplication(t)
+	cm := a.ConfigMgr()
+	assert.Error(t, cm.Set("backup", "Schedule", "every day"))
+	require.NoError(t, cm.Set("backup", "Schedule", "* * * * *"))
+
+	backups := func() []backup.Info {
+		list, err := backup.NewService(a.gormDB, a.appConfig.GetBackupDir()).List()
+		require.NoError(t, err)
+		return list
+	}
+
+	// Disabled by default
+	a.backupChecked = time.Now().Add(-2 * time.Minute)
+	a.SchedBackupTask()
+	assert.Empty(t, backups())
+
+	require.NoError(t, cm.Set("backup", "Enabled", "true"))
+	// The first check only starts the schedule
+	a.backupChecked = time.Time{}
+	a.SchedBackupTask()
+	assert.Empty(t, backups())
+
+	a.backupChecked = time.Now().Add(-2 * time.Minute)
+	a.SchedBackupTask()
+	assert.Len(t, backups(), 1)
+
+	// Not due again within the same minute
+	a.SchedBackupTask()
+	assert.Len(t, backups(), 1)
+}
+
+func TestRestoreBackupCommand(t *testing.T) {
+	a := newBackupTestApplication(t)
+	require.NoError(t, a.gormDB.Create(&domain.RadiusUser{ID: 1, Username: "alice"}).Error)
+
+	var dump bytes.Buffer
+	_, err := backup.NewService(a.gormDB, "").Dump(context.Background(), &dump)
+	require.NoError(t, err)
+
+	target := newBackupTestApplication(t)
+	var out bytes.Buffer
+	require.NoError(t, restoreBackup(target.gormDB, bytes.NewReader(dump.Bytes()), []string{"radius_user"}, &out))
+	assert.Contains(t, out.String(), "radius_user          1 rows")
+
+	var user domain.RadiusUser
+	require.NoError(t, target.gormDB.First(&user, 1).Error)
+	assert.Equal(t, "alice", user.Username)
+
+	// Instances polling for changes are told to drop their caches
+	var events int64
+	require.NoError(t, target.gormDB.Model(&domain.SysChangeEvent{}).Count(&events).Error)
+	assert.Equal(t, int64(4), events)
+}
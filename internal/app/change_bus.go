@@ -0,0 +1,108 @@
+package app
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Kinds of change events
+const (
+	ChangeUser    = "user"    // A RADIUS user, including its devices and two-factor secret
+	ChangeProfile = "profile" // A billing profile or its attributes
+	ChangeNas     = "nas"     // A NAS device
+	ChangePolicy  = "policy"  // The authorization policy rules
+)
+
+// ChangeEvent tells the in-memory caches that an entity was changed or
+// deleted, so they evict it instead of serving it until it expires
+type ChangeEvent struct {
+	Kind string   `json:"kind"`
+	ID   int64    `json:"id,string"`      // 0 without keys stands for every entity of the kind
+	Keys []string `json:"keys,omitempty"` // Natural keys the entity is cached under: the username and MAC of a user, old and new
+}
+
+// ChangeTransport forwards the events published by this instance to the
+// other instances sharing the database
+type ChangeTransport interface {
+	Send(event ChangeEvent) error
+}
+
+// ChangeBus delivers change events to the subscribed caches. The admin API
+// publishes an event after every write to users, profiles, NAS devices and
+// policies; the RADIUS service and the application caches subscribe.
+type ChangeBus struct {
+	mu          sync.RWMutex
+	subscribers map[int]func(ChangeEvent)
+	nextID      int
+	transport   ChangeTransport
+}
+
+// NewChangeBus creates a bus delivering the events in this process only
+func NewChangeBus() *ChangeBus {
+	return &ChangeBus{subscribers: make(map[int]func(ChangeEvent))}
+}
+
+// Subscribe registers fn for every event and returns the function removing
+// it. fn runs on the publishing goroutine and must not block.
+func (b *ChangeBus) Subscribe(fn func(ChangeEvent)) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = fn
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// SetTransport forwards the published events to the other instances
+func (b *ChangeBus) SetTransport(transport ChangeTransport) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.transport = transport
+}
+
+// Publish delivers the event to the subscribers and forwards it to the
+// other instances. A nil bus ignores it.
+func (b *ChangeBus) Publish(event ChangeEvent) {
+	if b == nil {
+		return
+	}
+	b.Deliver(event)
+
+	b.mu.RLock()
+	transport := b.transport
+	b.mu.RUnlock()
+	if transport == nil {
+		return
+	}
+	if err := transport.Send(event); err != nil {
+		// The other instances fall back to the cache expiry
+		zap.L().Error("forward change event failed",
+			zap.String("kind", event.Kind),
+			zap.Int64("id", event.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// Deliver hands the event to the subscribers of this process only, it is
+// used by the transports for the events of other instances
+func (b *ChangeBus) Deliver(event ChangeEvent) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	subscribers := make([]func(ChangeEvent), 0, len(b.subscribers))
+	for _, fn := range b.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	b.mu.RUnlock()
+
+	for _, fn := range subscribers {
+		fn(event)
+	}
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+)
+
+type recordingTransport struct {
+	sent []ChangeEvent
+	err  error
+}
+
+func (t *recordingTransport) Send(event ChangeEvent) error {
+	t.sent = append(t.sent, event)
+	return t.err
+}
+
+func TestChangeBus(t *testing.T) {
+	bus := NewChangeBus()
+	var got []ChangeEvent
+	unsubscribe := bus.Subscribe(func(event ChangeEvent) { got = append(got, event) })
+
+	transport := &recordingTransport{}
+	bus.SetTransport(transport)
+
+	event := ChangeEvent{Kind: ChangeUser, ID: 1, Keys: []string{"alice"}}
+	bus.Publish(event)
+	assert.Equal(t, []ChangeEvent{event}, got)
+	assert.Equal(t, []ChangeEvent{event}, transport.sent)
+
+	// Events of other instances are not forwarded again
+	bus.Deliver(ChangeEvent{Kind: ChangeNas, ID: 2})
+	assert.Len(t, got, 2)
+	assert.Len(t, transport.sent, 1)
+
+	// A failing transport does not stop the local delivery
+	transport.err = errors.New("down")
+	bus.Publish(ChangeEvent{Kind: ChangeProfile, ID: 3})
+	assert.Len(t, got, 3)
+
+	unsubscribe()
+	bus.Publish(event)
+	assert.Len(t, got, 3)
+
+	var nilBus *ChangeBus
+	assert.NotPanics(t, func() { nilBus.Publish(event) })
+}
+
+func TestChangePoller(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&domain.SysChangeEvent{}))
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // one in-memory database for both instances
+
+	// An event stored before the start is skipped
+	senderBus, receiverBus := NewChangeBus(), NewChangeBus()
+	sender := NewChangePoller(db, senderBus, time.Hour)
+	require.NoError(t, sender.Send(ChangeEvent{Kind: ChangeNas, ID: 9}))
+
+	receiver := NewChangePoller(db, receiverBus, time.Hour)
+	receiver.Start()
+	defer receiver.Stop()
+	sender.Start()
+	defer sender.Stop()
+	senderBus.SetTransport(sender)
+
+	var received, echoed []ChangeEvent
+	receiverBus.Subscribe(func(event ChangeEvent) { received = append(received, event) })
+	senderBus.Subscribe(func(event ChangeEvent) { echoed = append(echoed, event) })
+
+	event := ChangeEvent{Kind: ChangeUser, ID: 1, Keys: []string{"alice", "aa:bb:cc:00:00:01"}}
+	senderBus.Publish(event)
+	require.Len(t, echoed, 1)
+
+	receiver.Poll()
+	assert.Equal(t, []ChangeEvent{event}, received)
+
+	// Each event is delivered once and never back to its origin
+	receiver.Poll()
+	sender.Poll()
+	assert.Len(t, received, 1)
+	assert.Len(t, echoed, 1)
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// changeEventRetention is how long the events stay in sys_change_event,
+	// far longer than any poll interval
+	changeEventRetention = time.Hour
+	// changePollBatch bounds the events read by one poll
+	changePollBatch = 1000
+)
+
+// ChangePoller carries the change events between instances through the
+// sys_change_event table: Send stores the events of this instance and every
+// interval the events stored by the others since the last poll are
+// delivered to the local bus. It works with every database backend. An
+// event committed out of id order may be missed, the cache expiry stays the
+// backstop.
+type ChangePoller struct {
+	db        *gorm.DB
+	bus       *ChangeBus
+	origin    string
+	interval  time.Duration
+	lastID    int64
+	lastPurge time.Time
+	started   bool
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+// NewChangePoller creates a poller delivering to bus, Start runs it
+func NewChangePoller(db *gorm.DB, bus *ChangeBus, interval time.Duration) *ChangePoller {
+	return &ChangePoller{
+		db:       db,
+		bus:      bus,
+		origin:   common.UUID(),
+		interval: interval,
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Send stores an event of this instance for the others
+func (p *ChangePoller) Send(event ChangeEvent) error {
+	keys, err := json.Marshal(event.Keys)
+	if err != nil {
+		return err
+	}
+	return p.db.Create(&domain.SysChangeEvent{
+		Kind:      event.Kind,
+		EntityId:  event.ID,
+		Keys:      string(keys),
+		Origin:    p.origin,
+		CreatedAt: time.Now(),
+	}).Error
+}
+
+// Start skips the events stored so far and polls until Stop
+func (p *ChangePoller) Start() {
+	var last domain.SysChangeEvent
+	if err := p.db.Order("id DESC").Limit(1).Find(&last).Error; err == nil {
+		p.lastID = last.ID
+	}
+	p.started = true
+	go p.run()
+}
+
+// Stop ends the polling
+func (p *ChangePoller) Stop() {
+	if !p.started {
+		return
+	}
+	p.started = false
+	close(p.stop)
+	<-p.stopped
+}
+
+func (p *ChangePoller) run() {
+	defer close(p.stopped)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.Poll()
+		}
+	}
+}
+
+// Poll delivers the events the other instances stored since the last poll
+// and purges the expired ones
+func (p *ChangePoller) Poll() {
+	for {
+		var rows []domain.SysChangeEvent
+		if err := p.db.Where("id > ?", p.lastID).Order("id").Limit(changePollBatch).Find(&rows).Error; err != nil {
+			zap.L().Error("poll change events failed", zap.Error(err))
+			return
+		}
+		for _, row := range rows {
+			p.lastID = row.ID
+			if row.Origin == p.origin {
+				continue
+			}
+			event := ChangeEvent{Kind: row.Kind, ID: row.EntityId}
+			if row.Keys != "" {
+				_ = json.Unmarshal([]byte(row.Keys), &event.Keys) //nolint:errcheck // keys are optional
+			}
+			p.bus.Deliver(event)
+		}
+		if len(rows) < changePollBatch {
+			break
+		}
+	}
+
+	if time.Since(p.lastPurge) > changeEventRetention/4 {
+		p.lastPurge = time.Now()
+		p.db.Where("created_at < ?", time.Now().Add(-changeEventRetention)).Delete(&domain.SysChangeEvent{})
+	}
+}
@@ -62,6 +62,11 @@ type ConfigSchema struct {
 //go:embed config_schemas.json
 var configSchemasData []byte
 
+// schemaValidators holds the custom validators of the JSON schemas by key
+var schemaValidators = map[string]func(string) error{
+	"backup.Schedule": validateCronSpec,
+}
+
 // ConfigManager is a lightweight configuration manager (memory-first with database backup)
 type ConfigManager struct {
 	app     *Application
@@ -115,6 +120,7 @@ func (cm *ConfigManager) loadSchemasFromJSON() error {
 			Title:       schemaJSON.Title,
 			TitleI18n:   schemaJSON.TitleI18n,
 			DescI18n:    schemaJSON.DescI18n,
+			Validator:   schemaValidators[schemaJSON.Key],
 		}
 		cm.register(schema)
 	}
@@ -1,15 +1,23 @@
 package app
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
+	"os"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/glebarez/sqlite"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/talkincode/toughradius/v9/config"
 	"github.com/talkincode/toughradius/v9/pkg/common"
 	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -24,8 +32,10 @@ func getDatabase(dbConfig config.DBConfig, workdir string) *gorm.DB {
 		return getSqliteDatabase(dbConfig, workdir)
 	case "postgres", "postgresql":
 		return getPgDatabase(dbConfig)
+	case "mysql", "mariadb":
+		return getMysqlDatabase(dbConfig)
 	default:
-		zap.S().Fatalf("Unsupported database type: %s, supported types: postgres, sqlite", dbConfig.Type)
+		zap.S().Fatalf("Unsupported database type: %s, supported types: postgres, mysql, sqlite", dbConfig.Type)
 		return nil
 	}
 }
@@ -71,12 +81,15 @@ func getSqliteDatabase(config config.DBConfig, workdir string) *gorm.DB {
 
 // getPgDatabase returns a PostgreSQL database connection
 func getPgDatabase(config config.DBConfig) *gorm.DB {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable TimeZone=Asia/Shanghai",
-		config.Host,
-		config.User,
-		config.Passwd,
-		config.Name,
-		config.Port)
+	dsn := config.DSN
+	if dsn == "" {
+		dsn = fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable TimeZone=Asia/Shanghai",
+			config.Host,
+			config.User,
+			config.Passwd,
+			config.Name,
+			config.Port)
+	}
 	pool, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		DisableForeignKeyConstraintWhenMigrating: true,
 		SkipDefaultTransaction:                   true,
@@ -105,3 +118,119 @@ func getPgDatabase(config config.DBConfig) *gorm.DB {
 	// sqlDB.SetConnMaxLifetime(time.Hour * 8)
 	return pool
 }
+
+// getMysqlDatabase returns a MySQL or MariaDB database connection
+func getMysqlDatabase(config config.DBConfig) *gorm.DB {
+	dsn, err := MysqlDSN(config)
+	common.Must(err)
+	pool, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+		SkipDefaultTransaction:                   true,
+		PrepareStmt:                              true,
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true,
+		},
+		Logger: logger.New(
+			zap.NewStdLog(zap.L()),
+			logger.Config{
+				SlowThreshold:             time.Millisecond * 200,
+				LogLevel:                  common.If(config.Debug, logger.Info, logger.Silent).(logger.LogLevel), //nolint:errcheck // type assertion is safe
+				IgnoreRecordNotFoundError: true,
+				Colorful:                  false,
+			},
+		),
+	})
+	common.Must(err)
+	sqlDB, err := pool.DB()
+	common.Must(err)
+	sqlDB.SetMaxIdleConns(config.IdleConn)
+	sqlDB.SetMaxOpenConns(config.MaxConn)
+	// The server closes the connections idle longer than wait_timeout
+	sqlDB.SetConnMaxLifetime(time.Hour)
+	return pool
+}
+
+// mysqlTLSConfigName is the name the TLS settings with a CA or client
+// certificate are registered under with the MySQL driver
+const mysqlTLSConfigName = "toughradius"
+
+// MysqlDSN returns the MySQL DSN of the configuration: DSN when set,
+// otherwise one built from the connection fields with utf8mb4 and the local
+// time zone. parseTime is always on, the time columns need it. The TLS
+// settings are applied on top.
+func MysqlDSN(cfg config.DBConfig) (string, error) {
+	mc := mysqldriver.NewConfig()
+	if cfg.DSN != "" {
+		parsed, err := mysqldriver.ParseDSN(cfg.DSN)
+		if err != nil {
+			return "", fmt.Errorf("parse mysql dsn: %w", err)
+		}
+		mc = parsed
+	} else {
+		port := cfg.Port
+		if port == 0 {
+			port = 3306
+		}
+		mc.Net = "tcp"
+		mc.Addr = net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+		mc.User = cfg.User
+		mc.Passwd = cfg.Passwd
+		mc.DBName = cfg.Name
+		mc.Loc = time.Local
+		mc.Params = map[string]string{"charset": "utf8mb4"}
+	}
+	mc.ParseTime = true
+
+	tlsName, err := mysqlTLS(cfg)
+	if err != nil {
+		return "", err
+	}
+	if tlsName != "" {
+		mc.TLSConfig = tlsName
+	}
+	if tlsName == mysqlTLSConfigName && strings.EqualFold(strings.TrimSpace(cfg.TLS), "preferred") {
+		mc.AllowFallbackToPlaintext = true
+	}
+	return mc.FormatDSN(), nil
+}
+
+// mysqlTLS returns the driver tls parameter of the configuration. With a CA
+// or client certificate the settings are registered as a custom TLS config.
+func mysqlTLS(cfg config.DBConfig) (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(cfg.TLS))
+	switch mode {
+	case "", "false", "true", "skip-verify", "preferred":
+	default:
+		return "", fmt.Errorf("unsupported mysql tls mode %q", cfg.TLS)
+	}
+	if mode == "false" || (cfg.TLSCA == "" && cfg.TLSCert == "") {
+		return mode, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: mode == "skip-verify", //nolint:gosec // G402: explicitly configured
+	}
+	if cfg.TLSCA != "" {
+		pem, err := os.ReadFile(cfg.TLSCA)
+		if err != nil {
+			return "", fmt.Errorf("read mysql tls ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return "", errors.New("mysql tls ca contains no certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.TLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCert, cfg.TLSKey)
+		if err != nil {
+			return "", fmt.Errorf("load mysql tls certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if err := mysqldriver.RegisterTLSConfig(mysqlTLSConfigName, tlsConfig); err != nil {
+		return "", err
+	}
+	return mysqlTLSConfigName, nil
+}
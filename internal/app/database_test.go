@@ -0,0 +1,89 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/app/mysqltest"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+func TestMysqlDSN(t *testing.T) {
+	dsn, err := MysqlDSN(config.DBConfig{Host: "db.example.com", User: "radius", Passwd: "p@ss/word", Name: "toughradius"})
+	require.NoError(t, err)
+	parsed, err := mysqldriver.ParseDSN(dsn)
+	require.NoError(t, err)
+	assert.Equal(t, "db.example.com:3306", parsed.Addr)
+	assert.Equal(t, "p@ss/word", parsed.Passwd)
+	assert.Equal(t, "toughradius", parsed.DBName)
+	assert.True(t, parsed.ParseTime)
+	assert.Equal(t, time.Local, parsed.Loc)
+	assert.Equal(t, "utf8mb4", parsed.Params["charset"])
+	assert.Empty(t, parsed.TLSConfig)
+
+	// A DSN is used as is, except that the time columns need parseTime
+	dsn, err = MysqlDSN(config.DBConfig{DSN: "u:p@tcp(10.0.0.5:3307)/radius", TLS: "skip-verify"})
+	require.NoError(t, err)
+	parsed, err = mysqldriver.ParseDSN(dsn)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.5:3307", parsed.Addr)
+	assert.True(t, parsed.ParseTime)
+	assert.Equal(t, "skip-verify", parsed.TLSConfig)
+
+	_, err = MysqlDSN(config.DBConfig{DSN: "not a dsn"})
+	assert.Error(t, err)
+	_, err = MysqlDSN(config.DBConfig{Host: "db", TLS: "sometimes"})
+	assert.Error(t, err)
+
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(ca, []byte("not a certificate"), 0o600))
+	_, err = MysqlDSN(config.DBConfig{Host: "db", TLS: "true", TLSCA: ca})
+	assert.Error(t, err)
+}
+
+func TestMysqlDatabase(t *testing.T) {
+	srv := mysqltest.NewServer("toughradius")
+	defer srv.Close()
+
+	db := getMysqlDatabase(config.DBConfig{
+		Type:     "mysql",
+		Host:     srv.Host,
+		Port:     srv.Port,
+		User:     mysqltest.User,
+		Name:     srv.DBName,
+		MaxConn:  4,
+		IdleConn: 1,
+	})
+	require.Equal(t, "mysql", db.Name()) //nolint:staticcheck
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+
+	app := &Application{gormDB: db}
+	app.checkSuper()
+	var admin domain.SysOpr
+	require.NoError(t, db.Where("username = ?", "admin").First(&admin).Error)
+	assert.Equal(t, "super", admin.Level)
+
+	expire := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	user := domain.RadiusUser{ID: common.UUIDint64(), Username: "alice", Password: "secret", Status: common.ENABLED, ExpireTime: expire}
+	require.NoError(t, db.Create(&user).Error)
+	var loaded domain.RadiusUser
+	require.NoError(t, db.Where("username = ?", "alice").First(&loaded).Error)
+	assert.True(t, expire.Equal(loaded.ExpireTime), "time columns round trip in the local zone")
+
+	// The change poller works the same on MySQL
+	bus := NewChangeBus()
+	var received []ChangeEvent
+	bus.Subscribe(func(event ChangeEvent) { received = append(received, event) })
+	receiver := NewChangePoller(db, bus, time.Hour)
+	require.NoError(t, NewChangePoller(db, NewChangeBus(), time.Hour).Send(ChangeEvent{Kind: ChangeUser, ID: user.ID, Keys: []string{"alice"}}))
+	receiver.Poll()
+	require.Len(t, received, 1)
+	assert.Equal(t, []string{"alice"}, received[0].Keys)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"gorm.io/gorm"
 )
 
@@ -58,3 +59,53 @@ func TestCheckSuperRepairsExistingAdmin(t *testing.T) {
 	assert.Equal(t, common.ENABLED, admin.Status)
 	assert.Equal(t, common.Sha256HashWithSalt("toughradius", common.GetSecretSalt()), admin.Password)
 }
+
+func TestMigratePasswords(t *testing.T) {
+	app := newTestApplication(t)
+
+	radiusUser := &domain.RadiusUser{ID: common.UUIDint64(), Username: "migrate-radius", Password: "secret1"}
+	hashedUser := &domain.RadiusUser{ID: common.UUIDint64(), Username: "migrate-hashed", Password: "{NT}8846f7eaee8fb117ad06bdd830b7586c"}
+	taggedUser := &domain.RadiusUser{ID: common.UUIDint64(), Username: "migrate-tagged", Password: "{CLEAR}$6$secret4"}
+	hotspotUser := &domain.HotspotUser{ID: common.UUIDint64(), Username: "migrate-hotspot", Password: "secret2"}
+	pppoeUser := &domain.PppoeUser{ID: common.UUIDint64(), Username: "migrate-pppoe", Password: "secret3"}
+	require.NoError(t, app.gormDB.Create(radiusUser).Error)
+	require.NoError(t, app.gormDB.Create(hashedUser).Error)
+	require.NoError(t, app.gormDB.Create(taggedUser).Error)
+	require.NoError(t, app.gormDB.Create(hotspotUser).Error)
+	require.NoError(t, app.gormDB.Create(pppoeUser).Error)
+
+	count, err := app.MigratePasswords(passwd.SchemeSHA512Crypt)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, count, 4)
+
+	var radius domain.RadiusUser
+	require.NoError(t, app.gormDB.First(&radius, radiusUser.ID).Error)
+	assert.Equal(t, passwd.SchemeSHA512Crypt, passwd.Identify(radius.Password))
+	assert.True(t, passwd.Verify(radius.Password, "secret1"))
+
+	var hashed domain.RadiusUser
+	require.NoError(t, app.gormDB.First(&hashed, hashedUser.ID).Error)
+	assert.Equal(t, hashedUser.Password, hashed.Password)
+
+	// Tagged cleartext is converted even though it looks like a hash
+	var tagged domain.RadiusUser
+	require.NoError(t, app.gormDB.First(&tagged, taggedUser.ID).Error)
+	assert.Equal(t, passwd.SchemeSHA512Crypt, passwd.Identify(tagged.Password))
+	assert.True(t, passwd.Verify(tagged.Password, "$6$secret4"))
+
+	var hotspot domain.HotspotUser
+	require.NoError(t, app.gormDB.First(&hotspot, hotspotUser.ID).Error)
+	assert.True(t, passwd.Verify(hotspot.Password, "secret2"))
+
+	var pppoe domain.PppoeUser
+	require.NoError(t, app.gormDB.First(&pppoe, pppoeUser.ID).Error)
+	assert.True(t, passwd.Verify(pppoe.Password, "secret3"))
+
+	// Already migrated passwords are skipped
+	count, err = app.MigratePasswords(passwd.SchemeBcrypt)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	_, err = app.MigratePasswords(passwd.SchemeCleartext)
+	assert.Error(t, err)
+}
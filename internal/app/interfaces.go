@@ -39,6 +39,16 @@ type ProfileCacheProvider interface {
 	ProfileCache() *ProfileCache
 }
 
+// PolicyCacheProvider provides authorization policy rule cache access
+type PolicyCacheProvider interface {
+	PolicyCache() *PolicyCache
+}
+
+// ChangeBusProvider provides the bus announcing changes to cached entities
+type ChangeBusProvider interface {
+	ChangeBus() *ChangeBus
+}
+
 // AppContext combines all provider interfaces for full application context
 // Services should depend on specific providers or this combined interface
 type AppContext interface {
@@ -48,6 +58,8 @@ type AppContext interface {
 	SchedulerProvider
 	ConfigManagerProvider
 	ProfileCacheProvider
+	PolicyCacheProvider
+	ChangeBusProvider
 
 	// Application lifecycle methods
 	MigrateDB(track bool) error
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -8,7 +10,10 @@ import (
 	"github.com/shirou/gopsutil/v4/cpu"
 	"github.com/shirou/gopsutil/v4/mem"
 	"github.com/shirou/gopsutil/v4/process"
+	"github.com/talkincode/toughradius/v9/internal/backup"
+	"github.com/talkincode/toughradius/v9/internal/billing"
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/lockout"
 	"github.com/talkincode/toughradius/v9/pkg/metrics"
 	"go.uber.org/zap"
 )
@@ -17,6 +22,14 @@ var cronParser = cron.NewParser(
 	cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
 )
 
+// validateCronSpec checks a cron expression of the settings
+func validateCronSpec(spec string) error {
+	if _, err := cronParser.Parse(spec); err != nil {
+		return fmt.Errorf("invalid cron expression: %w", err)
+	}
+	return nil
+}
+
 func (a *Application) initJob() {
 	loc, _ := time.LoadLocation(a.appConfig.System.Location)
 	a.sched = cron.New(cron.WithLocation(loc), cron.WithParser(cronParser))
@@ -30,6 +43,13 @@ func (a *Application) initJob() {
 		zap.S().Errorf("init job error %s", err.Error())
 	}
 
+	_, err = a.sched.AddFunc("@every 5m", func() {
+		go a.SchedBillingRenewTask()
+	})
+	if err != nil {
+		zap.S().Errorf("init job error %s", err.Error())
+	}
+
 	_, err = a.sched.AddFunc("@daily", func() {
 		a.gormDB.
 			Where("opt_time < ? ", time.Now().
@@ -40,6 +60,27 @@ func (a *Application) initJob() {
 		zap.S().Errorf("init job error %s", err.Error())
 	}
 
+	_, err = a.sched.AddFunc("@daily", func() {
+		go a.SchedClearAuthLogTask()
+	})
+	if err != nil {
+		zap.S().Errorf("init job error %s", err.Error())
+	}
+
+	_, err = a.sched.AddFunc("@hourly", func() {
+		go a.SchedClearLockoutTask()
+	})
+	if err != nil {
+		zap.S().Errorf("init job error %s", err.Error())
+	}
+
+	_, err = a.sched.AddFunc("@every 1m", func() {
+		go a.SchedBackupTask()
+	})
+	if err != nil {
+		zap.S().Errorf("init job error %s", err.Error())
+	}
+
 	a.sched.Start()
 }
 
@@ -90,6 +131,34 @@ func (a *Application) SchedProcessMonitorTask() {
 	}
 }
 
+// SchedBillingRenewTask renews priced accounts from the prepaid wallet and
+// suspends expired accounts that cannot be paid for
+func (a *Application) SchedBillingRenewTask() {
+	defer func() {
+		if err := recover(); err != nil {
+			zap.S().Error(err)
+		}
+	}()
+
+	if !a.ConfigMgr().GetBool("billing", "AutoRenewEnabled") {
+		return
+	}
+	ahead := time.Duration(a.ConfigMgr().GetInt("billing", "RenewAheadHours")) * time.Hour
+	stats, err := billing.NewService(a.gormDB).RunAutoRenew(context.Background(), time.Now(), ahead)
+	if err != nil {
+		zap.S().Errorf("billing renew task error %s", err.Error())
+		return
+	}
+	if stats.Checked > 0 {
+		zap.S().Infof("billing renew task: checked=%d renewed=%d suspended=%d failed=%d",
+			stats.Checked, stats.Renewed, stats.Suspended, stats.Failed)
+	}
+	if stats.Renewed > 0 || stats.Suspended > 0 {
+		// No keys: the batch may touch any user, the caches drop them all
+		a.changeBus.Publish(ChangeEvent{Kind: ChangeUser})
+	}
+}
+
 func (a *Application) SchedClearExpireData() {
 	defer func() {
 		if err := recover(); err != nil {
@@ -110,3 +179,86 @@ func (a *Application) SchedClearExpireData() {
 		Where("acct_stop_time < ? ", time.Now().
 			Add(-time.Hour*24*time.Duration(idays))).Delete(domain.RadiusAccounting{})
 }
+
+// SchedClearAuthLogTask removes the authentication log entries older than
+// the radius.AuthLogHistoryDays setting, 0 keeps them
+func (a *Application) SchedClearAuthLogTask() {
+	defer func() {
+		if err := recover(); err != nil {
+			zap.S().Error(err)
+		}
+	}()
+
+	days := a.ConfigMgr().GetInt("radius", "AuthLogHistoryDays")
+	if days <= 0 {
+		return
+	}
+	a.gormDB.
+		Where("auth_time < ?", time.Now().
+			Add(-time.Hour*24*time.Duration(days))).Delete(domain.RadiusAuthLog{})
+}
+
+// SchedClearLockoutTask removes the lockout counters no longer in effect
+func (a *Application) SchedClearLockoutTask() {
+	defer func() {
+		if err := recover(); err != nil {
+			zap.S().Error(err)
+		}
+	}()
+
+	removed, err := lockout.NewManager(a.gormDB, a.ConfigMgr()).Purge(context.Background())
+	if err != nil {
+		zap.S().Errorf("clear lockout task error %s", err.Error())
+		return
+	}
+	if removed > 0 {
+		zap.S().Infof("clear lockout task: removed %d counters", removed)
+	}
+}
+
+// SchedBackupTask takes a backup when the backup.Schedule cron expression
+// came due since the previous check, then removes the backups beyond
+// backup.Retention
+func (a *Application) SchedBackupTask() {
+	defer func() {
+		if err := recover(); err != nil {
+			zap.S().Error(err)
+		}
+	}()
+
+	// A backup outlasting the check interval is not started twice
+	if !a.backupMu.TryLock() {
+		return
+	}
+	defer a.backupMu.Unlock()
+
+	now := time.Now()
+	last := a.backupChecked
+	a.backupChecked = now
+	if last.IsZero() || !a.ConfigMgr().GetBool("backup", "Enabled") {
+		return
+	}
+	schedule, err := cronParser.Parse(a.ConfigMgr().GetString("backup", "Schedule"))
+	if err != nil {
+		zap.S().Errorf("backup task error %s", err.Error())
+		return
+	}
+	if schedule.Next(last).After(now) {
+		return
+	}
+
+	svc := backup.NewService(a.gormDB, a.appConfig.GetBackupDir())
+	info, err := svc.Create(context.Background())
+	if err != nil {
+		zap.S().Errorf("backup task error %s", err.Error())
+		return
+	}
+	zap.S().Infof("backup task: created %s (%d bytes)", info.Name, info.Size)
+	removed, err := svc.Prune(int(a.ConfigMgr().GetInt("backup", "Retention")))
+	if err != nil {
+		zap.S().Errorf("backup task prune error %s", err.Error())
+	}
+	if len(removed) > 0 {
+		zap.S().Infof("backup task: removed %v", removed)
+	}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/app/migrations"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// migrateSchema applies the pending versioned migrations, then lets
+// AutoMigrate create the new tables and columns. AutoMigrate runs even when
+// a migration fails: the failed one stays pending and is retried on the next
+// run, and the migrations cope with columns AutoMigrate added meanwhile.
+func migrateSchema(db *gorm.DB, ms ...migrations.Migration) ([]migrations.Migration, error) {
+	applied, err := migrations.NewRunner(db, ms...).Up()
+	for _, m := range applied {
+		zap.S().Infof("applied migration %d: %s", m.Version, m.Name)
+	}
+	return applied, errors.Join(err, db.Migrator().AutoMigrate(domain.Tables...))
+}
+
+// RunMigrations runs a -migrate command against the configured database
+// without starting the application: up applies the pending migrations,
+// down reverts the latest one and status lists them.
+func RunMigrations(cfg *config.AppConfig, command string, out io.Writer) error {
+	db, closeDB := openCommandDatabase(cfg)
+	defer closeDB()
+	return runMigrateCommand(db, command, out)
+}
+
+// openCommandDatabase connects to the configured database for a command
+// line tool, without starting the application
+func openCommandDatabase(cfg *config.AppConfig) (*gorm.DB, func()) {
+	if loc, err := time.LoadLocation(cfg.System.Location); err == nil {
+		time.Local = loc
+	}
+	if cfg.Database.Type == "" {
+		cfg.Database.Type = "postgres"
+	}
+	db := getDatabase(cfg.Database, cfg.System.Workdir)
+	return db, func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close() //nolint:errcheck
+		}
+	}
+}
+
+func runMigrateCommand(db *gorm.DB, command string, out io.Writer) error {
+	runner := migrations.NewRunner(db)
+	switch command {
+	case "up":
+		applied, err := migrateSchema(db)
+		for _, m := range applied {
+			_, _ = fmt.Fprintf(out, "applied %d: %s\n", m.Version, m.Name) //nolint:errcheck
+		}
+		if err == nil && len(applied) == 0 {
+			_, _ = fmt.Fprintln(out, "no pending migrations") //nolint:errcheck
+		}
+		return err
+	case "down":
+		reverted, err := runner.Down()
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			_, _ = fmt.Fprintln(out, "no applied migrations") //nolint:errcheck
+			return nil
+		}
+		_, _ = fmt.Fprintf(out, "reverted %d: %s\n", reverted.Version, reverted.Name) //nolint:errcheck
+		return nil
+	case "status":
+		status, err := runner.Status()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT") //nolint:errcheck
+		for _, s := range status {
+			state, appliedAt := "pending", "-"
+			if s.Applied {
+				state, appliedAt = "applied", s.AppliedAt.Format(time.DateTime)
+			}
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt) //nolint:errcheck
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", command)
+	}
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// MigratePasswords re-encodes the cleartext passwords of RADIUS, hotspot and
+// PPPoE users with the given scheme and returns how many were converted.
+// Passwords that are already hashed are left untouched.
+func (a *Application) MigratePasswords(scheme passwd.Scheme) (int, error) {
+	if scheme == passwd.SchemeCleartext {
+		return 0, errors.New("hashed passwords cannot be converted back to cleartext")
+	}
+
+	total := 0
+	for _, model := range []schema.Tabler{&domain.RadiusUser{}, &domain.HotspotUser{}, &domain.PppoeUser{}} {
+		count, err := a.migrateTablePasswords(model, scheme)
+		total += count
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (a *Application) migrateTablePasswords(model schema.Tabler, scheme passwd.Scheme) (int, error) {
+	type userPassword struct {
+		ID       int64
+		Password string
+	}
+
+	count := 0
+	var rows []userPassword
+	result := a.gormDB.Model(model).Select("id", "password").
+		FindInBatches(&rows, 500, func(_ *gorm.DB, _ int) error {
+			for _, row := range rows {
+				plain, ok := passwd.Cleartext(row.Password)
+				if row.Password == "" || !ok {
+					continue
+				}
+				hashed, err := passwd.Hash(scheme, plain)
+				if err != nil {
+					return err
+				}
+				if err := a.gormDB.Model(model).Where("id = ?", row.ID).Update("password", hashed).Error; err != nil {
+					return err
+				}
+				count++
+			}
+			return nil
+		})
+	if result.Error != nil {
+		return count, result.Error
+	}
+
+	zap.L().Info("migrated user passwords",
+		zap.String("table", model.TableName()),
+		zap.String("scheme", string(scheme)),
+		zap.Int("count", count))
+	return count, nil
+}
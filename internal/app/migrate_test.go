@@ -0,0 +1,57 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app/migrations"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestRunMigrateCommand(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	run := func(command string) string {
+		var out bytes.Buffer
+		require.NoError(t, runMigrateCommand(db, command, &out))
+		return out.String()
+	}
+
+	assert.Contains(t, run("status"), "pending")
+	assert.Contains(t, run("up"), "applied 1:")
+	assert.True(t, db.Migrator().HasTable(&domain.RadiusUser{}), "up creates the schema")
+	assert.Contains(t, run("up"), "no pending migrations")
+	assert.NotContains(t, run("status"), "pending")
+	assert.Contains(t, run("down"), "reverted 3:")
+	assert.Contains(t, run("status"), "pending")
+
+	assert.Error(t, runMigrateCommand(db, "sideways", &bytes.Buffer{}))
+}
+
+func TestMigrateSchemaAfterFailedMigration(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	broken := migrations.Migration{Version: 1, Name: "broken", Up: func(tx *gorm.DB) error {
+		return errors.New("boom")
+	}}
+	_, err = migrateSchema(db, broken)
+	assert.ErrorContains(t, err, "boom")
+	assert.True(t, db.Migrator().HasTable(&domain.RadiusUser{}), "the tables are created anyway")
+
+	status, err := migrations.NewRunner(db, broken).Status()
+	require.NoError(t, err)
+	assert.False(t, status[0].Applied, "the failed migration stays pending")
+}
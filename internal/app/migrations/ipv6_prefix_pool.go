@@ -0,0 +1,34 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// ipv6PrefixPool renames radius_profile.ipv6_prefix to ipv6_prefix_pool,
+// formerly scripts/migrate_ipv6_profile_link.sql. When AutoMigrate already
+// added the new column next to the old one, the old values are carried over.
+var ipv6PrefixPool = Migration{
+	Version: 1,
+	Name:    "rename profile ipv6_prefix to ipv6_prefix_pool",
+	Renames: []ColumnRename{{Table: "radius_profile", From: "ipv6_prefix", To: "ipv6_prefix_pool"}},
+	Up: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		if !m.HasTable("radius_profile") || !m.HasColumn("radius_profile", "ipv6_prefix") {
+			return nil
+		}
+		if !m.HasColumn("radius_profile", "ipv6_prefix_pool") {
+			return m.RenameColumn("radius_profile", "ipv6_prefix", "ipv6_prefix_pool")
+		}
+		err := tx.Exec("UPDATE radius_profile SET ipv6_prefix_pool = ipv6_prefix WHERE ipv6_prefix_pool IS NULL OR ipv6_prefix_pool = ''").Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec("ALTER TABLE radius_profile DROP COLUMN ipv6_prefix").Error
+	},
+	Down: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		if !m.HasTable("radius_profile") || !m.HasColumn("radius_profile", "ipv6_prefix_pool") ||
+			m.HasColumn("radius_profile", "ipv6_prefix") {
+			return nil
+		}
+		return m.RenameColumn("radius_profile", "ipv6_prefix_pool", "ipv6_prefix")
+	},
+}
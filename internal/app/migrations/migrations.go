@@ -0,0 +1,168 @@
+// Package migrations holds the versioned schema and data migrations of
+// ToughRADIUS. They are compiled into the binary and applied in version
+// order, each in its own transaction, and the applied versions are recorded
+// in the schema_version table.
+//
+// Migrations run before GORM AutoMigrate, which keeps creating new tables
+// and columns: a migration covers what AutoMigrate cannot, such as renamed
+// columns and backfilled data. Migrations must therefore tolerate missing
+// tables, which AutoMigrate creates afterwards on a fresh install, and be
+// idempotent: the migrations newer than a restored backup run again on its
+// rows.
+package migrations
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Migration is a versioned change of the database
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error // nil when the migration cannot be reverted
+	Renames []ColumnRename          // Columns renamed by Up, mapped when restoring older backups
+}
+
+// ColumnRename is a column renamed by a migration
+type ColumnRename struct {
+	Table string
+	From  string
+	To    string
+}
+
+// Status is the state of a migration in a database
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// All returns the migrations of this release in version order
+func All() []Migration {
+	all := []Migration{
+		ipv6PrefixPool,
+		profileLinkMode,
+		userDeviceUnique,
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Version < all[j].Version })
+	return all
+}
+
+// Since returns the migrations of this release newer than version, in
+// version order
+func Since(version int64) []Migration {
+	var newer []Migration
+	for _, m := range All() {
+		if m.Version > version {
+			newer = append(newer, m)
+		}
+	}
+	return newer
+}
+
+// Runner applies and reverts migrations on a database
+type Runner struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewRunner creates a runner of the given migrations, All() when none are
+// given
+func NewRunner(db *gorm.DB, migrations ...Migration) *Runner {
+	if len(migrations) == 0 {
+		migrations = All()
+	}
+	return &Runner{db: db, migrations: migrations}
+}
+
+// Up applies the pending migrations in version order and returns them
+func (r *Runner) Up() ([]Migration, error) {
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+	var done []Migration
+	for _, m := range r.migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&domain.SchemaVersion{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %d %s: %w", m.Version, m.Name, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// Down reverts the latest applied migration and returns it, or nil when no
+// migration is applied
+func (r *Runner) Down() (*Migration, error) {
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(r.migrations) - 1; i >= 0; i-- {
+		m := r.migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if m.Down == nil {
+			return nil, fmt.Errorf("migration %d %s cannot be reverted", m.Version, m.Name)
+		}
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&domain.SchemaVersion{}, m.Version).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("revert migration %d %s: %w", m.Version, m.Name, err)
+		}
+		return &m, nil
+	}
+	return nil, nil
+}
+
+// Status reports every migration with whether it is applied
+func (r *Runner) Status() ([]Status, error) {
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Status, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		version, ok := applied[m.Version]
+		result = append(result, Status{Version: m.Version, Name: m.Name, Applied: ok, AppliedAt: version.AppliedAt})
+	}
+	return result, nil
+}
+
+// applied returns the recorded versions, creating the schema_version table
+// on first use
+func (r *Runner) applied() (map[int64]domain.SchemaVersion, error) {
+	if err := r.db.AutoMigrate(&domain.SchemaVersion{}); err != nil {
+		return nil, fmt.Errorf("create schema_version: %w", err)
+	}
+	var versions []domain.SchemaVersion
+	if err := r.db.Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]domain.SchemaVersion, len(versions))
+	for _, v := range versions {
+		applied[v.Version] = v
+	}
+	return applied, nil
+}
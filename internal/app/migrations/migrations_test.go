@@ -0,0 +1,173 @@
+package migrations
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app/mysqltest"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrations.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() }) //nolint:errcheck
+	return db
+}
+
+func openMysqlTestDB(t *testing.T) *gorm.DB {
+	srv := mysqltest.NewServer("toughradius")
+	t.Cleanup(srv.Close)
+	db, err := gorm.Open(mysql.Open(srv.DSN()), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	return db
+}
+
+// createLegacySchema creates the tables as a release before profile linking
+// left them
+func createLegacySchema(t *testing.T, db *gorm.DB) {
+	for _, stmt := range []string{
+		"CREATE TABLE radius_profile (id INTEGER PRIMARY KEY, name TEXT, ipv6_prefix TEXT)",
+		"CREATE TABLE radius_user (id INTEGER PRIMARY KEY, profile_id INTEGER, username TEXT)",
+		"INSERT INTO radius_profile (id, name, ipv6_prefix) VALUES (1, 'gold', 'pool-gold'), (2, 'basic', '')",
+		"INSERT INTO radius_user (id, profile_id, username) VALUES (1, 1, 'alice'), (2, 2, 'bob'), (3, 9, 'carol')",
+		"CREATE TABLE radius_user_device (id INTEGER PRIMARY KEY, user_id INTEGER, mac_addr VARCHAR(32))",
+		"INSERT INTO radius_user_device (id, user_id, mac_addr) VALUES (1, 1, 'aa:bb:cc:00:00:01'), (2, 1, 'aa:bb:cc:00:00:01'), (3, 1, 'aa:bb:cc:00:00:02'), (4, 2, 'aa:bb:cc:00:00:01')",
+	} {
+		require.NoError(t, db.Exec(stmt).Error)
+	}
+}
+
+func TestUpgradeLegacySchema(t *testing.T) {
+	for name, open := range map[string]func(*testing.T) *gorm.DB{
+		"sqlite": openTestDB,
+		"mysql":  openMysqlTestDB,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testUpgradeLegacySchema(t, open(t))
+		})
+	}
+}
+
+func testUpgradeLegacySchema(t *testing.T, db *gorm.DB) {
+	createLegacySchema(t, db)
+
+	runner := NewRunner(db)
+	applied, err := runner.Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, len(All()))
+
+	m := db.Migrator()
+	assert.False(t, m.HasColumn("radius_profile", "ipv6_prefix"))
+	var pool string
+	require.NoError(t, db.Raw("SELECT ipv6_prefix_pool FROM radius_profile WHERE id = 1").Scan(&pool).Error)
+	assert.Equal(t, "pool-gold", pool)
+
+	// The schema of this release applies on top
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+	var users []domain.RadiusUser
+	require.NoError(t, db.Order("id").Find(&users).Error)
+	require.Len(t, users, 3)
+	assert.Equal(t, "pool-gold", users[0].IPv6PrefixPool)
+	assert.Empty(t, users[1].IPv6PrefixPool)
+	assert.Empty(t, users[2].IPv6PrefixPool)
+	for _, user := range users {
+		assert.Equal(t, domain.ProfileLinkModeStatic, user.ProfileLinkMode)
+	}
+	var deviceIDs []int64
+	require.NoError(t, db.Model(&domain.RadiusUserDevice{}).Order("id").Pluck("id", &deviceIDs).Error)
+	assert.Equal(t, []int64{1, 3, 4}, deviceIDs, "duplicate devices are removed")
+
+	// Nothing is pending anymore
+	applied, err = runner.Up()
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	status, err := runner.Status()
+	require.NoError(t, err)
+	for _, s := range status {
+		assert.True(t, s.Applied, s.Name)
+		assert.False(t, s.AppliedAt.IsZero())
+	}
+
+	// Reverting one at a time restores the old column
+	for i := len(All()) - 1; i >= 0; i-- {
+		reverted, err := runner.Down()
+		require.NoError(t, err)
+		require.NotNil(t, reverted)
+		assert.Equal(t, All()[i].Version, reverted.Version)
+	}
+	reverted, err := runner.Down()
+	require.NoError(t, err)
+	assert.Nil(t, reverted)
+	assert.True(t, m.HasColumn("radius_profile", "ipv6_prefix"))
+	assert.False(t, m.HasColumn("radius_profile", "ipv6_prefix_pool"))
+	status, err = runner.Status()
+	require.NoError(t, err)
+	for _, s := range status {
+		assert.False(t, s.Applied, s.Name)
+	}
+}
+
+func TestUpgradeAfterAutoMigrate(t *testing.T) {
+	// AutoMigrate of a newer release ran before the migrations existed and
+	// added the new column next to the old one
+	db := openTestDB(t)
+	createLegacySchema(t, db)
+	require.NoError(t, db.AutoMigrate(&domain.RadiusProfile{}))
+
+	_, err := NewRunner(db).Up()
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn("radius_profile", "ipv6_prefix"))
+	var profile domain.RadiusProfile
+	require.NoError(t, db.First(&profile, 1).Error)
+	assert.Equal(t, "pool-gold", profile.IPv6PrefixPool)
+}
+
+func TestFreshInstall(t *testing.T) {
+	db := openTestDB(t)
+	applied, err := NewRunner(db).Up()
+	require.NoError(t, err)
+	assert.Len(t, applied, len(All()))
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+}
+
+func TestFailedAndIrreversibleMigrations(t *testing.T) {
+	db := openTestDB(t)
+	runner := NewRunner(db,
+		Migration{Version: 1, Name: "create", Up: func(tx *gorm.DB) error {
+			return tx.Exec("CREATE TABLE note (id INTEGER PRIMARY KEY)").Error
+		}},
+		Migration{Version: 2, Name: "broken", Up: func(tx *gorm.DB) error {
+			if err := tx.Exec("INSERT INTO note (id) VALUES (1)").Error; err != nil {
+				return err
+			}
+			return errors.New("boom")
+		}},
+	)
+	applied, err := runner.Up()
+	require.Error(t, err)
+	assert.Len(t, applied, 1)
+
+	// The failed migration is rolled back and stays pending
+	var count int64
+	require.NoError(t, db.Table("note").Count(&count).Error)
+	assert.Zero(t, count)
+	status, err := runner.Status()
+	require.NoError(t, err)
+	assert.True(t, status[0].Applied)
+	assert.False(t, status[1].Applied)
+
+	_, err = runner.Down()
+	assert.ErrorContains(t, err, "cannot be reverted")
+}
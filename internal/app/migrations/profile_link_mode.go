@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+)
+
+// profileLinkMode backfills the users created before profile linking. They
+// get the static link mode, which AutoMigrate leaves NULL on some databases,
+// and the snapshot of their profile's IPv6 prefix pool, as if they had been
+// created with it.
+var profileLinkMode = Migration{
+	Version: 2,
+	Name:    "backfill user profile link mode",
+	Up: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		if !m.HasTable("radius_user") {
+			return nil
+		}
+		for _, field := range []string{"IPv6PrefixPool", "ProfileLinkMode"} {
+			if !m.HasColumn(&domain.RadiusUser{}, field) {
+				if err := m.AddColumn(&domain.RadiusUser{}, field); err != nil {
+					return err
+				}
+			}
+		}
+		err := tx.Exec("UPDATE radius_user SET profile_link_mode = ? WHERE profile_link_mode IS NULL",
+			domain.ProfileLinkModeStatic).Error
+		if err != nil {
+			return err
+		}
+		if !m.HasTable("radius_profile") || !m.HasColumn("radius_profile", "ipv6_prefix_pool") {
+			return nil
+		}
+		return tx.Exec(`UPDATE radius_user SET ipv6_prefix_pool =
+			(SELECT p.ipv6_prefix_pool FROM radius_profile p WHERE p.id = radius_user.profile_id)
+			WHERE profile_link_mode = ? AND (ipv6_prefix_pool IS NULL OR ipv6_prefix_pool = '')
+			AND EXISTS (SELECT 1 FROM radius_profile p WHERE p.id = radius_user.profile_id AND p.ipv6_prefix_pool <> '')`,
+			domain.ProfileLinkModeStatic).Error
+	},
+	// The backfilled values are valid without the migration as well
+	Down: func(tx *gorm.DB) error { return nil },
+}
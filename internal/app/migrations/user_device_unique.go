@@ -0,0 +1,22 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// userDeviceUnique removes the duplicate devices concurrent logins could
+// learn, keeping the first one, so AutoMigrate can add the unique index on
+// user and MAC address.
+var userDeviceUnique = Migration{
+	Version: 3,
+	Name:    "deduplicate user devices",
+	Up: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasTable("radius_user_device") {
+			return nil
+		}
+		// The derived table lets MySQL delete from the table it reads
+		return tx.Exec(`DELETE FROM radius_user_device WHERE id IN (SELECT id FROM
+			(SELECT d.id FROM radius_user_device d JOIN radius_user_device k
+			ON k.user_id = d.user_id AND k.mac_addr = d.mac_addr AND k.id < d.id) dup)`).Error
+	},
+	// Removed duplicates carry no information of their own
+	Down: func(tx *gorm.DB) error { return nil },
+}
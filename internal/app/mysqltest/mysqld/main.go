@@ -0,0 +1,51 @@
+// Command mysqld serves an in-memory MySQL-compatible database backed by
+// go-mysql-server for the tests of the mysqltest package. It is a module of
+// its own, so the engine and its dependencies stay out of the main module.
+//
+// It listens on a free loopback port, prints the address on the first line
+// of stdout and stops when stdin is closed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	dbName := flag.String("db", "toughradius", "database name")
+	flag.Parse()
+
+	// The engine logs every query and connection
+	logrus.SetLevel(logrus.WarnLevel)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	db := memory.NewDatabase(*dbName)
+	db.BaseDatabase.EnablePrimaryKeyIndexes()
+	provider := memory.NewDBProvider(db)
+	engine := sqle.NewDefault(provider)
+
+	srv, err := server.NewServer(server.Config{Protocol: "tcp", Listener: ln}, engine, sql.NewContext, memory.NewSessionBuilder(provider), nil)
+	if err != nil {
+		log.Fatalf("failed to start: %v", err)
+	}
+	go func() {
+		_, _ = io.Copy(io.Discard, os.Stdin) //nolint:errcheck
+		_ = srv.Close()                      //nolint:errcheck
+	}()
+
+	fmt.Println(ln.Addr().String())
+	_ = srv.Start() //nolint:errcheck // returns when closed
+}
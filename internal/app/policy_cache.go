@@ -0,0 +1,66 @@
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DefaultPolicyCacheTTL bounds how long rule changes made outside the admin
+// API take to apply
+const DefaultPolicyCacheTTL = time.Minute
+
+// PolicyCache keeps the compiled authorization policy rules in memory so the
+// rules table is not queried for every Access-Request
+type PolicyCache struct {
+	mu        sync.RWMutex
+	db        *gorm.DB
+	ttl       time.Duration
+	rules     *domain.PolicyRuleSet
+	expiresAt time.Time
+}
+
+// NewPolicyCache creates a new policy rule cache
+func NewPolicyCache(db *gorm.DB, ttl time.Duration) *PolicyCache {
+	if ttl == 0 {
+		ttl = DefaultPolicyCacheTTL
+	}
+	return &PolicyCache{db: db, ttl: ttl}
+}
+
+// Rules returns the enabled rules, loading them from the database when the
+// cache is empty or expired
+func (pc *PolicyCache) Rules() (*domain.PolicyRuleSet, error) {
+	pc.mu.RLock()
+	rules, expiresAt := pc.rules, pc.expiresAt
+	pc.mu.RUnlock()
+	if rules != nil && time.Now().Before(expiresAt) {
+		return rules, nil
+	}
+
+	var items []domain.PolicyRule
+	if err := pc.db.Where("status = ?", "enabled").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	rules, err := domain.NewPolicyRuleSet(items)
+	if err != nil {
+		// The admin API validates rules, skip any edited behind its back
+		zap.L().Warn("invalid policy rules skipped", zap.Error(err))
+	}
+
+	pc.mu.Lock()
+	pc.rules = rules
+	pc.expiresAt = time.Now().Add(pc.ttl)
+	pc.mu.Unlock()
+	return rules, nil
+}
+
+// Invalidate drops the cached rules (call when a rule is created, updated or deleted)
+func (pc *PolicyCache) Invalidate() {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.rules = nil
+}
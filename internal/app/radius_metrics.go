@@ -14,8 +14,15 @@ const (
 	MetricsRadiusRejectLimit        = "radus_reject_limit"
 	MetricsRadiusRejectBindError    = "radus_reject_bind_error"
 	MetricsRadiusRejectLdapError    = "radus_reject_ldap_error"
+	MetricsRadiusRejectOtpError     = "radus_reject_otp_error"
+	MetricsRadiusRejectHookError    = "radus_reject_hook_error"
 	MetricsRadiusRejectPasswdError  = "radus_reject_passwd_error" //nolint:gosec // G101: this is a metric name, not a credential
 	MetricsRadiusRejectUnauthorized = "radus_reject_unauthorized"
+	MetricsRadiusRejectTimeWindow   = "radus_reject_time_window"
+	MetricsRadiusRejectCheckItem    = "radus_reject_check_item"
+	MetricsRadiusRejectPolicy       = "radus_reject_policy"
+	MetricsRadiusRejectNode         = "radus_reject_node"
+	MetricsRadiusRejectLockout      = "radus_reject_lockout"
 	MetricsRadiusAuthDrop           = "radus_auth_drop"
 	MetricsRadiusAcctDrop           = "radus_acct_drop"
 	MetricsRadiusAccept             = "radus_accept"
@@ -32,8 +39,15 @@ var metricsNames = []string{
 	MetricsRadiusRejectLimit,
 	MetricsRadiusRejectBindError,
 	MetricsRadiusRejectLdapError,
+	MetricsRadiusRejectOtpError,
+	MetricsRadiusRejectHookError,
 	MetricsRadiusRejectPasswdError,
 	MetricsRadiusRejectUnauthorized,
+	MetricsRadiusRejectTimeWindow,
+	MetricsRadiusRejectCheckItem,
+	MetricsRadiusRejectPolicy,
+	MetricsRadiusRejectNode,
+	MetricsRadiusRejectLockout,
 	MetricsRadiusAuthDrop,
 	MetricsRadiusAcctDrop,
 	MetricsRadiusAccept,
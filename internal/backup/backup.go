@@ -0,0 +1,562 @@
+// Package backup takes and restores logical backups of the ToughRADIUS
+// database. A backup is a gzip-compressed JSON lines stream: a header naming
+// the tables, one line per row keyed by column name, and a trailer with the
+// row counts that tells a complete backup from a truncated one.
+//
+// Rows are read within a single transaction, so a backup is a consistent
+// snapshot, and encoded from the GORM schema rather than the SQL dialect,
+// so a backup of SQLite restores into PostgreSQL or MySQL and the other way
+// round.
+package backup
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/app/migrations"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	formatName    = "toughradius-backup"
+	formatVersion = 1
+
+	filePrefix = "toughradius-"
+	fileSuffix = ".jsonl.gz"
+	timeLayout = "20060102-150405"
+
+	insertBatchSize = 200
+)
+
+var (
+	// ErrInvalidName is returned for a name that is not a backup file name.
+	ErrInvalidName = errors.New("invalid backup name")
+	// ErrNotFound is returned when a backup does not exist.
+	ErrNotFound = errors.New("backup not found")
+	// ErrInvalidBackup is returned when a stream is not a complete backup.
+	ErrInvalidBackup = errors.New("invalid or truncated backup")
+	// ErrUnknownTable is returned when restoring a table the backup lacks.
+	ErrUnknownTable = errors.New("table not in backup")
+	// ErrNewerSchema is returned when restoring a backup of a newer release.
+	ErrNewerSchema = errors.New("backup was taken with a newer schema version")
+	// ErrUnknownColumn is returned when a backup holds a column the schema
+	// of this release lacks and no migration renamed.
+	ErrUnknownColumn = errors.New("column not in the current schema")
+)
+
+// Header is the first line of a backup
+type Header struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Database      string    `json:"database"`       // Dialect of the source database
+	SchemaVersion int64     `json:"schema_version"` // Latest migration applied to the source
+	Tables        []string  `json:"tables"`
+}
+
+// line is any line after the header: a row, or the trailer with the counts
+type line struct {
+	Table string                     `json:"t,omitempty"`
+	Row   map[string]json.RawMessage `json:"r,omitempty"`
+	Rows  map[string]int64           `json:"rows,omitempty"`
+}
+
+// Info describes a backup file
+type Info struct {
+	Name      string           `json:"name"`
+	Size      int64            `json:"size"`
+	CreatedAt time.Time        `json:"created_at"`
+	Rows      map[string]int64 `json:"rows,omitempty"` // Set for a backup just taken
+}
+
+// Result reports a restore
+type Result struct {
+	Header Header           `json:"header"`
+	Rows   map[string]int64 `json:"rows"` // Rows restored per table
+}
+
+// Tables returns the models a backup covers: domain.Tables without the
+// schema_version bookkeeping, which belongs to the target database, and the
+// transient change events
+func Tables() []schema.Tabler {
+	tables := make([]schema.Tabler, 0, len(domain.Tables))
+	for _, table := range domain.Tables {
+		switch table.(type) {
+		case *domain.SchemaVersion, *domain.SysChangeEvent:
+			continue
+		}
+		tables = append(tables, table.(schema.Tabler)) //nolint:errcheck // every model names its table
+	}
+	return tables
+}
+
+// Service takes backups of a database into a directory and restores them
+type Service struct {
+	db  *gorm.DB
+	dir string
+	now func() time.Time
+}
+
+// NewService creates a backup service of db keeping its files in dir
+func NewService(db *gorm.DB, dir string) *Service {
+	return &Service{db: db, dir: dir, now: time.Now}
+}
+
+// Create writes a new backup file and returns it
+func (s *Service) Create(ctx context.Context) (*Info, error) {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return nil, err
+	}
+	name := filePrefix + s.now().Format(timeLayout) + fileSuffix
+	path := filepath.Join(s.dir, name)
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("backup %s already exists", name)
+	}
+
+	// Written under a temporary name, so a listed backup is always complete
+	tmp, err := os.CreateTemp(s.dir, ".backup-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }() //nolint:errcheck // gone after the rename
+
+	rows, err := s.Dump(ctx, tmp)
+	if err != nil {
+		_ = tmp.Close() //nolint:errcheck
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	info, err := s.stat(name)
+	if err != nil {
+		return nil, err
+	}
+	info.Rows = rows
+	return info, nil
+}
+
+// Dump writes a backup to w and returns the rows written per table
+func (s *Service) Dump(ctx context.Context, w io.Writer) (map[string]int64, error) {
+	gz := gzip.NewWriter(w)
+	enc := json.NewEncoder(gz)
+	rows := make(map[string]int64)
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		header := Header{
+			Format:        formatName,
+			Version:       formatVersion,
+			CreatedAt:     s.now(),
+			Database:      tx.Name(),
+			SchemaVersion: appliedVersion(tx),
+		}
+		tables := Tables()
+		for _, table := range tables {
+			header.Tables = append(header.Tables, table.TableName())
+		}
+		if err := enc.Encode(header); err != nil {
+			return err
+		}
+		for _, table := range tables {
+			count, err := dumpTable(ctx, tx, enc, table)
+			if err != nil {
+				return fmt.Errorf("dump %s: %w", table.TableName(), err)
+			}
+			rows[table.TableName()] = count
+		}
+		return enc.Encode(line{Rows: rows})
+	}, snapshotOptions(s.db))
+	if err != nil {
+		return nil, err
+	}
+	return rows, gz.Close()
+}
+
+// dumpTable writes the rows of a table, one line each
+func dumpTable(ctx context.Context, tx *gorm.DB, enc *json.Encoder, table schema.Tabler) (int64, error) {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(table); err != nil {
+		return 0, err
+	}
+	if !tx.Migrator().HasTable(table) {
+		return 0, nil
+	}
+
+	rows, err := tx.Model(table).Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }() //nolint:errcheck
+
+	var count int64
+	for rows.Next() {
+		record := reflect.New(stmt.Schema.ModelType).Elem()
+		if err := tx.ScanRows(rows, record.Addr().Interface()); err != nil {
+			return count, err
+		}
+		row := make(map[string]any, len(stmt.Schema.DBNames))
+		for _, dbName := range stmt.Schema.DBNames {
+			field := stmt.Schema.FieldsByDBName[dbName]
+			if !isColumn(field) {
+				continue
+			}
+			row[dbName], _ = field.ValueOf(ctx, record) //nolint:errcheck // zero flag only
+		}
+		if err := enc.Encode(map[string]any{"t": table.TableName(), "r": row}); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+// Restore replaces the given tables, all tables of the backup when none are
+// given, with the rows of the backup read from r. The tables are restored
+// in one transaction: a failed or truncated restore leaves them unchanged.
+//
+// A backup of an older schema version is brought up to date: the columns
+// renamed since are mapped to their new names, and the newer migrations run
+// on the restored rows, so their data backfills apply. A column neither the
+// schema nor a rename knows fails the restore rather than losing its data.
+func (s *Service) Restore(ctx context.Context, r io.Reader, tables []string) (*Result, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+	defer func() { _ = gz.Close() }() //nolint:errcheck
+	dec := json.NewDecoder(gz)
+
+	var header Header
+	if err := dec.Decode(&header); err != nil || header.Format != formatName {
+		return nil, ErrInvalidBackup
+	}
+	if header.Version > formatVersion {
+		return nil, fmt.Errorf("%w: format version %d", ErrInvalidBackup, header.Version)
+	}
+	if latest := latestVersion(); header.SchemaVersion > latest {
+		return nil, fmt.Errorf("%w: %d, this release knows %d", ErrNewerSchema, header.SchemaVersion, latest)
+	}
+
+	schemas, err := s.selectTables(header, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := migrations.Since(header.SchemaVersion)
+	renames := columnRenames(pending)
+
+	result := &Result{Header: header, Rows: make(map[string]int64)}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for name, sch := range schemas {
+			if err := tx.Exec("DELETE FROM " + tx.Statement.Quote(sch.Table)).Error; err != nil {
+				return fmt.Errorf("clear %s: %w", name, err)
+			}
+			result.Rows[name] = 0
+		}
+
+		batches := make(map[string][]map[string]any)
+		flush := func(name string) error {
+			if len(batches[name]) == 0 {
+				return nil
+			}
+			if err := tx.Table(schemas[name].Table).Create(batches[name]).Error; err != nil {
+				return fmt.Errorf("restore %s: %w", name, err)
+			}
+			batches[name] = batches[name][:0]
+			return nil
+		}
+
+		for {
+			var l line
+			if err := dec.Decode(&l); err != nil {
+				return ErrInvalidBackup
+			}
+			if l.Table == "" {
+				// The trailer: check that every row was read
+				for name := range schemas {
+					if err := flush(name); err != nil {
+						return err
+					}
+					if result.Rows[name] != l.Rows[name] {
+						return ErrInvalidBackup
+					}
+				}
+				for _, m := range pending {
+					if err := m.Up(tx); err != nil {
+						return fmt.Errorf("migration %d %s: %w", m.Version, m.Name, err)
+					}
+				}
+				return resetSequences(tx, schemas)
+			}
+			sch, ok := schemas[l.Table]
+			if !ok {
+				continue
+			}
+			row, err := decodeRow(sch, l.Row, renames[l.Table])
+			if err != nil {
+				return fmt.Errorf("restore %s: %w", l.Table, err)
+			}
+			batches[l.Table] = append(batches[l.Table], row)
+			result.Rows[l.Table]++
+			if len(batches[l.Table]) >= insertBatchSize {
+				if err := flush(l.Table); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreFile restores a backup file of the directory, see Restore
+func (s *Service) RestoreFile(ctx context.Context, name string, tables []string) (*Result, error) {
+	f, err := s.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }() //nolint:errcheck
+	return s.Restore(ctx, f, tables)
+}
+
+// selectTables resolves the tables to restore to their schemas
+func (s *Service) selectTables(header Header, tables []string) (map[string]*schema.Schema, error) {
+	inBackup := make(map[string]bool, len(header.Tables))
+	for _, name := range header.Tables {
+		inBackup[name] = true
+	}
+	if len(tables) == 0 {
+		tables = header.Tables
+	}
+
+	known := make(map[string]schema.Tabler)
+	for _, table := range Tables() {
+		known[table.TableName()] = table
+	}
+	schemas := make(map[string]*schema.Schema, len(tables))
+	for _, name := range tables {
+		table, ok := known[name]
+		if !ok || !inBackup[name] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTable, name)
+		}
+		stmt := &gorm.Statement{DB: s.db}
+		if err := stmt.Parse(table); err != nil {
+			return nil, err
+		}
+		schemas[name] = stmt.Schema
+	}
+	return schemas, nil
+}
+
+// decodeRow converts a backup row to the column values of the schema,
+// mapping renamed columns to their new names. Columns the backup lacks take
+// their default.
+func decodeRow(sch *schema.Schema, raw map[string]json.RawMessage, renames []migrations.ColumnRename) (map[string]any, error) {
+	for _, rename := range renames {
+		if data, ok := raw[rename.From]; ok {
+			if _, exists := raw[rename.To]; !exists {
+				raw[rename.To] = data
+			}
+			delete(raw, rename.From)
+		}
+	}
+
+	row := make(map[string]any, len(sch.DBNames))
+	for column, data := range raw {
+		field, ok := sch.FieldsByDBName[column]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.%s", ErrUnknownColumn, sch.Table, column)
+		}
+		if !isColumn(field) {
+			continue
+		}
+		value := reflect.New(field.FieldType)
+		if err := json.Unmarshal(data, value.Interface()); err != nil {
+			return nil, fmt.Errorf("column %s: %w", column, err)
+		}
+		row[column] = value.Elem().Interface()
+	}
+	for _, column := range sch.DBNames {
+		field := sch.FieldsByDBName[column]
+		if _, ok := row[column]; ok || !isColumn(field) {
+			continue
+		}
+		if field.DefaultValueInterface != nil {
+			row[column] = field.DefaultValueInterface
+		} else {
+			row[column] = reflect.Zero(field.FieldType).Interface()
+		}
+	}
+	return row, nil
+}
+
+// columnRenames groups the renames of the migrations by table, in migration
+// order
+func columnRenames(ms []migrations.Migration) map[string][]migrations.ColumnRename {
+	renames := make(map[string][]migrations.ColumnRename)
+	for _, m := range ms {
+		for _, rename := range m.Renames {
+			renames[rename.Table] = append(renames[rename.Table], rename)
+		}
+	}
+	return renames
+}
+
+// isColumn tells a stored column from a field computed by queries, such as
+// the online session counts
+func isColumn(field *schema.Field) bool {
+	return !field.IgnoreMigration && field.Creatable
+}
+
+// resetSequences moves the PostgreSQL sequences of the restored tables past
+// the restored IDs; the other databases do it on their own
+func resetSequences(tx *gorm.DB, schemas map[string]*schema.Schema) error {
+	if tx.Name() != "postgres" {
+		return nil
+	}
+	for _, sch := range schemas {
+		field := sch.PrioritizedPrimaryField
+		if field == nil || !field.AutoIncrement {
+			continue
+		}
+		err := tx.Exec(fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', '%s'), COALESCE(MAX(%s), 0) + 1, false) FROM %s",
+			sch.Table, field.DBName, tx.Statement.Quote(field.DBName), tx.Statement.Quote(sch.Table))).Error
+		if err != nil {
+			return fmt.Errorf("reset sequence of %s: %w", sch.Table, err)
+		}
+	}
+	return nil
+}
+
+// List returns the backup files, newest first
+func (s *Service) List() ([]Info, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Info{}, nil
+		}
+		return nil, err
+	}
+	backups := make([]Info, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || validName(entry.Name()) != nil {
+			continue
+		}
+		info, err := s.stat(entry.Name())
+		if err != nil {
+			continue
+		}
+		backups = append(backups, *info)
+	}
+	// The names sort by the time they were taken
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Name > backups[j].Name })
+	return backups, nil
+}
+
+// Open opens a backup file for reading
+func (s *Service) Open(name string) (*os.File, error) {
+	if err := validName(name); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+// Delete removes a backup file
+func (s *Service) Delete(name string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Prune removes the oldest backups beyond the newest keep and returns their
+// names
+func (s *Service) Prune(keep int) ([]string, error) {
+	backups, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for i := keep; i < len(backups); i++ {
+		if err := s.Delete(backups[i].Name); err != nil {
+			return removed, err
+		}
+		removed = append(removed, backups[i].Name)
+	}
+	return removed, nil
+}
+
+func (s *Service) stat(name string) (*Info, error) {
+	fi, err := os.Stat(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	createdAt := fi.ModTime()
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileSuffix)
+	if t, err := time.ParseInLocation(timeLayout, stamp, time.Local); err == nil {
+		createdAt = t
+	}
+	return &Info{Name: name, Size: fi.Size(), CreatedAt: createdAt}, nil
+}
+
+// validName accepts the file names of Create only, so a name from a request
+// cannot point outside the directory
+func validName(name string) error {
+	if filepath.Base(name) != name || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// snapshotOptions returns the transaction options of a consistent read. A
+// SQLite transaction reads a snapshot as it is.
+func snapshotOptions(db *gorm.DB) *sql.TxOptions {
+	if db.Name() == "sqlite" {
+		return nil
+	}
+	return &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+}
+
+// appliedVersion returns the latest migration applied to the database
+func appliedVersion(tx *gorm.DB) int64 {
+	var version int64
+	if tx.Migrator().HasTable(&domain.SchemaVersion{}) {
+		tx.Model(&domain.SchemaVersion{}).Select("COALESCE(MAX(version), 0)").Scan(&version)
+	}
+	return version
+}
+
+// latestVersion returns the latest migration of this release
+func latestVersion() int64 {
+	all := migrations.All()
+	if len(all) == 0 {
+		return 0
+	}
+	return all[len(all)-1].Version
+}
@@ -0,0 +1,235 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app/migrations"
+	"github.com/talkincode/toughradius/v9/internal/app/mysqltest"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func openSqlite(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "backup.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() }) //nolint:errcheck
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+	return db
+}
+
+func openMysql(t *testing.T) *gorm.DB {
+	srv := mysqltest.NewServer("toughradius")
+	t.Cleanup(srv.Close)
+	db, err := gorm.Open(mysql.Open(srv.DSN()), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(domain.Tables...))
+	return db
+}
+
+// seed creates a few rows, setting every time column for the MySQL stand-in
+func seed(t *testing.T, db *gorm.DB) domain.RadiusUser {
+	now := time.Now().Truncate(time.Second)
+	deleted := now.Add(-time.Hour)
+	user := domain.RadiusUser{ID: 101, ProfileId: 7, Username: "alice", Password: "secret", TotpSecret: "JBSWY3DPEHPK3PXP",
+		Status: "enabled", ExpireTime: now.Add(24 * time.Hour), LastOnline: now, Balance: 1500}
+	require.NoError(t, db.Create(&user).Error)
+	require.NoError(t, db.Create(&domain.RadiusProfile{ID: 7, Name: "gold", UpRate: 1024, DownRate: 4096}).Error)
+	require.NoError(t, db.Create(&domain.NetNas{ID: 8, Name: "nas", Identifier: "nas-1", Ipaddr: "10.0.0.1", Secret: "s3cret"}).Error)
+	require.NoError(t, db.Create(&domain.Voucher{ID: 9, BatchId: 1, Code: "V1", Status: "used", DeletedAt: &deleted}).Error)
+	require.NoError(t, db.Create(&domain.SysConfig{ID: 10, Type: "backup", Name: "Retention", Value: "3"}).Error)
+	return user
+}
+
+func TestBackupAcrossDatabases(t *testing.T) {
+	dir := t.TempDir()
+	source := openSqlite(t)
+	user := seed(t, source)
+	require.NoError(t, source.Create(&domain.SchemaVersion{Version: 1, Name: "first", AppliedAt: time.Now()}).Error)
+
+	info, err := NewService(source, dir).Create(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.Rows["radius_user"])
+	assert.NotContains(t, info.Rows, "schema_version")
+	assert.NotContains(t, info.Rows, "sys_change_event")
+	backups, err := NewService(source, dir).List()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, info.Name, backups[0].Name)
+	assert.Positive(t, backups[0].Size)
+
+	target := openMysql(t)
+	result, err := NewService(target, dir).RestoreFile(context.Background(), info.Name, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "sqlite", result.Header.Database)
+	assert.Equal(t, int64(1), result.Header.SchemaVersion)
+	assert.Equal(t, int64(1), result.Rows["radius_user"])
+	assert.Equal(t, int64(0), result.Rows["radius_online"])
+
+	var restored domain.RadiusUser
+	require.NoError(t, target.Select("id", "username", "password", "totp_secret", "expire_time", "balance").
+		First(&restored, user.ID).Error)
+	assert.Equal(t, "alice", restored.Username)
+	assert.Equal(t, user.TotpSecret, restored.TotpSecret, "fields hidden from JSON are kept")
+	assert.Equal(t, int64(1500), restored.Balance)
+	assert.True(t, user.ExpireTime.Equal(restored.ExpireTime))
+
+	var voucher domain.Voucher
+	require.NoError(t, target.Select("id", "code", "deleted_at").First(&voucher, 9).Error)
+	require.NotNil(t, voucher.DeletedAt, "soft deleted rows are kept")
+	var nas domain.NetNas
+	require.NoError(t, target.Select("id", "secret").First(&nas, 8).Error)
+	assert.Equal(t, "s3cret", nas.Secret)
+}
+
+func TestRestoreSelectedTables(t *testing.T) {
+	dir := t.TempDir()
+	db := openSqlite(t)
+	seed(t, db)
+	svc := NewService(db, dir)
+	info, err := svc.Create(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, db.Delete(&domain.RadiusUser{}, 101).Error)
+	require.NoError(t, db.Model(&domain.NetNas{}).Where("id = ?", 8).Update("secret", "changed").Error)
+	require.NoError(t, db.Create(&domain.RadiusUser{ID: 102, Username: "bob"}).Error)
+
+	result, err := svc.RestoreFile(context.Background(), info.Name, []string{"radius_user"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"radius_user": 1}, result.Rows)
+
+	var usernames []string
+	require.NoError(t, db.Model(&domain.RadiusUser{}).Pluck("username", &usernames).Error)
+	assert.Equal(t, []string{"alice"}, usernames)
+	var nas domain.NetNas
+	require.NoError(t, db.First(&nas, 8).Error)
+	assert.Equal(t, "changed", nas.Secret, "tables not selected are left alone")
+
+	_, err = svc.RestoreFile(context.Background(), info.Name, []string{"radius_users"})
+	assert.ErrorIs(t, err, ErrUnknownTable)
+}
+
+func TestRestoreRejectsBadBackups(t *testing.T) {
+	dir := t.TempDir()
+	db := openSqlite(t)
+	seed(t, db)
+	svc := NewService(db, dir)
+
+	var buf bytes.Buffer
+	_, err := svc.Dump(context.Background(), &buf)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&domain.RadiusUser{ID: 102, Username: "bob"}).Error)
+
+	// A truncated backup restores nothing
+	_, err = svc.Restore(context.Background(), bytes.NewReader(buf.Bytes()[:buf.Len()/2]), nil)
+	assert.Error(t, err)
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusUser{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+
+	_, err = svc.Restore(context.Background(), bytes.NewReader([]byte("not a backup")), nil)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+
+	// A backup of a newer release is refused
+	newer := NewService(db, dir)
+	require.NoError(t, db.Create(&domain.SchemaVersion{Version: 1000, Name: "future", AppliedAt: time.Now()}).Error)
+	buf.Reset()
+	_, err = newer.Dump(context.Background(), &buf)
+	require.NoError(t, err)
+	_, err = svc.Restore(context.Background(), &buf, nil)
+	assert.ErrorIs(t, err, ErrNewerSchema)
+	assert.Less(t, migrations.All()[len(migrations.All())-1].Version, int64(1000))
+
+	_, err = svc.Open("../backup.db")
+	assert.ErrorIs(t, err, ErrInvalidName)
+	_, err = svc.Open("toughradius-20200101-000000.jsonl.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPrune(t *testing.T) {
+	dir := t.TempDir()
+	svc := NewService(openSqlite(t), dir)
+	start := time.Date(2026, 1, 1, 3, 0, 0, 0, time.Local)
+	for day := 0; day < 3; day++ {
+		svc.now = func() time.Time { return start.AddDate(0, 0, day) }
+		_, err := svc.Create(context.Background())
+		require.NoError(t, err)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("kept"), 0o600))
+
+	removed, err := svc.Prune(1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"toughradius-20260102-030000.jsonl.gz", "toughradius-20260101-030000.jsonl.gz"}, removed)
+	backups, err := svc.List()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, start.AddDate(0, 0, 2), backups[0].CreatedAt)
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
+
+// writeBackup encodes a backup by hand, as an older release wrote it
+func writeBackup(t *testing.T, header Header, rows map[string][]map[string]any) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(gz)
+	require.NoError(t, enc.Encode(header))
+	counts := make(map[string]int64)
+	for _, table := range header.Tables {
+		for _, row := range rows[table] {
+			require.NoError(t, enc.Encode(map[string]any{"t": table, "r": row}))
+			counts[table]++
+		}
+	}
+	require.NoError(t, enc.Encode(map[string]any{"rows": counts}))
+	require.NoError(t, gz.Close())
+	return &buf
+}
+
+func TestRestoreOlderSchema(t *testing.T) {
+	db := openSqlite(t)
+	svc := NewService(db, t.TempDir())
+	header := Header{Format: formatName, Version: formatVersion, Database: "sqlite", SchemaVersion: 0,
+		Tables: []string{"radius_profile", "radius_user"}}
+
+	// Taken before migration 1 renamed the profile column and profile
+	// linking added the user columns
+	old := writeBackup(t, header, map[string][]map[string]any{
+		"radius_profile": {{"id": 7, "name": "gold", "ipv6_prefix": "pool-gold"}},
+		"radius_user":    {{"id": 101, "profile_id": 7, "username": "alice"}},
+	})
+	result, err := svc.Restore(context.Background(), old, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.Rows["radius_profile"])
+
+	var profile domain.RadiusProfile
+	require.NoError(t, db.First(&profile, 7).Error)
+	assert.Equal(t, "pool-gold", profile.IPv6PrefixPool, "renamed columns are mapped")
+	var user domain.RadiusUser
+	require.NoError(t, db.First(&user, 101).Error)
+	assert.Equal(t, "pool-gold", user.IPv6PrefixPool, "data migrations run on the restored rows")
+	assert.Equal(t, domain.ProfileLinkModeStatic, user.ProfileLinkMode)
+
+	// A column nothing knows about fails the restore instead of being dropped
+	unknown := writeBackup(t, header, map[string][]map[string]any{
+		"radius_profile": {{"id": 8, "name": "silver", "legacy_quota": 10}},
+	})
+	_, err = svc.Restore(context.Background(), unknown, nil)
+	assert.ErrorIs(t, err, ErrUnknownColumn)
+	assert.ErrorContains(t, err, "radius_profile.legacy_quota")
+	require.NoError(t, db.First(&profile, 7).Error, "the failed restore changed nothing")
+}
@@ -0,0 +1,405 @@
+// Package billing implements the prepaid wallet used to renew priced
+// RADIUS profiles. Every balance change is performed with a conditional
+// UPDATE and recorded in the wallet_transaction ledger inside the same
+// database transaction, so concurrent recharges and renewals never lose
+// money or produce negative balances.
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrInvalidAmount is returned when a recharge amount is not positive.
+	ErrInvalidAmount = errors.New("amount must be greater than zero")
+	// ErrInsufficientBalance is returned when the wallet cannot cover a renewal.
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+	// ErrProfileNotBillable is returned when the user's profile has no price or renewal period.
+	ErrProfileNotBillable = errors.New("profile has no price or renewal period")
+	// ErrAlreadyRenewed is returned when another renewal of the same period
+	// completed first.
+	ErrAlreadyRenewed = errors.New("account was already renewed")
+	// ErrUserDisabled is returned when renewing a user disabled by an operator.
+	ErrUserDisabled = errors.New("user is disabled")
+	// ErrVoucherUnavailable is returned when a voucher is used, disabled or unknown.
+	ErrVoucherUnavailable = errors.New("voucher is not available")
+	// ErrVoucherExpired is returned when a voucher or its batch has expired.
+	ErrVoucherExpired = errors.New("voucher has expired")
+	// ErrVoucherPassword is returned when the voucher password does not match.
+	ErrVoucherPassword = errors.New("voucher password mismatch")
+)
+
+// Service performs wallet operations against the database.
+type Service struct {
+	db  *gorm.DB
+	now func() time.Time
+}
+
+// NewService creates a billing service backed by db.
+func NewService(db *gorm.DB) *Service {
+	return &Service{db: db, now: time.Now}
+}
+
+// RechargeResult describes the outcome of a recharge. Renewal is set when the
+// recharge immediately renewed a suspended or expired account.
+type RechargeResult struct {
+	Recharge *domain.WalletTransaction `json:"recharge"`
+	Renewal  *domain.WalletTransaction `json:"renewal,omitempty"`
+}
+
+// RenewStats summarizes a RunAutoRenew pass.
+type RenewStats struct {
+	Checked   int `json:"checked"`
+	Renewed   int `json:"renewed"`
+	Suspended int `json:"suspended"`
+	Failed    int `json:"failed"`
+}
+
+// Receipt is the printable view of a single ledger entry.
+type Receipt struct {
+	Transaction *domain.WalletTransaction `json:"transaction"`
+	Realname    string                    `json:"realname"`
+	Mobile      string                    `json:"mobile"`
+	ProfileName string                    `json:"profile_name"`
+}
+
+// Recharge credits amount to the user's wallet on behalf of an operator.
+func (s *Service) Recharge(ctx context.Context, userID, amount int64, oprName, remark string) (*RechargeResult, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	var txn *domain.WalletTransaction
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		txn, err = s.credit(tx, userID, amount, domain.WalletSourceOperator, "", oprName, remark)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.afterRecharge(ctx, txn, oprName), nil
+}
+
+// RechargeByVoucher redeems an available voucher as wallet credit. The credited
+// amount is the batch Amount, or the price of the voucher profile when unset.
+func (s *Service) RechargeByVoucher(ctx context.Context, userID int64, code, password, oprName string) (*RechargeResult, error) {
+	var txn *domain.WalletTransaction
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var voucher domain.Voucher
+		if err := tx.Where("code = ?", code).First(&voucher).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrVoucherUnavailable
+			}
+			return err
+		}
+		if voucher.Status != domain.VoucherStatusAvailable {
+			return ErrVoucherUnavailable
+		}
+		if voucher.Password != "" && voucher.Password != password {
+			return ErrVoucherPassword
+		}
+
+		var batch domain.VoucherBatch
+		if err := tx.First(&batch, voucher.BatchId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrVoucherUnavailable
+			}
+			return err
+		}
+		if batch.Status == domain.VoucherBatchStatusDisabled {
+			return ErrVoucherUnavailable
+		}
+
+		now := s.now()
+		expire := batch.ExpireTime
+		if voucher.ExpireTime != nil {
+			expire = *voucher.ExpireTime
+		}
+		if !expire.IsZero() && expire.Before(now) {
+			return ErrVoucherExpired
+		}
+
+		amount := batch.Amount
+		if amount <= 0 {
+			var profile domain.RadiusProfile
+			if err := tx.Select("price").First(&profile, voucher.ProfileId).Error; err == nil {
+				amount = profile.Price
+			}
+		}
+		if amount <= 0 {
+			return ErrInvalidAmount
+		}
+
+		// Guard against the same voucher being redeemed twice concurrently.
+		res := tx.Model(&domain.Voucher{}).
+			Where("id = ? AND status = ?", voucher.ID, domain.VoucherStatusAvailable).
+			Updates(map[string]interface{}{
+				"status":      domain.VoucherStatusUsed,
+				"user_id":     userID,
+				"redeemed_at": now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrVoucherUnavailable
+		}
+		if err := tx.Model(&domain.VoucherBatch{}).Where("id = ?", batch.ID).
+			UpdateColumn("used_count", gorm.Expr("used_count + 1")).Error; err != nil {
+			return err
+		}
+
+		var err error
+		txn, err = s.credit(tx, userID, amount, domain.WalletSourceVoucher, voucher.Code, oprName, "")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.afterRecharge(ctx, txn, oprName), nil
+}
+
+// Renew charges the profile price and extends the user's expiry by the
+// profile renewal period, counted from the later of now and the current
+// expiry. A suspended user is re-enabled by a successful renewal.
+func (s *Service) Renew(ctx context.Context, userID int64, source, oprName string) (*domain.WalletTransaction, error) {
+	return s.renew(ctx, userID, nil, source, oprName)
+}
+
+// renew renews the user's account. When expect is set the account is only
+// renewed while it still expires at expect, so a renewal decided on a stale
+// read is not charged twice. The expiry read is checked again by the
+// conditional UPDATE, an overlapping renewal of the same period fails with
+// ErrAlreadyRenewed instead of charging the wallet again.
+func (s *Service) renew(ctx context.Context, userID int64, expect *time.Time, source, oprName string) (*domain.WalletTransaction, error) {
+	var txn *domain.WalletTransaction
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user domain.RadiusUser
+		if err := tx.First(&user, userID).Error; err != nil {
+			return err
+		}
+		if expect != nil && !user.ExpireTime.Equal(*expect) {
+			return ErrAlreadyRenewed
+		}
+		if user.Status == common.DISABLED {
+			return ErrUserDisabled
+		}
+		var profile domain.RadiusProfile
+		if err := tx.First(&profile, user.ProfileId).Error; err != nil {
+			return err
+		}
+		if profile.Price <= 0 || profile.RenewPeriod <= 0 {
+			return ErrProfileNotBillable
+		}
+
+		now := s.now()
+		base := user.ExpireTime
+		if base.Before(now) {
+			base = now
+		}
+		newExpire := base.AddDate(0, 0, profile.RenewPeriod)
+
+		res := tx.Model(&domain.RadiusUser{}).
+			Where("id = ? AND expire_time = ? AND balance >= ?", user.ID, user.ExpireTime, profile.Price).
+			Updates(map[string]interface{}{
+				"balance":     gorm.Expr("balance - ?", profile.Price),
+				"expire_time": newExpire,
+				"status":      common.ENABLED,
+				"updated_at":  now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			var current domain.RadiusUser
+			if err := tx.Select("expire_time").First(&current, user.ID).Error; err != nil {
+				return err
+			}
+			if !current.ExpireTime.Equal(user.ExpireTime) {
+				return ErrAlreadyRenewed
+			}
+			return ErrInsufficientBalance
+		}
+
+		balance, err := currentBalance(tx, user.ID)
+		if err != nil {
+			return err
+		}
+		oldExpire := user.ExpireTime
+		txn = &domain.WalletTransaction{
+			ID:           common.UUIDint64(),
+			UserId:       user.ID,
+			Username:     user.Username,
+			Type:         domain.WalletTxnRenew,
+			Source:       source,
+			Amount:       -profile.Price,
+			Balance:      balance,
+			ProfileId:    profile.ID,
+			ExpireBefore: &oldExpire,
+			ExpireAfter:  &newExpire,
+			OprName:      oprName,
+			CreatedAt:    now,
+		}
+		txn.ReceiptNo = receiptNo(txn)
+		return tx.Create(txn).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// RunAutoRenew renews every enabled or suspended user on a priced profile
+// whose account expires before now+ahead. Users that cannot pay and are
+// already past their expiry are marked suspended.
+func (s *Service) RunAutoRenew(ctx context.Context, now time.Time, ahead time.Duration) (RenewStats, error) {
+	var stats RenewStats
+	var users []domain.RadiusUser
+	err := s.db.WithContext(ctx).
+		Select("radius_user.id, radius_user.username, radius_user.status, radius_user.expire_time").
+		Joins("JOIN radius_profile ON radius_profile.id = radius_user.profile_id").
+		Where("radius_user.status IN ?", []string{common.ENABLED, domain.UserStatusSuspended}).
+		Where("radius_user.expire_time <= ?", now.Add(ahead)).
+		Where("radius_profile.price > 0 AND radius_profile.renew_period > 0").
+		Find(&users).Error
+	if err != nil {
+		return stats, err
+	}
+
+	for _, user := range users {
+		if ctx.Err() != nil {
+			return stats, ctx.Err()
+		}
+		stats.Checked++
+		_, err := s.renew(ctx, user.ID, &user.ExpireTime, domain.WalletSourceAutoRenew, "")
+		switch {
+		case err == nil:
+			stats.Renewed++
+		case errors.Is(err, ErrAlreadyRenewed):
+			// An overlapping run or an operator renewed the account first
+		case errors.Is(err, ErrInsufficientBalance):
+			if user.Status == common.ENABLED && !user.ExpireTime.After(now) {
+				if err := s.suspend(ctx, user.ID); err != nil {
+					stats.Failed++
+					continue
+				}
+				stats.Suspended++
+			}
+		default:
+			stats.Failed++
+			zap.S().Warnf("auto renew user %s failed: %v", user.Username, err)
+		}
+	}
+	return stats, nil
+}
+
+// Receipt loads a ledger entry together with the customer details printed on it.
+func (s *Service) Receipt(ctx context.Context, txnID int64) (*Receipt, error) {
+	var txn domain.WalletTransaction
+	if err := s.db.WithContext(ctx).First(&txn, txnID).Error; err != nil {
+		return nil, err
+	}
+	receipt := &Receipt{Transaction: &txn}
+	var user domain.RadiusUser
+	if err := s.db.WithContext(ctx).Select("realname", "mobile", "profile_id").
+		First(&user, txn.UserId).Error; err == nil {
+		receipt.Realname = user.Realname
+		receipt.Mobile = user.Mobile
+	}
+	profileID := txn.ProfileId
+	if profileID == 0 {
+		profileID = user.ProfileId
+	}
+	if profileID != 0 {
+		var profile domain.RadiusProfile
+		if err := s.db.WithContext(ctx).Select("name").First(&profile, profileID).Error; err == nil {
+			receipt.ProfileName = profile.Name
+		}
+	}
+	return receipt, nil
+}
+
+// credit adds amount to the wallet and records a recharge entry within tx.
+func (s *Service) credit(tx *gorm.DB, userID, amount int64, source, refID, oprName, remark string) (*domain.WalletTransaction, error) {
+	var user domain.RadiusUser
+	if err := tx.Select("id", "username").First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if err := tx.Model(&domain.RadiusUser{}).Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"balance":    gorm.Expr("balance + ?", amount),
+			"updated_at": now,
+		}).Error; err != nil {
+		return nil, err
+	}
+	balance, err := currentBalance(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	txn := &domain.WalletTransaction{
+		ID:        common.UUIDint64(),
+		UserId:    userID,
+		Username:  user.Username,
+		Type:      domain.WalletTxnRecharge,
+		Source:    source,
+		Amount:    amount,
+		Balance:   balance,
+		RefId:     refID,
+		OprName:   oprName,
+		Remark:    remark,
+		CreatedAt: now,
+	}
+	txn.ReceiptNo = receiptNo(txn)
+	if err := tx.Create(txn).Error; err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// afterRecharge renews the account straight away when the user was suspended
+// or has already expired, so a top-up restores service without waiting for
+// the next scheduled run.
+func (s *Service) afterRecharge(ctx context.Context, txn *domain.WalletTransaction, oprName string) *RechargeResult {
+	result := &RechargeResult{Recharge: txn}
+	var user domain.RadiusUser
+	if err := s.db.WithContext(ctx).Select("id", "status", "expire_time").First(&user, txn.UserId).Error; err != nil {
+		return result
+	}
+	if user.Status != domain.UserStatusSuspended && user.ExpireTime.After(s.now()) {
+		return result
+	}
+	renewal, err := s.Renew(ctx, user.ID, txn.Source, oprName)
+	if err == nil {
+		result.Renewal = renewal
+	}
+	return result
+}
+
+func (s *Service) suspend(ctx context.Context, userID int64) error {
+	return s.db.WithContext(ctx).Model(&domain.RadiusUser{}).
+		Where("id = ? AND status = ?", userID, common.ENABLED).
+		Updates(map[string]interface{}{
+			"status":     domain.UserStatusSuspended,
+			"updated_at": s.now(),
+		}).Error
+}
+
+func currentBalance(tx *gorm.DB, userID int64) (int64, error) {
+	var balance int64
+	err := tx.Model(&domain.RadiusUser{}).Where("id = ?", userID).
+		Select("balance").Scan(&balance).Error
+	return balance, err
+}
+
+func receiptNo(txn *domain.WalletTransaction) string {
+	return fmt.Sprintf("R%s-%d", txn.CreatedAt.Format("20060102"), txn.ID)
+}
@@ -0,0 +1,256 @@
+package billing
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"gorm.io/gorm"
+)
+
+func setupBillingDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(
+		&domain.RadiusProfile{},
+		&domain.RadiusUser{},
+		&domain.VoucherBatch{},
+		&domain.Voucher{},
+		&domain.WalletTransaction{},
+	))
+	return db
+}
+
+func createBillingUser(t *testing.T, db *gorm.DB, price int64, period int, balance int64, expire time.Time, status string) *domain.RadiusUser {
+	profile := &domain.RadiusProfile{
+		ID:          common.UUIDint64(),
+		Name:        "monthly-" + time.Now().Format("150405.000000000"),
+		Status:      common.ENABLED,
+		Price:       price,
+		RenewPeriod: period,
+	}
+	require.NoError(t, db.Create(profile).Error)
+	user := &domain.RadiusUser{
+		ID:         common.UUIDint64(),
+		Username:   "wallet-" + time.Now().Format("150405.000000000"),
+		ProfileId:  profile.ID,
+		Status:     status,
+		Balance:    balance,
+		ExpireTime: expire,
+	}
+	require.NoError(t, db.Create(user).Error)
+	return user
+}
+
+func reloadUser(t *testing.T, db *gorm.DB, id int64) domain.RadiusUser {
+	var user domain.RadiusUser
+	require.NoError(t, db.First(&user, id).Error)
+	return user
+}
+
+func TestRecharge(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	ctx := context.Background()
+	user := createBillingUser(t, db, 1000, 30, 0, time.Now().AddDate(0, 1, 0), common.ENABLED)
+
+	_, err := svc.Recharge(ctx, user.ID, 0, "admin", "")
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+
+	result, err := svc.Recharge(ctx, user.ID, 2500, "admin", "cash")
+	require.NoError(t, err)
+	assert.Nil(t, result.Renewal, "active users are not renewed on recharge")
+	assert.Equal(t, int64(2500), result.Recharge.Amount)
+	assert.Equal(t, int64(2500), result.Recharge.Balance)
+	assert.Equal(t, domain.WalletSourceOperator, result.Recharge.Source)
+	assert.NotEmpty(t, result.Recharge.ReceiptNo)
+	assert.Equal(t, int64(2500), reloadUser(t, db, user.ID).Balance)
+}
+
+func TestRechargeRenewsSuspendedUser(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	expired := time.Now().Add(-time.Hour)
+	user := createBillingUser(t, db, 1000, 30, 0, expired, domain.UserStatusSuspended)
+
+	result, err := svc.Recharge(context.Background(), user.ID, 1500, "admin", "")
+	require.NoError(t, err)
+	require.NotNil(t, result.Renewal)
+	assert.Equal(t, int64(-1000), result.Renewal.Amount)
+	assert.Equal(t, int64(500), result.Renewal.Balance)
+
+	got := reloadUser(t, db, user.ID)
+	assert.Equal(t, common.ENABLED, got.Status)
+	assert.Equal(t, int64(500), got.Balance)
+	assert.True(t, got.ExpireTime.After(time.Now().AddDate(0, 0, 29)))
+}
+
+func TestRenew(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	ctx := context.Background()
+	expire := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+
+	t.Run("extends from current expiry", func(t *testing.T) {
+		user := createBillingUser(t, db, 1000, 30, 1000, expire, common.ENABLED)
+		txn, err := svc.Renew(ctx, user.ID, domain.WalletSourceOperator, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), txn.Balance)
+		assert.True(t, txn.ExpireAfter.Equal(expire.AddDate(0, 0, 30)))
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		user := createBillingUser(t, db, 1000, 30, 999, expire, common.ENABLED)
+		_, err := svc.Renew(ctx, user.ID, domain.WalletSourceOperator, "admin")
+		assert.ErrorIs(t, err, ErrInsufficientBalance)
+		assert.Equal(t, int64(999), reloadUser(t, db, user.ID).Balance)
+	})
+
+	t.Run("free profile", func(t *testing.T) {
+		user := createBillingUser(t, db, 0, 30, 1000, expire, common.ENABLED)
+		_, err := svc.Renew(ctx, user.ID, domain.WalletSourceOperator, "admin")
+		assert.ErrorIs(t, err, ErrProfileNotBillable)
+	})
+
+	t.Run("disabled user", func(t *testing.T) {
+		user := createBillingUser(t, db, 1000, 30, 1000, expire, common.DISABLED)
+		_, err := svc.Renew(ctx, user.ID, domain.WalletSourceOperator, "admin")
+		assert.ErrorIs(t, err, ErrUserDisabled)
+	})
+}
+
+func TestRenewConcurrent(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	ctx := context.Background()
+	now := time.Now()
+	user := createBillingUser(t, db, 1000, 30, 3000, now.Add(time.Hour), common.ENABLED)
+	listed := reloadUser(t, db, user.ID)
+
+	// Overlapping auto renew runs charge one period only
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := svc.RunAutoRenew(ctx, now, 24*time.Hour)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	got := reloadUser(t, db, user.ID)
+	assert.Equal(t, int64(2000), got.Balance)
+	var count int64
+	db.Model(&domain.WalletTransaction{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(1), count)
+
+	// A renewal decided on the expiry listed before is not charged again
+	_, err := svc.renew(ctx, user.ID, &listed.ExpireTime, domain.WalletSourceAutoRenew, "")
+	assert.ErrorIs(t, err, ErrAlreadyRenewed)
+	assert.Equal(t, int64(2000), reloadUser(t, db, user.ID).Balance)
+
+	// An operator renewal still extends the current expiry
+	txn, err := svc.Renew(ctx, user.ID, domain.WalletSourceOperator, "admin")
+	require.NoError(t, err)
+	assert.True(t, txn.ExpireAfter.Equal(got.ExpireTime.AddDate(0, 0, 30)))
+}
+
+func TestRechargeByVoucher(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	ctx := context.Background()
+	user := createBillingUser(t, db, 1000, 30, 0, time.Now().AddDate(0, 1, 0), common.ENABLED)
+
+	batch := &domain.VoucherBatch{
+		ID:         common.UUIDint64(),
+		Name:       "topup",
+		ProfileId:  user.ProfileId,
+		TotalCount: 2,
+		Amount:     0,
+		Status:     domain.VoucherBatchStatusEnabled,
+		ExpireTime: time.Now().AddDate(0, 1, 0),
+	}
+	require.NoError(t, db.Create(batch).Error)
+	voucher := &domain.Voucher{ID: common.UUIDint64(), BatchId: batch.ID, Code: "TOPUP-1",
+		ProfileId: user.ProfileId, Status: domain.VoucherStatusAvailable}
+	locked := &domain.Voucher{ID: common.UUIDint64(), BatchId: batch.ID, Code: "TOPUP-2",
+		Password: "secret", ProfileId: user.ProfileId, Status: domain.VoucherStatusAvailable}
+	require.NoError(t, db.Create(voucher).Error)
+	require.NoError(t, db.Create(locked).Error)
+
+	result, err := svc.RechargeByVoucher(ctx, user.ID, "TOPUP-1", "", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), result.Recharge.Amount, "falls back to profile price")
+	assert.Equal(t, domain.WalletSourceVoucher, result.Recharge.Source)
+	assert.Equal(t, "TOPUP-1", result.Recharge.RefId)
+
+	_, err = svc.RechargeByVoucher(ctx, user.ID, "TOPUP-1", "", "admin")
+	assert.ErrorIs(t, err, ErrVoucherUnavailable)
+
+	_, err = svc.RechargeByVoucher(ctx, user.ID, "TOPUP-2", "wrong", "admin")
+	assert.ErrorIs(t, err, ErrVoucherPassword)
+
+	_, err = svc.RechargeByVoucher(ctx, user.ID, "MISSING", "", "admin")
+	assert.ErrorIs(t, err, ErrVoucherUnavailable)
+
+	var used domain.Voucher
+	require.NoError(t, db.First(&used, voucher.ID).Error)
+	assert.Equal(t, domain.VoucherStatusUsed, used.Status)
+	assert.Equal(t, user.ID, used.UserId)
+
+	var gotBatch domain.VoucherBatch
+	require.NoError(t, db.First(&gotBatch, batch.ID).Error)
+	assert.Equal(t, 1, gotBatch.UsedCount)
+	assert.Equal(t, int64(1000), reloadUser(t, db, user.ID).Balance)
+}
+
+func TestRunAutoRenew(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	now := time.Now()
+
+	renewable := createBillingUser(t, db, 1000, 30, 1500, now.Add(2*time.Hour), common.ENABLED)
+	broke := createBillingUser(t, db, 1000, 30, 100, now.Add(-time.Hour), common.ENABLED)
+	expiringBroke := createBillingUser(t, db, 1000, 30, 100, now.Add(2*time.Hour), common.ENABLED)
+	notDue := createBillingUser(t, db, 1000, 30, 5000, now.AddDate(0, 0, 10), common.ENABLED)
+	free := createBillingUser(t, db, 0, 0, 5000, now.Add(-time.Hour), common.ENABLED)
+
+	stats, err := svc.RunAutoRenew(context.Background(), now, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Checked)
+	assert.Equal(t, 1, stats.Renewed)
+	assert.Equal(t, 1, stats.Suspended)
+
+	assert.Equal(t, int64(500), reloadUser(t, db, renewable.ID).Balance)
+	assert.Equal(t, domain.UserStatusSuspended, reloadUser(t, db, broke.ID).Status)
+	assert.Equal(t, common.ENABLED, reloadUser(t, db, expiringBroke.ID).Status, "not suspended before expiry")
+	assert.Equal(t, int64(5000), reloadUser(t, db, notDue.ID).Balance)
+	assert.Equal(t, common.ENABLED, reloadUser(t, db, free.ID).Status)
+
+	var count int64
+	db.Model(&domain.WalletTransaction{}).Where("source = ?", domain.WalletSourceAutoRenew).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestReceipt(t *testing.T) {
+	db := setupBillingDB(t)
+	svc := NewService(db)
+	user := createBillingUser(t, db, 1000, 30, 1000, time.Now().Add(time.Hour), common.ENABLED)
+	txn, err := svc.Renew(context.Background(), user.ID, domain.WalletSourceOperator, "admin")
+	require.NoError(t, err)
+
+	receipt, err := svc.Receipt(context.Background(), txn.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txn.ReceiptNo, receipt.Transaction.ReceiptNo)
+	assert.Contains(t, receipt.ProfileName, "monthly-")
+}
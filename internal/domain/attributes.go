@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AttributeItem is a radreply / radcheck style attribute entry.
+//
+// Reply operators:
+//   - "="  adds the attribute unless the reply already carries it
+//   - ":=" replaces any value already in the reply
+//   - "+=" always appends another instance
+//
+// Check operators compare against the Access-Request: "==", "!=", ">", ">=",
+// "<", "<=", "=~" and "!~" (regular expressions), "=*" (present) and
+// "!*" (absent).
+type AttributeItem struct {
+	Attribute string `json:"attribute"`
+	Op        string `json:"op"`
+	Value     string `json:"value"`
+}
+
+var (
+	replyOps = map[string]bool{"=": true, ":=": true, "+=": true}
+	checkOps = map[string]bool{
+		"==": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+		"=~": true, "!~": true, "=*": true, "!*": true,
+	}
+)
+
+// ParseReplyItems decodes and validates a JSON encoded reply attribute list.
+// An empty string yields no items and a missing operator defaults to "=".
+func ParseReplyItems(s string) ([]AttributeItem, error) {
+	return parseAttributeItems(s, "=", replyOps)
+}
+
+// ParseCheckItems decodes and validates a JSON encoded check attribute list.
+// An empty string yields no items and a missing operator defaults to "==".
+func ParseCheckItems(s string) ([]AttributeItem, error) {
+	items, err := parseAttributeItems(s, "==", checkOps)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		if item.Op == "=~" || item.Op == "!~" {
+			if _, err := regexp.Compile(item.Value); err != nil {
+				return nil, fmt.Errorf("attribute %d: invalid regular expression: %w", i+1, err)
+			}
+		}
+	}
+	return items, nil
+}
+
+func parseAttributeItems(s, defaultOp string, ops map[string]bool) ([]AttributeItem, error) {
+	var items []AttributeItem
+	if strings.TrimSpace(s) == "" {
+		return items, nil
+	}
+	if err := json.Unmarshal([]byte(s), &items); err != nil {
+		return nil, fmt.Errorf("invalid attribute list: %w", err)
+	}
+	for i := range items {
+		items[i].Attribute = strings.TrimSpace(items[i].Attribute)
+		items[i].Op = strings.TrimSpace(items[i].Op)
+		if items[i].Attribute == "" {
+			return nil, fmt.Errorf("attribute %d: name is required", i+1)
+		}
+		if items[i].Op == "" {
+			items[i].Op = defaultOp
+		}
+		if !ops[items[i].Op] {
+			return nil, fmt.Errorf("attribute %d: unsupported operator %q", i+1, items[i].Op)
+		}
+	}
+	return items, nil
+}
+
+// MergeAttributeItems combines profile and user attribute lists. User items
+// override every profile item with the same attribute name.
+func MergeAttributeItems(profile, user []AttributeItem) []AttributeItem {
+	if len(user) == 0 {
+		return profile
+	}
+	overridden := make(map[string]bool, len(user))
+	for _, item := range user {
+		overridden[strings.ToLower(item.Attribute)] = true
+	}
+	merged := make([]AttributeItem, 0, len(profile)+len(user))
+	for _, item := range profile {
+		if !overridden[strings.ToLower(item.Attribute)] {
+			merged = append(merged, item)
+		}
+	}
+	return append(merged, user...)
+}
+
+// ReplyItemList parses the profile ReplyAttrs setting.
+func (p *RadiusProfile) ReplyItemList() ([]AttributeItem, error) {
+	return ParseReplyItems(p.ReplyAttrs)
+}
+
+// CheckItemList parses the profile CheckAttrs setting.
+func (p *RadiusProfile) CheckItemList() ([]AttributeItem, error) {
+	return ParseCheckItems(p.CheckAttrs)
+}
+
+// ReplyItemList parses the user ReplyAttrs setting.
+func (u *RadiusUser) ReplyItemList() ([]AttributeItem, error) {
+	return ParseReplyItems(u.ReplyAttrs)
+}
+
+// CheckItemList parses the user CheckAttrs setting.
+func (u *RadiusUser) CheckItemList() ([]AttributeItem, error) {
+	return ParseCheckItems(u.CheckAttrs)
+}
+
+// ReplyItems returns the user's custom reply attributes merged over those of
+// its profile. cache should be a ProfileCacheGetter implementation.
+func (u *RadiusUser) ReplyItems(cache interface{}) ([]AttributeItem, error) {
+	return u.mergedItems(cache, (*RadiusProfile).ReplyItemList, (*RadiusUser).ReplyItemList)
+}
+
+// CheckItems returns the user's custom check attributes merged over those of
+// its profile. cache should be a ProfileCacheGetter implementation.
+func (u *RadiusUser) CheckItems(cache interface{}) ([]AttributeItem, error) {
+	return u.mergedItems(cache, (*RadiusProfile).CheckItemList, (*RadiusUser).CheckItemList)
+}
+
+func (u *RadiusUser) mergedItems(
+	cache interface{},
+	profileItems func(*RadiusProfile) ([]AttributeItem, error),
+	userItems func(*RadiusUser) ([]AttributeItem, error),
+) ([]AttributeItem, error) {
+	var base []AttributeItem
+	if getter, ok := cache.(ProfileCacheGetter); ok && u.ProfileId != 0 {
+		if profile, err := getter.Get(u.ProfileId); err == nil && profile != nil {
+			items, err := profileItems(profile)
+			if err != nil {
+				return nil, fmt.Errorf("profile %d: %w", profile.ID, err)
+			}
+			base = items
+		}
+	}
+	items, err := userItems(u)
+	if err != nil {
+		return nil, err
+	}
+	return MergeAttributeItems(base, items), nil
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReplyItems(t *testing.T) {
+	items, err := ParseReplyItems(`[{"attribute":" Filter-Id ","value":"std"},{"attribute":"Class","op":":=","value":"gold"}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []AttributeItem{
+		{Attribute: "Filter-Id", Op: "=", Value: "std"},
+		{Attribute: "Class", Op: ":=", Value: "gold"},
+	}, items)
+
+	items, err = ParseReplyItems("")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	for _, s := range []string{
+		`not json`,
+		`[{"attribute":"","value":"x"}]`,
+		`[{"attribute":"Class","op":"==","value":"x"}]`,
+	} {
+		_, err := ParseReplyItems(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseCheckItems(t *testing.T) {
+	items, err := ParseCheckItems(`[{"attribute":"Called-Station-Id","op":"=~","value":":corp$"},{"attribute":"NAS-Port-Type","value":"Ethernet"}]`)
+	require.NoError(t, err)
+	assert.Equal(t, "==", items[1].Op)
+
+	for _, s := range []string{
+		`[{"attribute":"Called-Station-Id","op":"=~","value":"("}]`,
+		`[{"attribute":"Called-Station-Id","op":":=","value":"x"}]`,
+	} {
+		_, err := ParseCheckItems(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMergeAttributeItems(t *testing.T) {
+	profile := []AttributeItem{
+		{Attribute: "Filter-Id", Op: "=", Value: "a"},
+		{Attribute: "Filter-Id", Op: "+=", Value: "b"},
+		{Attribute: "Class", Op: "=", Value: "gold"},
+	}
+	user := []AttributeItem{{Attribute: "filter-id", Op: "=", Value: "c"}}
+
+	assert.Equal(t, []AttributeItem{
+		{Attribute: "Class", Op: "=", Value: "gold"},
+		{Attribute: "filter-id", Op: "=", Value: "c"},
+	}, MergeAttributeItems(profile, user))
+	assert.Equal(t, profile, MergeAttributeItems(profile, nil))
+}
+
+type attrProfileCache map[int64]*RadiusProfile
+
+func (c attrProfileCache) Get(id int64) (*RadiusProfile, error) {
+	return c[id], nil
+}
+
+func TestRadiusUserReplyItems(t *testing.T) {
+	cache := attrProfileCache{
+		1: {ID: 1, ReplyAttrs: `[{"attribute":"Class","value":"gold"}]`},
+		2: {ID: 2, ReplyAttrs: `broken`},
+	}
+
+	user := &RadiusUser{ProfileId: 1, ReplyAttrs: `[{"attribute":"Filter-Id","value":"vip"}]`}
+	items, err := user.ReplyItems(cache)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	items, err = user.ReplyItems(nil)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	_, err = (&RadiusUser{ProfileId: 2}).ReplyItems(cache)
+	assert.Error(t, err)
+
+	checks, err := (&RadiusUser{ProfileId: 1, CheckAttrs: `[{"attribute":"User-Name","op":"=*"}]`}).CheckItems(cache)
+	require.NoError(t, err)
+	assert.Len(t, checks, 1)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Results of an authentication
+const (
+	AuthResultAccept = "accept"
+	AuthResultReject = "reject"
+)
+
+// RadiusAuthLog records the outcome of one Access-Request answered with an
+// Access-Accept or Access-Reject, so the reason of a failed login can be
+// looked up after the fact. Challenges are not recorded.
+//
+// Database table: radius_auth_log
+type RadiusAuthLog struct {
+	ID           int64     `json:"id,string" form:"id"`
+	AuthTime     time.Time `json:"auth_time" gorm:"index"`
+	Username     string    `json:"username" gorm:"size:255;index"`
+	NasId        string    `json:"nas_id" gorm:"size:128"`
+	NasAddr      string    `json:"nas_addr" gorm:"size:64;index"`
+	MacAddr      string    `json:"mac_addr" gorm:"size:64;index"`
+	AuthMethod   string    `json:"auth_method" gorm:"size:32"`        // pap | chap | mschap | mac | eap-md5 ...
+	Result       string    `json:"result" gorm:"size:16;index"`       // accept | reject
+	Stage        string    `json:"stage" gorm:"size:64"`              // Pipeline stage that rejected the request
+	MetricsType  string    `json:"metrics_type" gorm:"size:64;index"` // Reject reason key, e.g. radus_reject_passwd_error
+	ReplyMessage string    `json:"reply_message" gorm:"size:255"`     // Reply-Message sent to the NAS
+}
+
+// TableName returns the database table name for RadiusAuthLog.
+func (RadiusAuthLog) TableName() string {
+	return "radius_auth_log"
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+// WalletTransaction is a single entry in a subscriber's prepaid wallet ledger.
+// Every change to RadiusUser.Balance is recorded here so the balance can be
+// audited and receipts can be reproduced at any time.
+//
+// Database table: wallet_transaction
+//
+// Amounts are expressed in the smallest currency unit (for example cents),
+// the same unit used by RadiusProfile.Price. Credits are positive and debits
+// are negative; Balance holds the wallet balance right after this entry.
+type WalletTransaction struct {
+	// ID is the primary key, also used to derive the receipt number.
+	ID int64 `json:"id,string" form:"id"`
+
+	// UserId references the RadiusUser that owns the wallet.
+	UserId int64 `json:"user_id,string" gorm:"index" form:"user_id"`
+
+	// Username is denormalized so ledgers stay readable after user deletion.
+	Username string `json:"username" gorm:"index;size:100" form:"username"`
+
+	// Type classifies the entry.
+	// Possible values: "recharge", "renew", "adjust"
+	Type string `json:"type" gorm:"index;size:20" form:"type"`
+
+	// Source describes where the entry originated.
+	// Possible values: "operator", "voucher", "auto_renew"
+	Source string `json:"source" gorm:"size:20" form:"source"`
+
+	// Amount is the signed change applied to the balance.
+	Amount int64 `json:"amount" form:"amount"`
+
+	// Balance is the wallet balance after this entry was applied.
+	Balance int64 `json:"balance" form:"balance"`
+
+	// ProfileId is the billing profile charged by a renewal entry.
+	ProfileId int64 `json:"profile_id,string" form:"profile_id"`
+
+	// ExpireBefore and ExpireAfter capture the expiry change made by a renewal.
+	ExpireBefore *time.Time `json:"expire_before" form:"expire_before"`
+	ExpireAfter  *time.Time `json:"expire_after" form:"expire_after"`
+
+	// RefId links to the originating record, e.g. the voucher code.
+	RefId string `json:"ref_id" gorm:"size:100" form:"ref_id"`
+
+	// ReceiptNo is the human-readable receipt number printed for the customer.
+	ReceiptNo string `json:"receipt_no" gorm:"uniqueIndex;size:50" form:"receipt_no"`
+
+	// OprName is the operator that performed the entry, empty for system jobs.
+	OprName string `json:"opr_name" gorm:"size:100" form:"opr_name"`
+
+	// Remark is an optional note shown on the receipt.
+	Remark string `json:"remark" form:"remark"`
+
+	// CreatedAt is automatically set by GORM on INSERT.
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName returns the database table name for WalletTransaction.
+func (WalletTransaction) TableName() string {
+	return "wallet_transaction"
+}
+
+// Wallet transaction type constants
+const (
+	WalletTxnRecharge = "recharge"
+	WalletTxnRenew    = "renew"
+	WalletTxnAdjust   = "adjust"
+)
+
+// Wallet transaction source constants
+const (
+	WalletSourceOperator  = "operator"
+	WalletSourceVoucher   = "voucher"
+	WalletSourceAutoRenew = "auto_renew"
+)
+
+// UserStatusSuspended marks a RadiusUser that was stopped by the billing job
+// because the wallet could not cover a renewal. A successful recharge renews
+// the account and restores the "enabled" status.
+const UserStatusSuspended = "suspended"
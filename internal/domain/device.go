@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// MAC binding modes for RadiusUser.BindMac and RadiusProfile.BindMac
+const (
+	MacBindOff     = 0 // No MAC binding
+	MacBindSingle  = 1 // Only the MAC address of the user may log in
+	MacBindDevices = 2 // Any registered device may log in, new devices are learned up to MaxDevices
+)
+
+// Sources of a registered device
+const (
+	DeviceSourceLearned = "learned" // Recorded on the first successful login
+	DeviceSourceManual  = "manual"  // Registered by an operator
+)
+
+// RadiusUserDevice is a device registered to a user for MAC binding and MAC
+// authentication.
+//
+// Database table: radius_user_device
+type RadiusUserDevice struct {
+	ID        int64     `json:"id,string" form:"id"`
+	UserId    int64     `json:"user_id,string" gorm:"uniqueIndex:idx_user_device_mac" form:"user_id"`
+	Username  string    `json:"username" gorm:"index;size:100" form:"username"`
+	MacAddr   string    `json:"mac_addr" gorm:"index;uniqueIndex:idx_user_device_mac;size:32" form:"mac_addr"` // Lower case, colon separated
+	Label     string    `json:"label" gorm:"size:100" form:"label"`
+	Source    string    `json:"source" gorm:"size:20" form:"source"` // learned | manual
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the database table name for RadiusUserDevice.
+func (RadiusUserDevice) TableName() string {
+	return "radius_user_device"
+}
+
+// NormalizeMac returns mac in the lower case, colon separated form devices
+// are stored in, or an empty string when it is not a MAC address.
+func NormalizeMac(mac string) string {
+	hw, err := net.ParseMAC(strings.TrimSpace(mac))
+	if err != nil {
+		return ""
+	}
+	return hw.String()
+}
+
+// GetMaxDevices returns how many devices may be learned in MacBindDevices
+// mode, the user setting overriding the profile. Zero means only devices
+// registered by an operator may log in.
+func (u *RadiusUser) GetMaxDevices(cache interface{}) int {
+	if u.MaxDevices > 0 {
+		return u.MaxDevices
+	}
+	if getter, ok := cache.(ProfileCacheGetter); ok && u.ProfileId != 0 {
+		if profile, err := getter.Get(u.ProfileId); err == nil {
+			return profile.MaxDevices
+		}
+	}
+	return 0
+}
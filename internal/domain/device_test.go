@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMac(t *testing.T) {
+	assert.Equal(t, "00:11:22:aa:bb:cc", NormalizeMac("00-11-22-AA-BB-CC"))
+	assert.Equal(t, "00:11:22:aa:bb:cc", NormalizeMac(" 00:11:22:aa:bb:cc "))
+	assert.Equal(t, "00:11:22:aa:bb:cc", NormalizeMac("0011.22aa.bbcc"))
+	assert.Empty(t, NormalizeMac("N/A"))
+	assert.Empty(t, NormalizeMac(""))
+}
+
+func TestRadiusUser_GetMaxDevices(t *testing.T) {
+	cache := newMockCache()
+	cache.profiles[1] = &RadiusProfile{ID: 1, MaxDevices: 4}
+	assert.Equal(t, 2, (&RadiusUser{ProfileId: 1, MaxDevices: 2}).GetMaxDevices(cache))
+	assert.Equal(t, 4, (&RadiusUser{ProfileId: 1}).GetMaxDevices(cache))
+	assert.Equal(t, 0, (&RadiusUser{ProfileId: 9}).GetMaxDevices(cache))
+	assert.Equal(t, 0, (&RadiusUser{ProfileId: 1}).GetMaxDevices(nil))
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// Scopes of an authentication lockout
+const (
+	LockoutScopeUsername = "username" // User-Name of the request
+	LockoutScopeStation  = "station"  // Calling-Station-Id, the client MAC or source IP
+	LockoutScopeNas      = "nas"      // IP address of the NAS
+)
+
+// RadiusLockout counts the failed logins of one subject and records when it
+// is locked. It is kept in the database so every instance applies the same
+// lockouts and they survive restarts.
+//
+// Database table: radius_lockout
+type RadiusLockout struct {
+	ID          int64     `json:"id,string" form:"id"`
+	Scope       string    `json:"scope" gorm:"size:20;uniqueIndex:idx_lockout_subject" form:"scope"`      // username | station | nas
+	Subject     string    `json:"subject" gorm:"size:128;uniqueIndex:idx_lockout_subject" form:"subject"` // Username, station or NAS IP
+	Failures    int       `json:"failures"`                                                               // Failures in the current window
+	Level       int       `json:"level"`                                                                  // Lockouts so far, each one doubles the duration
+	LastFailure time.Time `json:"last_failure"`
+	LockedUntil time.Time `json:"locked_until" gorm:"index"`
+	Reason      string    `json:"reason" gorm:"size:255"` // Reject reason of the last failure
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName returns the database table name for RadiusLockout.
+func (RadiusLockout) TableName() string {
+	return "radius_lockout"
+}
+
+// IsLocked reports whether the subject is locked at now
+func (l *RadiusLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil.After(now)
+}
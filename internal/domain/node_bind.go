@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Node binding modes for RadiusProfile.NodeBind
+const (
+	NodeBindDefault = 0 // Follow the radius.NodeBindEnabled system setting
+	NodeBindOn      = 1 // Users may only log in on the NAS of their node
+	NodeBindOff     = 2 // Users may log in on the NAS of any node
+)
+
+// AllowedNodeList parses the profile AllowedNodes setting.
+func (p *RadiusProfile) AllowedNodeList() ([]int64, error) {
+	var nodes []int64
+	for _, part := range strings.Split(p.AllowedNodes, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid allowed node %q", part)
+		}
+		nodes = append(nodes, id)
+	}
+	return nodes, nil
+}
+
+// HomeNode returns the node a user belongs to, the node of the profile for
+// users created without one. Zero means the user belongs to no node.
+func (u *RadiusUser) HomeNode(profile *RadiusProfile) int64 {
+	if u.NodeId != 0 || profile == nil {
+		return u.NodeId
+	}
+	return profile.NodeId
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRadiusProfile_AllowedNodeList(t *testing.T) {
+	nodes, err := (&RadiusProfile{AllowedNodes: " 3, 7,,12 "}).AllowedNodeList()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 7, 12}, nodes)
+
+	nodes, err = (&RadiusProfile{}).AllowedNodeList()
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	_, err = (&RadiusProfile{AllowedNodes: "3,branch-b"}).AllowedNodeList()
+	assert.Error(t, err)
+	_, err = (&RadiusProfile{AllowedNodes: "-1"}).AllowedNodeList()
+	assert.Error(t, err)
+}
+
+func TestRadiusUser_HomeNode(t *testing.T) {
+	profile := &RadiusProfile{NodeId: 5}
+	assert.Equal(t, int64(2), (&RadiusUser{NodeId: 2}).HomeNode(profile))
+	assert.Equal(t, int64(5), (&RadiusUser{}).HomeNode(profile))
+	assert.Equal(t, int64(0), (&RadiusUser{}).HomeNode(nil))
+}
@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy rule actions
+const (
+	PolicyActionAccept = "accept" // Apply the overrides and keep evaluating
+	PolicyActionReject = "reject" // Reject the request with RejectMessage
+)
+
+// PolicyRule is an operator defined authorization rule evaluated right after
+// the user is loaded. Rules run in ascending Priority order; every matching
+// rule applies its actions, a reject or a Final rule ends the evaluation.
+//
+// Database table: radius_policy_rule
+//
+// Conditions left empty match any request, the others must all match.
+type PolicyRule struct {
+	ID       int64  `json:"id,string" form:"id"`
+	Name     string `json:"name" gorm:"size:100" form:"name"`
+	Priority int    `json:"priority" gorm:"index" form:"priority"` // Lower values run first
+	Status   string `json:"status" gorm:"size:20" form:"status"`   // enabled | disabled
+	Final    bool   `json:"final" form:"final"`                    // Stop evaluating after this rule matches
+
+	// Conditions
+	NasId           int64  `json:"nas_id,string" form:"nas_id"`                // NAS device
+	NodeId          int64  `json:"node_id,string" form:"node_id"`              // Node of the NAS device
+	CalledStationId string `json:"called_station_id" form:"called_station_id"` // Regular expression on Called-Station-Id
+	Ssid            string `json:"ssid" form:"ssid"`                           // Wireless SSID, case-insensitive
+	NasPortTypes    string `json:"nas_port_types" form:"nas_port_types"`       // Comma separated NAS-Port-Type values, e.g. "15,19"
+	TimeWindows     string `json:"time_windows" form:"time_windows"`           // JSON list of weekly time windows
+	ProfileId       int64  `json:"profile_id,string" form:"profile_id"`        // Profile of the user
+	Realm           string `json:"realm" form:"realm"`                         // Login realm, case-insensitive
+
+	// Actions
+	Action        string `json:"action" gorm:"size:20" form:"action"`         // accept | reject
+	RejectMessage string `json:"reject_message" form:"reject_message"`        // Reply-Message of a reject
+	SetProfileId  int64  `json:"set_profile_id,string" form:"set_profile_id"` // Authorize with this profile instead
+	ReplyAttrs    string `json:"reply_attrs" form:"reply_attrs"`              // JSON list of reply attributes
+	EapMethod     string `json:"eap_method" form:"eap_method"`                // Preferred EAP method, e.g. eap-mschapv2
+
+	Remark    string    `json:"remark" form:"remark"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the database table name for PolicyRule.
+func (PolicyRule) TableName() string {
+	return "radius_policy_rule"
+}
+
+// PolicyRequest holds the request attributes the rule conditions match on.
+type PolicyRequest struct {
+	NasId           int64     `json:"nas_id,string"`
+	NodeId          int64     `json:"node_id,string"`
+	CalledStationId string    `json:"called_station_id"`
+	Ssid            string    `json:"ssid"`
+	NasPortType     int       `json:"nas_port_type"` // 0 when the request has none
+	ProfileId       int64     `json:"profile_id,string"`
+	Realm           string    `json:"realm"`
+	Time            time.Time `json:"time"`
+}
+
+// PolicyDecision is the combined outcome of the matching rules.
+type PolicyDecision struct {
+	Matched       []string        `json:"matched"` // Names of the matching rules in evaluation order
+	Reject        bool            `json:"reject"`
+	RejectMessage string          `json:"reject_message,omitempty"`
+	ProfileId     int64           `json:"profile_id,string,omitempty"`
+	ReplyAttrs    []AttributeItem `json:"reply_attrs,omitempty"`
+	EapMethod     string          `json:"eap_method,omitempty"`
+}
+
+// PolicyRuleSet is a validated, ordered list of enabled rules.
+type PolicyRuleSet struct {
+	rules []compiledPolicyRule
+}
+
+type compiledPolicyRule struct {
+	rule          PolicyRule
+	calledStation *regexp.Regexp
+	portTypes     map[int]bool
+	windows       []TimeWindow
+	replyItems    []AttributeItem
+}
+
+// Validate checks the rule fields the admin API accepts.
+func (r *PolicyRule) Validate() error {
+	_, err := compilePolicyRule(*r)
+	return err
+}
+
+func compilePolicyRule(r PolicyRule) (compiledPolicyRule, error) {
+	c := compiledPolicyRule{rule: r}
+	switch r.Action {
+	case PolicyActionAccept, PolicyActionReject:
+	default:
+		return c, fmt.Errorf("invalid action %q", r.Action)
+	}
+
+	var err error
+	if strings.TrimSpace(r.CalledStationId) != "" {
+		if c.calledStation, err = regexp.Compile(r.CalledStationId); err != nil {
+			return c, fmt.Errorf("invalid called station id expression: %w", err)
+		}
+	}
+	for _, part := range strings.Split(r.NasPortTypes, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 {
+			return c, fmt.Errorf("invalid nas port type %q", part)
+		}
+		if c.portTypes == nil {
+			c.portTypes = make(map[int]bool)
+		}
+		c.portTypes[v] = true
+	}
+	if c.windows, err = ParseTimeWindows(r.TimeWindows); err != nil {
+		return c, err
+	}
+	if c.replyItems, err = ParseReplyItems(r.ReplyAttrs); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// NewPolicyRuleSet compiles the enabled rules in evaluation order. Invalid
+// rules are left out and reported in the returned error, the set always
+// holds the valid ones.
+func NewPolicyRuleSet(rules []PolicyRule) (*PolicyRuleSet, error) {
+	set := &PolicyRuleSet{}
+	var errs []error
+	for _, r := range rules {
+		if r.Status != "" && r.Status != "enabled" {
+			continue
+		}
+		c, err := compilePolicyRule(r)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("policy rule %q: %w", r.Name, err))
+			continue
+		}
+		set.rules = append(set.rules, c)
+	}
+	sort.SliceStable(set.rules, func(i, j int) bool {
+		if set.rules[i].rule.Priority != set.rules[j].rule.Priority {
+			return set.rules[i].rule.Priority < set.rules[j].rule.Priority
+		}
+		return set.rules[i].rule.ID < set.rules[j].rule.ID
+	})
+	return set, errors.Join(errs...)
+}
+
+// Len returns the number of rules in the set.
+func (s *PolicyRuleSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.rules)
+}
+
+// Evaluate applies the matching rules to req. Later rules override the
+// profile and EAP method of earlier ones, reply attributes accumulate.
+func (s *PolicyRuleSet) Evaluate(req PolicyRequest) PolicyDecision {
+	var d PolicyDecision
+	if s == nil {
+		return d
+	}
+	for i := range s.rules {
+		c := &s.rules[i]
+		if !c.matches(req) {
+			continue
+		}
+		d.Matched = append(d.Matched, c.rule.Name)
+		if c.rule.Action == PolicyActionReject {
+			d.Reject = true
+			d.RejectMessage = c.rule.RejectMessage
+			return d
+		}
+		if c.rule.SetProfileId != 0 {
+			d.ProfileId = c.rule.SetProfileId
+		}
+		if method := strings.ToLower(strings.TrimSpace(c.rule.EapMethod)); method != "" {
+			d.EapMethod = method
+		}
+		d.ReplyAttrs = append(d.ReplyAttrs, c.replyItems...)
+		if c.rule.Final {
+			break
+		}
+	}
+	return d
+}
+
+func (c *compiledPolicyRule) matches(req PolicyRequest) bool {
+	r := &c.rule
+	if r.NasId != 0 && r.NasId != req.NasId {
+		return false
+	}
+	if r.NodeId != 0 && r.NodeId != req.NodeId {
+		return false
+	}
+	if c.calledStation != nil && !c.calledStation.MatchString(req.CalledStationId) {
+		return false
+	}
+	if ssid := strings.TrimSpace(r.Ssid); ssid != "" && !strings.EqualFold(ssid, req.Ssid) {
+		return false
+	}
+	if c.portTypes != nil && !c.portTypes[req.NasPortType] {
+		return false
+	}
+	if r.ProfileId != 0 && r.ProfileId != req.ProfileId {
+		return false
+	}
+	if realm := strings.TrimSpace(r.Realm); realm != "" && !strings.EqualFold(realm, req.Realm) {
+		return false
+	}
+	if len(c.windows) > 0 {
+		if allowed, _ := WindowsAllow(c.windows, req.Time); !allowed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyRule_Validate(t *testing.T) {
+	valid := PolicyRule{
+		Name:            "guest",
+		Action:          PolicyActionAccept,
+		CalledStationId: `(?i):guest$`,
+		NasPortTypes:    "15, 19",
+		TimeWindows:     `[{"days":"mon-fri","start":"08:00","end":"18:00"}]`,
+		ReplyAttrs:      `[{"attribute":"Filter-Id","value":"guest"}]`,
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := []PolicyRule{
+		{Name: "no action"},
+		{Name: "bad action", Action: "drop"},
+		{Name: "bad regexp", Action: PolicyActionAccept, CalledStationId: "("},
+		{Name: "bad port type", Action: PolicyActionAccept, NasPortTypes: "wireless"},
+		{Name: "bad window", Action: PolicyActionAccept, TimeWindows: `[{"days":"mon","start":"25:00","end":"26:00"}]`},
+		{Name: "bad reply", Action: PolicyActionAccept, ReplyAttrs: `[{"attribute":"Class","op":"==","value":"x"}]`},
+	}
+	for _, rule := range invalid {
+		assert.Error(t, rule.Validate(), rule.Name)
+	}
+}
+
+func TestNewPolicyRuleSet(t *testing.T) {
+	set, err := NewPolicyRuleSet([]PolicyRule{
+		{ID: 3, Name: "third", Priority: 20, Action: PolicyActionAccept},
+		{ID: 2, Name: "second", Priority: 10, Action: PolicyActionAccept},
+		{ID: 1, Name: "first", Priority: 10, Action: PolicyActionAccept},
+		{ID: 4, Name: "disabled", Status: "disabled", Action: PolicyActionReject},
+		{ID: 5, Name: "broken", Action: "drop"},
+	})
+	assert.ErrorContains(t, err, `policy rule "broken"`)
+	assert.Equal(t, 3, set.Len())
+	assert.Equal(t, []string{"first", "second", "third"}, set.Evaluate(PolicyRequest{}).Matched)
+
+	var empty *PolicyRuleSet
+	assert.Equal(t, 0, empty.Len())
+	assert.Empty(t, empty.Evaluate(PolicyRequest{}).Matched)
+}
+
+func TestPolicyRuleSet_Evaluate(t *testing.T) {
+	monday := time.Date(2026, 10, 19, 9, 30, 0, 0, time.Local)
+	set, err := NewPolicyRuleSet([]PolicyRule{
+		{
+			ID: 1, Name: "guest ssid", Priority: 10, Action: PolicyActionAccept,
+			Ssid: "Guest", SetProfileId: 7, EapMethod: "EAP-MSCHAPv2",
+			ReplyAttrs: `[{"attribute":"Filter-Id","value":"guest"}]`,
+		},
+		{
+			ID: 2, Name: "guest office hours", Priority: 20, Action: PolicyActionReject,
+			Ssid: "guest", TimeWindows: `[{"days":"sat,sun","start":"00:00","end":"00:00"}]`,
+			RejectMessage: "guest network closed",
+		},
+		{
+			ID: 3, Name: "wired", Priority: 30, Action: PolicyActionAccept, Final: true,
+			NasPortTypes: "15", ReplyAttrs: `[{"attribute":"Class","value":"wired"}]`,
+		},
+		{
+			ID: 4, Name: "after final", Priority: 40, Action: PolicyActionReject,
+		},
+		{
+			ID: 5, Name: "partner realm", Priority: 5, Action: PolicyActionReject,
+			Realm: "partner.example", NodeId: 9,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("overrides and reply attributes", func(t *testing.T) {
+		d := set.Evaluate(PolicyRequest{Ssid: "GUEST", NasPortType: 15, Time: monday})
+		assert.Equal(t, []string{"guest ssid", "wired"}, d.Matched)
+		assert.False(t, d.Reject)
+		assert.Equal(t, int64(7), d.ProfileId)
+		assert.Equal(t, "eap-mschapv2", d.EapMethod)
+		require.Len(t, d.ReplyAttrs, 2)
+		assert.Equal(t, "Filter-Id", d.ReplyAttrs[0].Attribute)
+		assert.Equal(t, "Class", d.ReplyAttrs[1].Attribute)
+	})
+
+	t.Run("reject stops evaluation", func(t *testing.T) {
+		d := set.Evaluate(PolicyRequest{Ssid: "guest", NasPortType: 15, Time: monday.AddDate(0, 0, 5)})
+		assert.Equal(t, []string{"guest ssid", "guest office hours"}, d.Matched)
+		assert.True(t, d.Reject)
+		assert.Equal(t, "guest network closed", d.RejectMessage)
+	})
+
+	t.Run("non final rules keep evaluating", func(t *testing.T) {
+		d := set.Evaluate(PolicyRequest{NasPortType: 19, Time: monday})
+		assert.Equal(t, []string{"after final"}, d.Matched)
+		assert.True(t, d.Reject)
+	})
+
+	t.Run("all conditions must match", func(t *testing.T) {
+		d := set.Evaluate(PolicyRequest{Realm: "Partner.Example", NodeId: 9, NasPortType: 15, Time: monday})
+		assert.Equal(t, []string{"partner realm"}, d.Matched)
+
+		d = set.Evaluate(PolicyRequest{Realm: "partner.example", NodeId: 1, NasPortType: 15, Time: monday})
+		assert.Equal(t, []string{"wired"}, d.Matched)
+	})
+}
@@ -14,12 +14,24 @@ type RadiusProfile struct {
 	Status         string    `gorm:"index" json:"status" form:"status"`        // Profile status: 0=disabled 1=enabled
 	AddrPool       string    `json:"addr_pool" form:"addr_pool"`               // Address pool
 	ActiveNum      int       `json:"active_num" form:"active_num"`             // Concurrent sessions
+	SessionPolicy  string    `json:"session_policy" form:"session_policy"`     // Over the session limit: reject_new | disconnect_oldest | disconnect_others
 	UpRate         int       `json:"up_rate" form:"up_rate"`                   // Upload rate in Kb
 	DownRate       int       `json:"down_rate" form:"down_rate"`               // Download rate in Kb
 	Domain         string    `json:"domain" form:"domain"`                     // Domain, corresponds to NAS device domain attribute, e.g., Huawei domain_code
 	IPv6PrefixPool string    `json:"ipv6_prefix_pool" form:"ipv6_prefix_pool"` // IPv6 prefix pool name for NAS-side allocation
-	BindMac        int       `json:"bind_mac" form:"bind_mac"`                 // Bind MAC
+	BindMac        int       `json:"bind_mac" form:"bind_mac"`                 // MAC binding: 0=off 1=single MAC 2=registered devices
+	MaxDevices     int       `json:"max_devices" form:"max_devices"`           // Devices learned in device binding mode, 0=registered only
 	BindVlan       int       `json:"bind_vlan" form:"bind_vlan"`               // Bind VLAN
+	NodeBind       int       `json:"node_bind" form:"node_bind"`               // Node binding: 0=system setting 1=on 2=off
+	AllowedNodes   string    `json:"allowed_nodes" form:"allowed_nodes"`       // Comma separated IDs of other nodes whose NAS may be used
+	Price          int64     `json:"price" form:"price"`                       // Renewal price in the smallest currency unit, 0=free
+	RenewPeriod    int       `json:"renew_period" form:"renew_period"`         // Days added to ExpireTime per renewal, 0=no auto-renewal
+	AccessWindows  string    `json:"access_windows" form:"access_windows"`     // JSON list of allowed login windows, empty=always
+	RateSchedule   string    `json:"rate_schedule" form:"rate_schedule"`       // JSON list of time-banded rate overrides
+	RoleName       string    `json:"role_name" form:"role_name"`               // WLAN user role sent to Aruba / Ruckus controllers
+	DynamicVlan    int       `json:"dynamic_vlan" form:"dynamic_vlan"`         // VLAN assigned to WLAN clients, 0=none
+	ReplyAttrs     string    `json:"reply_attrs" form:"reply_attrs"`           // JSON list of custom reply attributes
+	CheckAttrs     string    `json:"check_attrs" form:"check_attrs"`           // JSON list of custom check attributes
 	Remark         string    `json:"remark" form:"remark"`                     // Remark
 	CreatedAt      time.Time `json:"created_at" form:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at" form:"updated_at"`
@@ -53,10 +65,20 @@ type RadiusUser struct {
 	Domain          string    `json:"domain" form:"domain"`                             // Domain name for vendor-specific features (e.g., Huawei domain)
 	IPv6PrefixPool  string    `json:"ipv6_prefix_pool" form:"ipv6_prefix_pool"`         // IPv6 prefix pool name (inherited from profile or user-specific)
 	BindVlan        int       `json:"bind_vlan" form:"bind_vlan"`                       // Bind VLAN
-	BindMac         int       `json:"bind_mac" form:"bind_mac"`                         // Bind MAC
+	BindMac         int       `json:"bind_mac" form:"bind_mac"`                         // MAC binding: 0=off 1=single MAC 2=registered devices
+	MaxDevices      int       `json:"max_devices" form:"max_devices"`                   // Devices learned in device binding mode, 0=profile setting
+	CircuitId       string    `json:"circuit_id" form:"circuit_id"`                     // Bound Agent-Circuit-Id (access line)
+	RemoteId        string    `json:"remote_id" form:"remote_id"`                       // Bound Agent-Remote-Id (access line)
+	BindLine        int       `json:"bind_line" form:"bind_line"`                       // Line binding: 0=off 1=learn on first login 2=strict
+	Roaming         int       `json:"roaming" form:"roaming"`                           // 1=may log in on the NAS of any node
 	ProfileLinkMode int       `json:"profile_link_mode" form:"profile_link_mode"`       // 0=static (snapshot), 1=dynamic (real-time from profile)
+	AuthSource      string    `json:"auth_source" form:"auth_source"`                   // Authentication source: empty=local, ldap=directory shadow user
+	TotpSecret      string    `json:"-" form:"-"`                                       // Base32 TOTP secret, empty=no second factor
 	ExpireTime      time.Time `gorm:"index" json:"expire_time"`                         // Expiration time
-	Status          string    `gorm:"index" json:"status" form:"status"`                // Status: enabled | disabled
+	Status          string    `gorm:"index" json:"status" form:"status"`                // Status: enabled | disabled | suspended
+	Balance         int64     `json:"balance"`                                          // Prepaid wallet balance in the smallest currency unit
+	ReplyAttrs      string    `json:"reply_attrs" form:"reply_attrs"`                   // JSON list of custom reply attributes, overriding the profile
+	CheckAttrs      string    `json:"check_attrs" form:"check_attrs"`                   // JSON list of custom check attributes, overriding the profile
 	Remark          string    `json:"remark" form:"remark"`                             // Remark
 	OnlineCount     int       `json:"online_count" gorm:"-:migration;<-:false"`
 	LastOnline      time.Time `json:"last_online"`
@@ -87,6 +109,8 @@ type RadiusOnline struct {
 	NasPort             int64     `json:"nas_port,string"`
 	NasClass            string    `json:"nas_class"`
 	NasPortId           string    `json:"nas_port_id"`
+	CircuitId           string    `gorm:"index" json:"circuit_id"`
+	RemoteId            string    `json:"remote_id"`
 	NasPortType         int       `json:"nas_port_type"`
 	ServiceType         int       `json:"service_type"`
 	AcctSessionId       string    `gorm:"index" json:"acct_session_id"`
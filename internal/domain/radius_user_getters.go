@@ -10,6 +10,19 @@ const (
 	ProfileLinkModeDynamic = 1 // Dynamic mode: user attributes are fetched from profile in real-time
 )
 
+// Line binding modes for RadiusUser.BindLine
+const (
+	LineBindOff    = 0 // No access line binding
+	LineBindLearn  = 1 // Bind to the line of the first successful login
+	LineBindStrict = 2 // Only the provisioned line may log in
+)
+
+// Authentication sources for RadiusUser.AuthSource
+const (
+	AuthSourceLocal = ""     // Password stored in radius_user
+	AuthSourceLDAP  = "ldap" // Shadow user whose password is verified against LDAP
+)
+
 // ProfileCacheGetter defines interface for profile cache to avoid circular dependency
 type ProfileCacheGetter interface {
 	Get(profileID int64) (*RadiusProfile, error)
@@ -196,7 +209,7 @@ func (u *RadiusUser) GetBindMac(cache interface{}) int {
 			}
 			// If profile binding is enabled and user has specific MAC, enforce binding
 			if profile.BindMac > 0 && u.MacAddr != "" && u.MacAddr != "NA" {
-				return 1
+				return profile.BindMac
 			}
 			return profile.BindMac
 		}
@@ -210,6 +223,11 @@ func (u *RadiusUser) GetBindMac(cache interface{}) int {
 	return u.BindMac
 }
 
+// HasLineId reports whether the user has a bound access line
+func (u *RadiusUser) HasLineId() bool {
+	return (u.CircuitId != "" && u.CircuitId != "N/A") || (u.RemoteId != "" && u.RemoteId != "N/A")
+}
+
 // GetBindVlan returns the VLAN binding flag, respecting profile link mode
 func (u *RadiusUser) GetBindVlan(cache interface{}) int {
 	// User-specific override has priority
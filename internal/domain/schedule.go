@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeWindow is a weekly recurring time range used by profile access windows
+// and rate schedules.
+//
+// Days is a comma separated list of weekdays or ranges, e.g. "mon-fri",
+// "sat,sun" or "*" for every day. Start and End use 24-hour "HH:MM" format;
+// End may be "24:00". When End is earlier than Start the window runs past
+// midnight into the following day, and Start equal to End covers the whole day.
+type TimeWindow struct {
+	Days  string `json:"days"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+
+	days  [7]bool
+	start int // minutes from midnight
+	end   int // minutes from midnight
+}
+
+// RateBand overrides the profile rates while its time window is active.
+// Rates use the same Kbps unit as RadiusProfile.UpRate and DownRate.
+type RateBand struct {
+	TimeWindow
+	UpRate   int `json:"up_rate"`
+	DownRate int `json:"down_rate"`
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseTimeWindows decodes and validates a JSON encoded list of time windows.
+// An empty string yields no windows.
+func ParseTimeWindows(s string) ([]TimeWindow, error) {
+	var windows []TimeWindow
+	if strings.TrimSpace(s) == "" {
+		return windows, nil
+	}
+	if err := json.Unmarshal([]byte(s), &windows); err != nil {
+		return nil, fmt.Errorf("invalid time windows: %w", err)
+	}
+	for i := range windows {
+		if err := windows[i].compile(); err != nil {
+			return nil, fmt.Errorf("time window %d: %w", i+1, err)
+		}
+	}
+	return windows, nil
+}
+
+// ParseRateBands decodes and validates a JSON encoded rate schedule.
+// An empty string yields no bands.
+func ParseRateBands(s string) ([]RateBand, error) {
+	var bands []RateBand
+	if strings.TrimSpace(s) == "" {
+		return bands, nil
+	}
+	if err := json.Unmarshal([]byte(s), &bands); err != nil {
+		return nil, fmt.Errorf("invalid rate schedule: %w", err)
+	}
+	for i := range bands {
+		if err := bands[i].compile(); err != nil {
+			return nil, fmt.Errorf("rate band %d: %w", i+1, err)
+		}
+		if bands[i].UpRate < 0 || bands[i].DownRate < 0 {
+			return nil, fmt.Errorf("rate band %d: rates must not be negative", i+1)
+		}
+	}
+	return bands, nil
+}
+
+// AccessWindowList parses the profile AccessWindows setting.
+func (p *RadiusProfile) AccessWindowList() ([]TimeWindow, error) {
+	return ParseTimeWindows(p.AccessWindows)
+}
+
+// RateBandList parses the profile RateSchedule setting.
+func (p *RadiusProfile) RateBandList() ([]RateBand, error) {
+	return ParseRateBands(p.RateSchedule)
+}
+
+func (w *TimeWindow) compile() error {
+	days := strings.ToLower(strings.TrimSpace(w.Days))
+	if days == "" || days == "*" {
+		for i := range w.days {
+			w.days[i] = true
+		}
+	} else {
+		for _, part := range strings.Split(days, ",") {
+			part = strings.TrimSpace(part)
+			from, to, isRange := strings.Cut(part, "-")
+			first, ok := weekdayNames[strings.TrimSpace(from)]
+			if !ok {
+				return fmt.Errorf("unknown weekday %q", from)
+			}
+			last := first
+			if isRange {
+				if last, ok = weekdayNames[strings.TrimSpace(to)]; !ok {
+					return fmt.Errorf("unknown weekday %q", to)
+				}
+			}
+			for d := first; ; d = (d + 1) % 7 {
+				w.days[d] = true
+				if d == last {
+					break
+				}
+			}
+		}
+	}
+
+	var err error
+	if w.start, err = parseClock(w.Start); err != nil {
+		return err
+	}
+	if w.end, err = parseClock(w.End); err != nil {
+		return err
+	}
+	if w.start == 24*60 {
+		return fmt.Errorf("start time %q out of range", w.Start)
+	}
+	if w.start == w.end {
+		w.start, w.end = 0, 24*60
+	}
+	return nil
+}
+
+func parseClock(s string) (int, error) {
+	hh, mm, ok := strings.Cut(strings.TrimSpace(s), ":")
+	h, errH := strconv.Atoi(hh)
+	m, errM := strconv.Atoi(mm)
+	if !ok || errH != nil || errM != nil || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+func (w *TimeWindow) overnight() bool {
+	return w.end < w.start
+}
+
+// Contains reports whether t falls inside the window.
+func (w *TimeWindow) Contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	d := t.Weekday()
+	if !w.overnight() {
+		return w.days[d] && m >= w.start && m < w.end
+	}
+	return (w.days[d] && m >= w.start) || (w.days[(d+6)%7] && m < w.end)
+}
+
+// endAfter returns the end of the window occurrence containing t.
+func (w *TimeWindow) endAfter(t time.Time) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	m := t.Hour()*60 + t.Minute()
+	if w.overnight() && m >= w.start {
+		midnight = midnight.AddDate(0, 0, 1)
+	}
+	return midnight.Add(time.Duration(w.end) * time.Minute)
+}
+
+// WindowsAllow reports whether t is inside any of the windows and, if so,
+// when the continuous allowed period ends. An empty list allows any time and
+// returns a zero end time, as does a schedule that never closes.
+func WindowsAllow(windows []TimeWindow, t time.Time) (bool, time.Time) {
+	if len(windows) == 0 {
+		return true, time.Time{}
+	}
+	var end time.Time
+	for i := range windows {
+		if windows[i].Contains(t) {
+			if e := windows[i].endAfter(t); e.After(end) {
+				end = e
+			}
+		}
+	}
+	if end.IsZero() {
+		return false, time.Time{}
+	}
+
+	// Follow adjacent or overlapping windows so the boundary is the real end
+	// of access, not the end of the first matching entry.
+	limit := t.AddDate(0, 0, 7)
+	for end.Before(limit) {
+		next := end
+		for i := range windows {
+			if windows[i].Contains(end) {
+				if e := windows[i].endAfter(end); e.After(next) {
+					next = e
+				}
+			}
+		}
+		if !next.After(end) {
+			return true, end
+		}
+		end = next
+	}
+	return true, time.Time{}
+}
+
+// ActiveRateBand returns the first band active at t, or nil.
+func ActiveRateBand(bands []RateBand, t time.Time) *RateBand {
+	for i := range bands {
+		if bands[i].Contains(t) {
+			return &bands[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 2025-01-06 is a Monday
+func at(day, hour, minute int) time.Time {
+	return time.Date(2025, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestParseTimeWindows(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		count   int
+		wantErr bool
+	}{
+		{"empty", "", 0, false},
+		{"single", `[{"days":"mon-fri","start":"08:00","end":"18:00"}]`, 1, false},
+		{"all days", `[{"days":"*","start":"00:00","end":"24:00"}]`, 1, false},
+		{"wrapping range", `[{"days":"fri-mon","start":"22:00","end":"06:00"}]`, 1, false},
+		{"unknown day", `[{"days":"funday","start":"08:00","end":"18:00"}]`, 0, true},
+		{"bad time", `[{"days":"mon","start":"8am","end":"18:00"}]`, 0, true},
+		{"out of range", `[{"days":"mon","start":"24:00","end":"01:00"}]`, 0, true},
+		{"bad json", `{"days":"mon"}`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows, err := ParseTimeWindows(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, windows, tt.count)
+		})
+	}
+}
+
+func TestWindowsAllow(t *testing.T) {
+	office, err := ParseTimeWindows(`[{"days":"mon-fri","start":"08:00","end":"18:00"}]`)
+	require.NoError(t, err)
+	night, err := ParseTimeWindows(`[{"days":"fri","start":"22:00","end":"06:00"}]`)
+	require.NoError(t, err)
+	chained, err := ParseTimeWindows(`[
+		{"days":"mon","start":"08:00","end":"12:00"},
+		{"days":"mon","start":"12:00","end":"20:00"}]`)
+	require.NoError(t, err)
+	always, err := ParseTimeWindows(`[{"days":"*","start":"00:00","end":"24:00"}]`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		windows []TimeWindow
+		now     time.Time
+		allowed bool
+		end     time.Time
+	}{
+		{"no windows", nil, at(6, 3, 0), true, time.Time{}},
+		{"inside office hours", office, at(6, 9, 30), true, at(6, 18, 0)},
+		{"before office hours", office, at(6, 7, 59), false, time.Time{}},
+		{"weekend", office, at(11, 10, 0), false, time.Time{}},
+		{"overnight start day", night, at(10, 23, 0), true, at(11, 6, 0)},
+		{"overnight next day", night, at(11, 5, 0), true, at(11, 6, 0)},
+		{"overnight wrong day", night, at(9, 23, 0), false, time.Time{}},
+		{"adjacent windows merge", chained, at(6, 9, 0), true, at(6, 20, 0)},
+		{"never closes", always, at(6, 9, 0), true, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, end := WindowsAllow(tt.windows, tt.now)
+			assert.Equal(t, tt.allowed, allowed)
+			assert.True(t, tt.end.Equal(end), "expected end %v, got %v", tt.end, end)
+		})
+	}
+}
+
+func TestActiveRateBand(t *testing.T) {
+	bands, err := ParseRateBands(`[{"days":"*","start":"00:00","end":"06:00","up_rate":20480,"down_rate":40960}]`)
+	require.NoError(t, err)
+
+	band := ActiveRateBand(bands, at(6, 2, 0))
+	require.NotNil(t, band)
+	assert.Equal(t, 20480, band.UpRate)
+	assert.Equal(t, 40960, band.DownRate)
+	assert.Nil(t, ActiveRateBand(bands, at(6, 6, 0)))
+
+	_, err = ParseRateBands(`[{"days":"*","start":"00:00","end":"06:00","up_rate":-1}]`)
+	assert.Error(t, err)
+}
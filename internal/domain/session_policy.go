@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// Session policies for RadiusProfile.SessionPolicy, applied when a login
+// would exceed the concurrent session limit
+const (
+	SessionPolicyRejectNew        = "reject_new"        // Reject the new login
+	SessionPolicyDisconnectOldest = "disconnect_oldest" // Disconnect the oldest sessions to make room, then accept
+	SessionPolicyDisconnectOthers = "disconnect_others" // Disconnect every other session, then accept
+)
+
+// IsValidSessionPolicy reports whether policy is a known session policy. An
+// empty policy means SessionPolicyRejectNew.
+func IsValidSessionPolicy(policy string) bool {
+	switch policy {
+	case "", SessionPolicyRejectNew, SessionPolicyDisconnectOldest, SessionPolicyDisconnectOthers:
+		return true
+	}
+	return false
+}
+
+// GetSessionPolicy returns the session policy of the profile of the user,
+// SessionPolicyRejectNew when none is set
+func (u *RadiusUser) GetSessionPolicy(cache interface{}) string {
+	if getter, ok := cache.(ProfileCacheGetter); ok && u.ProfileId != 0 {
+		if profile, err := getter.Get(u.ProfileId); err == nil && profile.SessionPolicy != "" {
+			return profile.SessionPolicy
+		}
+	}
+	return SessionPolicyRejectNew
+}
+
+// IsStale reports whether the session is probably a ghost: the NAS sent no
+// accounting update for longer than staleAfter. A zero staleAfter disables
+// the detection.
+func (s *RadiusOnline) IsStale(now time.Time, staleAfter time.Duration) bool {
+	if staleAfter <= 0 {
+		return false
+	}
+	last := s.LastUpdate
+	if last.IsZero() {
+		last = s.AcctStartTime
+	}
+	if last.IsZero() {
+		return false
+	}
+	return now.Sub(last) > staleAfter
+}
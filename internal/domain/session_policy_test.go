@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidSessionPolicy(t *testing.T) {
+	assert.True(t, IsValidSessionPolicy(""))
+	assert.True(t, IsValidSessionPolicy(SessionPolicyDisconnectOldest))
+	assert.False(t, IsValidSessionPolicy("disconnect_newest"))
+}
+
+func TestRadiusUser_GetSessionPolicy(t *testing.T) {
+	cache := newMockCache()
+	cache.profiles[1] = &RadiusProfile{ID: 1, SessionPolicy: SessionPolicyDisconnectOthers}
+	cache.profiles[2] = &RadiusProfile{ID: 2}
+	assert.Equal(t, SessionPolicyDisconnectOthers, (&RadiusUser{ProfileId: 1}).GetSessionPolicy(cache))
+	assert.Equal(t, SessionPolicyRejectNew, (&RadiusUser{ProfileId: 2}).GetSessionPolicy(cache))
+	assert.Equal(t, SessionPolicyRejectNew, (&RadiusUser{ProfileId: 9}).GetSessionPolicy(cache))
+	assert.Equal(t, SessionPolicyRejectNew, (&RadiusUser{ProfileId: 1}).GetSessionPolicy(nil))
+}
+
+func TestRadiusOnline_IsStale(t *testing.T) {
+	now := time.Now()
+	session := &RadiusOnline{AcctStartTime: now.Add(-time.Hour), LastUpdate: now.Add(-5 * time.Minute)}
+	assert.False(t, session.IsStale(now, 10*time.Minute))
+	assert.True(t, session.IsStale(now, 2*time.Minute))
+	assert.False(t, session.IsStale(now, 0))
+
+	// Sessions without an update are measured from their start
+	session = &RadiusOnline{AcctStartTime: now.Add(-time.Hour)}
+	assert.True(t, session.IsStale(now, 10*time.Minute))
+	assert.False(t, (&RadiusOnline{}).IsStale(now, time.Minute))
+}
@@ -53,3 +53,37 @@ type SysOprLog struct {
 func (SysOprLog) TableName() string {
 	return "sys_opr_log"
 }
+
+// SysChangeEvent is a change event of the admin API kept for the other
+// instances sharing the database, which poll the table to evict their
+// caches. Rows are removed after an hour.
+//
+// Database table: sys_change_event
+type SysChangeEvent struct {
+	ID        int64     `json:"id,string" gorm:"primaryKey;autoIncrement"`
+	Kind      string    `json:"kind" gorm:"size:32"`
+	EntityId  int64     `json:"entity_id,string"`
+	Keys      string    `json:"keys" gorm:"size:1024"` // JSON array of the natural keys
+	Origin    string    `json:"origin" gorm:"size:64"` // Instance that published the event
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName Specify table name
+func (SysChangeEvent) TableName() string {
+	return "sys_change_event"
+}
+
+// SchemaVersion records a versioned migration applied to the database, so
+// upgrades run each structural or data migration exactly once.
+//
+// Database table: schema_version
+type SchemaVersion struct {
+	Version   int64     `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `json:"name" gorm:"size:128"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
+// TableName Specify table name
+func (SchemaVersion) TableName() string {
+	return "schema_version"
+}
@@ -36,11 +36,26 @@ func TestRadiusProfile_TableName(t *testing.T) {
 	assert.Equal(t, "radius_profile", model.TableName())
 }
 
+func TestPolicyRule_TableName(t *testing.T) {
+	model := PolicyRule{}
+	assert.Equal(t, "radius_policy_rule", model.TableName())
+}
+
 func TestRadiusUser_TableName(t *testing.T) {
 	model := RadiusUser{}
 	assert.Equal(t, "radius_user", model.TableName())
 }
 
+func TestRadiusUserDevice_TableName(t *testing.T) {
+	model := RadiusUserDevice{}
+	assert.Equal(t, "radius_user_device", model.TableName())
+}
+
+func TestRadiusLockout_TableName(t *testing.T) {
+	model := RadiusLockout{}
+	assert.Equal(t, "radius_lockout", model.TableName())
+}
+
 func TestRadiusOnline_TableName(t *testing.T) {
 	model := RadiusOnline{}
 	assert.Equal(t, "radius_online", model.TableName())
@@ -51,6 +66,21 @@ func TestRadiusAccounting_TableName(t *testing.T) {
 	assert.Equal(t, "radius_accounting", model.TableName())
 }
 
+func TestSysChangeEvent_TableName(t *testing.T) {
+	model := SysChangeEvent{}
+	assert.Equal(t, "sys_change_event", model.TableName())
+}
+
+func TestSchemaVersion_TableName(t *testing.T) {
+	model := SchemaVersion{}
+	assert.Equal(t, "schema_version", model.TableName())
+}
+
+func TestRadiusAuthLog_TableName(t *testing.T) {
+	model := RadiusAuthLog{}
+	assert.Equal(t, "radius_auth_log", model.TableName())
+}
+
 // TestAllModelsHaveTableName ensures every model listed in Tables implements TableName
 func TestAllModelsHaveTableName(t *testing.T) {
 	type tableNamer interface {
@@ -83,15 +113,28 @@ func TestTableNameUniqueness(t *testing.T) {
 
 	// Ensure all table names follow snake_case
 	expectedNames := map[string]bool{
-		"sys_config":        true,
-		"sys_opr":           true,
-		"sys_opr_log":       true,
-		"net_node":          true,
-		"net_nas":           true,
-		"radius_profile":    true,
-		"radius_user":       true,
-		"radius_online":     true,
-		"radius_accounting": true,
+		"sys_config":         true,
+		"sys_opr":            true,
+		"sys_opr_log":        true,
+		"sys_change_event":   true,
+		"schema_version":     true,
+		"net_node":           true,
+		"net_nas":            true,
+		"radius_profile":     true,
+		"radius_user":        true,
+		"radius_user_device": true,
+		"radius_lockout":     true,
+		"radius_online":      true,
+		"radius_accounting":  true,
+		"radius_auth_log":    true,
+		"radius_policy_rule": true,
+		"voucher_batch":      true,
+		"voucher":            true,
+		"hotspot_profile":    true,
+		"hotspot_user":       true,
+		"pppoe_profile":      true,
+		"pppoe_user":         true,
+		"wallet_transaction": true,
 	}
 
 	assert.Equal(t, len(expectedNames), len(tableNames), "Table name count should match")
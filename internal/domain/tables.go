@@ -5,14 +5,20 @@ var Tables = []interface{}{
         &SysConfig{},
         &SysOpr{},
         &SysOprLog{},
+        &SysChangeEvent{},
+        &SchemaVersion{},
         // Network
         &NetNode{},
         &NetNas{},
         // Radius
         &RadiusAccounting{},
+        &RadiusAuthLog{},
         &RadiusOnline{},
         &RadiusProfile{},
         &RadiusUser{},
+        &RadiusUserDevice{},
+        &RadiusLockout{},
+        &PolicyRule{},
         // Voucher
         &VoucherBatch{},
         &Voucher{},
@@ -22,4 +28,6 @@ var Tables = []interface{}{
         // PPPoE
         &PppoeProfile{},
         &PppoeUser{},
+        // Billing
+        &WalletTransaction{},
 }
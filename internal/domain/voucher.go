@@ -50,6 +50,11 @@ type VoucherBatch struct {
         // If 0, the voucher uses the batch expire time.
         ValidDays int `json:"valid_days" form:"valid_days"`
 
+        // Amount is the wallet credit, in the smallest currency unit, granted when a
+        // voucher of this batch is redeemed as a wallet recharge.
+        // If 0, the price of the linked profile is credited instead.
+        Amount int64 `json:"amount" form:"amount"`
+
         // Prefix is the prefix for generated voucher codes.
         Prefix string `json:"prefix" gorm:"size:10" form:"prefix"`
 
@@ -11,6 +11,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
 	"go.uber.org/zap"
 	"layeh.com/radius"
+	"layeh.com/radius/rfc2866"
 )
 
 // HandleAccountingWithPlugins Use plugin system to handle accounting request
@@ -23,14 +24,13 @@ func (s *AcctService) HandleAccountingWithPlugins(
 	nasIP string,
 ) error {
 	// getAccounting-Status-Type
-	statusTypeAttr := r.Get(40) //nolint:staticcheck // Acct-Status-Type
-	if statusTypeAttr == nil {
+	if _, ok := r.Lookup(rfc2866.AcctStatusType_Type); !ok {
 		return fmt.Errorf("missing Acct-Status-Type attribute")
 	}
 
-	// The status type is already available from the RADIUS packet; extract it directly
+	// Acct-Status-Type is a 4-byte integer
 	// RFC 2866 value constants: Start=1, Stop=2, InterimUpdate=3, AccountingOn=7, AccountingOff=8
-	statusType := statusTypeAttr[0]
+	statusType := rfc2866.AcctStatusType_Get(r.Packet)
 
 	// Build the AccountingContext
 	acctCtx := &accounting.AccountingContext{
@@ -49,37 +49,41 @@ func (s *AcctService) HandleAccountingWithPlugins(
 		return fmt.Errorf("no accounting handlers registered")
 	}
 
-	// Iterate over handlers to find one that can handle this status type
+	// Run every handler that can handle this status type, in registration
+	// order, so hooks registered after the storage handlers see the request too
+	handled := false
 	for _, handler := range handlers {
-		if handler.CanHandle(acctCtx) {
-			err := handler.Handle(acctCtx)
-			if err != nil {
-				zap.L().Error("accounting handler failed",
-					zap.String("namespace", "radius"),
-					zap.String("handler", handler.Name()),
-					zap.String("username", username),
-					zap.Int("status_type", int(statusType)),
-					zap.Error(err),
-				)
-				return err
-			}
-
-			// Record metrics for successful handling
-			switch statusType {
-			case 1: // Start
-				zap.L().Info("radius accounting start",
-					zap.String("namespace", "radius"),
-					zap.String("metrics", app.MetricsRadiusOline),
-				)
-			case 2: // Stop
-				zap.L().Info("radius accounting stop",
-					zap.String("namespace", "radius"),
-					zap.String("metrics", app.MetricsRadiusOffline),
-				)
-			}
+		if !handler.CanHandle(acctCtx) {
+			continue
+		}
+		handled = true
+		if err := handler.Handle(acctCtx); err != nil {
+			zap.L().Error("accounting handler failed",
+				zap.String("namespace", "radius"),
+				zap.String("handler", handler.Name()),
+				zap.String("username", username),
+				zap.Int("status_type", int(statusType)),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
 
-			return nil
+	if handled {
+		// Record metrics for successful handling
+		switch statusType {
+		case rfc2866.AcctStatusType_Value_Start:
+			zap.L().Info("radius accounting start",
+				zap.String("namespace", "radius"),
+				zap.String("metrics", app.MetricsRadiusOline),
+			)
+		case rfc2866.AcctStatusType_Value_Stop:
+			zap.L().Info("radius accounting stop",
+				zap.String("namespace", "radius"),
+				zap.String("metrics", app.MetricsRadiusOffline),
+			)
 		}
+		return nil
 	}
 
 	return fmt.Errorf("no handler found for status type %d", statusType)
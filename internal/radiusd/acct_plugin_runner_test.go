@@ -2,15 +2,21 @@ package radiusd
 
 import (
 	"context"
+	"encoding/json"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"sync/atomic"
 	"testing"
 
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/accounting"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/accounting/handlers"
 	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
 	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
 	"layeh.com/radius/rfc2866"
 )
 
@@ -69,6 +75,35 @@ func TestHandleAccountingWithPluginsDispatchesToHandler(t *testing.T) {
 	}
 }
 
+func TestHandleAccountingWithPluginsRunsEveryMatchingHandler(t *testing.T) {
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+
+	var storeCalls, hookCalls, skippedCalls int32
+	registry.RegisterAccountingHandler(&mockAccountingHandler{name: "store", calls: &storeCalls, canHandle: true})
+	registry.RegisterAccountingHandler(&mockAccountingHandler{name: "other", calls: &skippedCalls, canHandle: false})
+	registry.RegisterAccountingHandler(&mockAccountingHandler{name: "hook", calls: &hookCalls, canHandle: true})
+
+	acctSvc := &AcctService{RadiusService: &RadiusService{}}
+	packet := radius.New(radius.CodeAccountingRequest, []byte("secret"))
+	_ = rfc2866.AcctStatusType_Set(packet, rfc2866.AcctStatusType_Value_InterimUpdate)
+	req := &radius.Request{
+		Packet:     packet,
+		RemoteAddr: &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1813},
+	}
+
+	err := acctSvc.HandleAccountingWithPlugins(context.Background(), req, &vendorparsers.VendorRequest{}, "alice", &domain.NetNas{}, "10.0.0.1")
+	if err != nil {
+		t.Fatalf("HandleAccountingWithPlugins returned error: %v", err)
+	}
+	if atomic.LoadInt32(&storeCalls) != 1 || atomic.LoadInt32(&hookCalls) != 1 {
+		t.Fatalf("expected both matching handlers to run once, got %d and %d", storeCalls, hookCalls)
+	}
+	if atomic.LoadInt32(&skippedCalls) != 0 {
+		t.Fatalf("expected non-matching handler to be skipped")
+	}
+}
+
 func TestHandleAccountingWithPluginsNoHandler(t *testing.T) {
 	registry.ResetForTest()
 	t.Cleanup(registry.ResetForTest)
@@ -93,3 +128,68 @@ func TestHandleAccountingWithPluginsNoHandler(t *testing.T) {
 		t.Fatalf("expected error when no handlers registered")
 	}
 }
+
+// hookConfig enables the HTTP hook with an accounting endpoint
+type hookConfig string
+
+func (c hookConfig) GetString(_, name string) string {
+	if name == "AcctURL" {
+		return string(c)
+	}
+	return ""
+}
+func (c hookConfig) GetBool(_, name string) bool { return name == "Enabled" }
+func (c hookConfig) GetInt64(_, _ string) int64  { return 0 }
+
+func TestHandleAccountingWithPluginsFiresHTTPHook(t *testing.T) {
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+
+	events := make(chan httphook.AcctEvent, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event httphook.AcctEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err == nil {
+			events <- event
+		}
+	}))
+	defer server.Close()
+	registry.RegisterAccountingHandler(handlers.NewHTTPHookHandler(httphook.NewClient(hookConfig(server.URL))))
+
+	acctSvc := &AcctService{RadiusService: &RadiusService{}}
+	for status, want := range map[rfc2866.AcctStatusType]string{
+		rfc2866.AcctStatusType_Value_Start:         httphook.EventStart,
+		rfc2866.AcctStatusType_Value_InterimUpdate: httphook.EventInterim,
+		rfc2866.AcctStatusType_Value_Stop:          httphook.EventStop,
+	} {
+		// Encode and parse the packet as received from a NAS
+		packet := radius.New(radius.CodeAccountingRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, "alice")
+		_ = rfc2866.AcctStatusType_Set(packet, status)
+		_ = rfc2866.AcctSessionID_SetString(packet, "s1")
+		raw, err := packet.Encode()
+		if err != nil {
+			t.Fatalf("encode packet: %v", err)
+		}
+		received, err := radius.Parse(raw, []byte("secret"))
+		if err != nil {
+			t.Fatalf("parse packet: %v", err)
+		}
+		req := &radius.Request{
+			Packet:     received,
+			RemoteAddr: &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1813},
+		}
+
+		err = acctSvc.HandleAccountingWithPlugins(context.Background(), req, &vendorparsers.VendorRequest{}, "alice", &domain.NetNas{}, "10.0.0.1")
+		if err != nil {
+			t.Fatalf("HandleAccountingWithPlugins returned error: %v", err)
+		}
+		select {
+		case event := <-events:
+			if event.Event != want || event.AcctSessionID != "s1" {
+				t.Fatalf("expected %s event for s1, got %+v", want, event)
+			}
+		default:
+			t.Fatalf("expected the hook to post a %s event", want)
+		}
+	}
+}
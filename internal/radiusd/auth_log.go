@@ -0,0 +1,101 @@
+package radiusd
+
+import (
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/microsoft"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// authLogWriter remembers the answer sent for a request, so the auth log
+// records what the NAS actually received
+type authLogWriter struct {
+	radius.ResponseWriter
+	response *radius.Packet
+}
+
+// Write sends the answer and remembers it
+func (w *authLogWriter) Write(packet *radius.Packet) error {
+	err := w.ResponseWriter.Write(packet)
+	if err == nil {
+		w.response = packet
+	}
+	return err
+}
+
+// recordAuthLog queues the auth log entry of an answered request. Only
+// accepts and rejects are recorded, err is the reason of a reject.
+func (s *AuthService) recordAuthLog(ctx *AuthPipelineContext, response *radius.Packet, err error) {
+	if response == nil || s.AuthLog == nil {
+		return
+	}
+
+	entry := domain.RadiusAuthLog{
+		Username:     ctx.Username,
+		NasId:        ctx.NasIdentifier,
+		NasAddr:      ctx.RemoteIP,
+		MacAddr:      ctx.CallingStationID,
+		AuthMethod:   authMethod(ctx),
+		ReplyMessage: rfc2865.ReplyMessage_GetString(response),
+	}
+	if entry.Username == "" {
+		entry.Username = rfc2865.UserName_GetString(ctx.Request.Packet)
+	}
+	if ctx.VendorRequest != nil && ctx.VendorRequest.MacAddr != "" {
+		entry.MacAddr = ctx.VendorRequest.MacAddr
+	}
+
+	switch response.Code {
+	case radius.CodeAccessAccept:
+		entry.Result = domain.AuthResultAccept
+	case radius.CodeAccessReject:
+		entry.Result = domain.AuthResultReject
+		entry.Stage = ctx.FailedStage
+		entry.MetricsType = app.MetricsRadiusAuthDrop
+		if err == nil {
+			err = ctx.RejectErr
+		}
+		if radiusErr, ok := radiuserrors.GetRadiusError(err); ok {
+			entry.MetricsType = radiusErr.MetricsKey()
+			if radiusErr.Stage() != "" {
+				entry.Stage = radiusErr.Stage()
+			}
+		}
+		if entry.ReplyMessage == "" && err != nil {
+			entry.ReplyMessage = err.Error()
+		}
+	default:
+		return
+	}
+	if len(entry.ReplyMessage) > 255 {
+		entry.ReplyMessage = entry.ReplyMessage[:255]
+	}
+
+	s.AuthLog.Record(entry)
+}
+
+// authMethod names how the request authenticates: the EAP method, mac for
+// MAC authentication or the password scheme of the request
+func authMethod(ctx *AuthPipelineContext) string {
+	packet := ctx.Request.Packet
+	switch {
+	case ctx.IsEAP:
+		if ctx.EAPMethod != "" {
+			return ctx.EAPMethod
+		}
+		return "eap"
+	case ctx.IsMacAuth:
+		return "mac"
+	case rfc2865.CHAPPassword_Get(packet) != nil:
+		return "chap"
+	case microsoft.MSCHAP2Response_Get(packet) != nil:
+		return "mschapv2"
+	case microsoft.MSCHAPResponse_Get(packet) != nil:
+		return "mschap"
+	case packet.Get(rfc2865.UserPassword_Type) != nil:
+		return "pap"
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package radiusd
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestAuthLog(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+	reRegisterVendorParsers()
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
+	authService := NewAuthService(radiusService)
+	db := appCtx.DB()
+
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Identifier: "nas-1", Ipaddr: "10.0.0.1", Secret: "secret", VendorCode: "0", Status: common.ENABLED}
+	require.NoError(t, db.Create(nas).Error)
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "alice", Password: "password", Status: common.ENABLED, ExpireTime: time.Now().Add(time.Hour)}
+	require.NoError(t, db.Create(user).Error)
+
+	serve := func(password string) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, "alice")                     //nolint:errcheck
+		_ = rfc2865.UserPassword_SetString(packet, password)                //nolint:errcheck
+		_ = rfc2865.NASIdentifier_SetString(packet, "nas-1")                //nolint:errcheck
+		_ = rfc2865.CallingStationID_SetString(packet, "aa:bb:cc:00:00:01") //nolint:errcheck
+		authService.ServeRADIUS(&recordingWriter{}, &radius.Request{
+			LocalAddr:  &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1812},
+			RemoteAddr: &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 40000},
+			Packet:     packet,
+		})
+	}
+
+	serve("wrong")
+	serve("password")
+	radiusService.AuthLog.Flush()
+
+	var entries []domain.RadiusAuthLog
+	require.NoError(t, db.Order("auth_time").Find(&entries).Error)
+	require.Len(t, entries, 2)
+
+	reject := entries[0]
+	assert.Equal(t, domain.AuthResultReject, reject.Result)
+	assert.Equal(t, "alice", reject.Username)
+	assert.Equal(t, "10.0.0.1", reject.NasAddr)
+	assert.Equal(t, "nas-1", reject.NasId)
+	assert.Equal(t, "aa:bb:cc:00:00:01", reject.MacAddr)
+	assert.Equal(t, "pap", reject.AuthMethod)
+	assert.Equal(t, StagePluginAuth, reject.Stage)
+	assert.Equal(t, app.MetricsRadiusRejectPasswdError, reject.MetricsType)
+	assert.NotEmpty(t, reject.ReplyMessage)
+
+	accept := entries[1]
+	assert.Equal(t, domain.AuthResultAccept, accept.Result)
+	assert.Empty(t, accept.Stage)
+	assert.Empty(t, accept.MetricsType)
+
+	// 0 days disables the log
+	require.NoError(t, appCtx.ConfigMgr().Set("radius", "AuthLogHistoryDays", "0"))
+	serve("password")
+	radiusService.AuthLog.Flush()
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusAuthLog{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+}
@@ -122,6 +122,7 @@ func (p *AuthPipeline) Execute(ctx *AuthPipelineContext) error {
 			break
 		}
 		if err := stage.Execute(ctx); err != nil {
+			ctx.FailedStage = stage.Name()
 			return fmt.Errorf("stage %s failed: %w", stage.Name(), err)
 		}
 	}
@@ -160,8 +161,28 @@ type AuthPipelineContext struct {
 	EAPMethod        string
 	IsMacAuth        bool
 	RateLimitChecked bool
+	PasswordVerified bool // The password was already checked, e.g. by the two-factor stage
 
-	stop bool
+	// Disconnects are the online sessions the checkers want ended, they are
+	// disconnected once the Access-Accept is sent
+	Disconnects []domain.RadiusOnline
+
+	// FailedStage is the stage that returned an error. A stage sending the
+	// reject itself, e.g. an EAP failure, sets it and RejectErr instead of
+	// returning the error.
+	FailedStage string
+	RejectErr   error
+
+	// DryRun marks a simulated request: stages must not change any state or
+	// call external systems, and may explain themselves with Note. Writer
+	// only records the answer.
+	DryRun bool
+
+	// storedUser is the user as loaded, before the policy rules changed it
+	storedUser *domain.RadiusUser
+
+	notes []string
+	stop  bool
 }
 
 // NewAuthPipelineContext builds a context with sane defaults.
@@ -181,6 +202,36 @@ func (ctx *AuthPipelineContext) Stop() {
 	ctx.stop = true
 }
 
+// Note records what a stage did or would have done for a simulated request,
+// it does nothing for real requests.
+func (ctx *AuthPipelineContext) Note(format string, args ...interface{}) {
+	if ctx.DryRun {
+		ctx.notes = append(ctx.notes, fmt.Sprintf(format, args...))
+	}
+}
+
+// takeNotes returns and clears the recorded notes
+func (ctx *AuthPipelineContext) takeNotes() []string {
+	notes := ctx.notes
+	ctx.notes = nil
+	return notes
+}
+
+// collectDisconnects stores the sessions the plugin checks want ended on ctx
+func (ctx *AuthPipelineContext) collectDisconnects() AuthPluginOption {
+	return CollectDisconnects(func(sessions []domain.RadiusOnline) {
+		ctx.Disconnects = sessions
+	})
+}
+
+// loadedUser returns the user as stored, without the policy overrides
+func (ctx *AuthPipelineContext) loadedUser() *domain.RadiusUser {
+	if ctx.storedUser != nil {
+		return ctx.storedUser
+	}
+	return ctx.User
+}
+
 // IsStopped reports whether execution has been halted.
 func (ctx *AuthPipelineContext) IsStopped() bool {
 	return ctx.stop
@@ -14,6 +14,18 @@ import (
 // authPluginOptions defines optional settings for authentication plugins
 type authPluginOptions struct {
 	skipPasswordValidation bool
+	dryRun                 bool
+	trace                  func(PluginTrace)
+	disconnects            func([]domain.RadiusOnline)
+}
+
+// PluginTrace is the result of one password validator or policy checker
+type PluginTrace struct {
+	Kind  string // "validator" or "checker"
+	Name  string
+	Order int
+	Err   error
+	Notes []string // What the plugin did or would have done in a dry run
 }
 
 // AuthPluginOption defines an option function for authentication plugins
@@ -26,6 +38,31 @@ func SkipPasswordValidation() AuthPluginOption {
 	}
 }
 
+// DryRun marks the authentication as simulated, plugins must not change any
+// state or call external systems
+func DryRun() AuthPluginOption {
+	return func(opts *authPluginOptions) {
+		opts.dryRun = true
+	}
+}
+
+// CollectDisconnects hands the online sessions the checkers want ended to fn
+// once every check passed. They are to be disconnected after the
+// Access-Accept is sent.
+func CollectDisconnects(fn func([]domain.RadiusOnline)) AuthPluginOption {
+	return func(opts *authPluginOptions) {
+		opts.disconnects = fn
+	}
+}
+
+// TracePlugins reports the result of the password validator and of every
+// policy checker run to fn
+func TracePlugins(fn func(PluginTrace)) AuthPluginOption {
+	return func(opts *authPluginOptions) {
+		opts.trace = fn
+	}
+}
+
 // AuthenticateUserWithPlugins uses the plugin system to authenticate a user
 func (s *AuthService) AuthenticateUserWithPlugins(
 	ctx context.Context,
@@ -57,6 +94,9 @@ func (s *AuthService) AuthenticateUserWithPlugins(
 			"profile_cache": s.AppContext().ProfileCache(), // Add profile cache for dynamic attribute resolution
 		},
 	}
+	if options.dryRun {
+		authCtx.Metadata[auth.MetadataDryRun] = true
+	}
 
 	var password string
 	var err error
@@ -68,18 +108,20 @@ func (s *AuthService) AuthenticateUserWithPlugins(
 			return errors.WrapError("radus_reject_passwd_error", err)
 		}
 
-		if err := s.validatePasswordWithPlugins(ctx, authCtx, password); err != nil {
+		if err := s.validatePasswordWithPlugins(ctx, authCtx, password, options.trace); err != nil {
 			return err
 		}
 	}
 
-	// 2. Perform profile checks via plugins
-	if !isMacAuth {
-		if err := s.checkPoliciesWithPlugins(ctx, authCtx); err != nil {
-			return err
-		}
+	// 2. Perform profile checks via plugins, MAC authentication only runs
+	// the checkers applying to it
+	if err := s.checkPoliciesWithPlugins(ctx, authCtx, options.trace); err != nil {
+		return err
 	}
 
+	if options.disconnects != nil {
+		options.disconnects(auth.TakeDisconnects(authCtx))
+	}
 	return nil
 }
 
@@ -88,6 +130,7 @@ func (s *AuthService) validatePasswordWithPlugins(
 	ctx context.Context,
 	authCtx *auth.AuthContext,
 	password string,
+	trace func(PluginTrace),
 ) error {
 	// Get all registered password validators
 	validators := registry.GetPasswordValidators()
@@ -95,25 +138,43 @@ func (s *AuthService) validatePasswordWithPlugins(
 	// Iterate over validators to find one that can handle the current request
 	for _, validator := range validators {
 		if validator.CanHandle(authCtx) {
-			return validator.Validate(ctx, authCtx, password)
+			err := validator.Validate(ctx, authCtx, password)
+			if trace != nil {
+				trace(PluginTrace{Kind: "validator", Name: validator.Name(), Err: err, Notes: auth.TakeNotes(authCtx)})
+			}
+			return err
 		}
 	}
 
 	// Return an error if no suitable validator is found
-	return errors.NewAuthError("radus_reject_other", "no suitable password validator found")
+	err := errors.NewAuthError("radus_reject_other", "no suitable password validator found")
+	if trace != nil {
+		trace(PluginTrace{Kind: "validator", Err: err})
+	}
+	return err
 }
 
 // checkPoliciesWithPlugins uses profile checker plugins
 func (s *AuthService) checkPoliciesWithPlugins(
 	ctx context.Context,
 	authCtx *auth.AuthContext,
+	trace func(PluginTrace),
 ) error {
 	// Get all registered profile checkers (already sorted by order)
 	checkers := registry.GetPolicyCheckers()
 
 	// Execute all profile checkers in order
 	for _, checker := range checkers {
-		if err := checker.Check(ctx, authCtx); err != nil {
+		if authCtx.IsMacAuth {
+			if macChecker, ok := checker.(auth.MacAuthPolicyChecker); !ok || !macChecker.AppliesToMacAuth() {
+				continue
+			}
+		}
+		err := checker.Check(ctx, authCtx)
+		if trace != nil {
+			trace(PluginTrace{Kind: "checker", Name: checker.Name(), Order: checker.Order(), Err: err, Notes: auth.TakeNotes(authCtx)})
+		}
+		if err != nil {
 			return err
 		}
 	}
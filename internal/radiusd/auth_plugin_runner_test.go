@@ -2,6 +2,7 @@ package radiusd
 
 import (
 	"context"
+	"errors"
 	"net"
 	"sync/atomic"
 	"testing"
@@ -41,6 +42,27 @@ func (c *mockChecker) Check(ctx context.Context, authCtx *auth.AuthContext) erro
 }
 func (c *mockChecker) Order() int { return c.order }
 
+// macAuthChecker is a mockChecker also running for MAC authentication
+type macAuthChecker struct {
+	mockChecker
+}
+
+func (c *macAuthChecker) AppliesToMacAuth() bool { return true }
+
+// disconnectChecker asks to disconnect session, or rejects when err is set
+type disconnectChecker struct {
+	order   int
+	session domain.RadiusOnline
+	err     error
+}
+
+func (c *disconnectChecker) Name() string { return "disconnect" }
+func (c *disconnectChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	auth.RequestDisconnect(authCtx, c.session)
+	return c.err
+}
+func (c *disconnectChecker) Order() int { return c.order }
+
 // mockAppContext implements app.AppContext for testing
 type mockAuthAppContext struct {
 	mockAppContext
@@ -132,3 +154,80 @@ func TestAuthenticateUserWithPluginsSkipsValidatorWhenOptionUsed(t *testing.T) {
 		t.Fatalf("expected validator skipped, got %d calls", got)
 	}
 }
+
+func TestAuthenticateUserWithPluginsRunsMacAuthCheckers(t *testing.T) {
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+
+	var validatorCalls, checkerCalls, macCheckerCalls int32
+	registry.RegisterPasswordValidator(&mockValidator{name: "validator", calls: &validatorCalls, handle: true})
+	registry.RegisterPolicyChecker(&mockChecker{name: "checker", order: 1, calls: &checkerCalls})
+	registry.RegisterPolicyChecker(&macAuthChecker{mockChecker{name: "mac", order: 2, calls: &macCheckerCalls}})
+
+	authSvc := newTestAuthService()
+	user := &domain.RadiusUser{Username: "00:11:22:33:44:55", MacAddr: "00:11:22:33:44:55"}
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	req := &radius.Request{
+		Packet:     packet,
+		RemoteAddr: &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1812},
+	}
+
+	err := authSvc.AuthenticateUserWithPlugins(context.Background(), req, packet.Response(radius.CodeAccessAccept),
+		user, &domain.NetNas{Identifier: "NAS-1"}, &vendorparsers.VendorRequest{}, true)
+	if err != nil {
+		t.Fatalf("AuthenticateUserWithPlugins returned error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&validatorCalls); got != 0 {
+		t.Fatalf("expected validator skipped, got %d calls", got)
+	}
+	if got := atomic.LoadInt32(&checkerCalls); got != 0 {
+		t.Fatalf("expected checker skipped, got %d calls", got)
+	}
+	if got := atomic.LoadInt32(&macCheckerCalls); got != 1 {
+		t.Fatalf("expected mac auth checker called once, got %d", got)
+	}
+}
+
+func TestAuthenticateUserWithPluginsCollectsDisconnectsOnSuccess(t *testing.T) {
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+
+	registry.RegisterPolicyChecker(&disconnectChecker{order: 1, session: domain.RadiusOnline{AcctSessionId: "s1"}})
+
+	authSvc := newTestAuthService()
+	user := &domain.RadiusUser{Username: "carol"}
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	req := &radius.Request{
+		Packet:     packet,
+		RemoteAddr: &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1812},
+	}
+	authenticate := func() ([]domain.RadiusOnline, bool, error) {
+		var sessions []domain.RadiusOnline
+		collected := false
+		err := authSvc.AuthenticateUserWithPlugins(context.Background(), req, packet.Response(radius.CodeAccessAccept),
+			user, &domain.NetNas{}, &vendorparsers.VendorRequest{}, false, SkipPasswordValidation(),
+			CollectDisconnects(func(s []domain.RadiusOnline) {
+				sessions, collected = s, true
+			}))
+		return sessions, collected, err
+	}
+
+	sessions, collected, err := authenticate()
+	if err != nil {
+		t.Fatalf("AuthenticateUserWithPlugins returned error: %v", err)
+	}
+	if !collected || len(sessions) != 1 || sessions[0].AcctSessionId != "s1" {
+		t.Fatalf("expected session s1 to be collected, got %v", sessions)
+	}
+
+	// A later rejecting check keeps every session online
+	registry.RegisterPolicyChecker(&disconnectChecker{order: 2, err: errors.New("rejected")})
+	_, collected, err = authenticate()
+	if err == nil {
+		t.Fatal("expected the rejecting check to fail authentication")
+	}
+	if collected {
+		t.Fatal("expected no disconnects for a rejected login")
+	}
+}
@@ -20,6 +20,7 @@ const (
 	StageRateLimit       = "auth_rate_limit"
 	StageVendorParsing   = "vendor_parsing"
 	StageLoadUser        = "load_user"
+	StageTOTP            = "totp"
 	StageEAPDispatch     = "eap_dispatch"
 	StagePluginAuth      = "plugin_auth"
 )
@@ -31,6 +32,7 @@ func (s *AuthService) registerDefaultStages() {
 		newStage(StageRateLimit, s.stageRateLimit),
 		newStage(StageVendorParsing, s.stageVendorParsing),
 		newStage(StageLoadUser, s.stageLoadUser),
+		newStage(StageTOTP, s.stageTOTP),
 		newStage(StageEAPDispatch, s.stageEAPDispatch),
 		newStage(StagePluginAuth, s.stagePluginAuth),
 	}
@@ -38,6 +40,16 @@ func (s *AuthService) registerDefaultStages() {
 	for _, stage := range stages {
 		s.authPipeline.Use(stage)
 	}
+
+	if err := s.authPipeline.InsertAfter(StageVendorParsing, newStage(StageLockout, s.stageLockout)); err != nil {
+		zap.L().Error("register lockout stage failed", zap.String("namespace", "radius"), zap.Error(err))
+	}
+	if err := s.authPipeline.InsertAfter(StageLoadUser, newStage(StagePolicy, s.stagePolicy)); err != nil {
+		zap.L().Error("register policy stage failed", zap.String("namespace", "radius"), zap.Error(err))
+	}
+	if err := s.authPipeline.InsertAfter(StageTOTP, newStage(StageLDAP, s.stageLDAP)); err != nil {
+		zap.L().Error("register ldap stage failed", zap.String("namespace", "radius"), zap.Error(err))
+	}
 }
 
 func (s *AuthService) stageRequestMetadata(ctx *AuthPipelineContext) error {
@@ -93,6 +105,10 @@ func (s *AuthService) stageRateLimit(ctx *AuthPipelineContext) error {
 	if ctx.IsEAP {
 		return nil
 	}
+	if ctx.DryRun {
+		ctx.Note("rate limit not checked")
+		return nil
+	}
 	if err := s.CheckAuthRateLimit(ctx.Username); err != nil {
 		return err
 	}
@@ -110,15 +126,29 @@ func (s *AuthService) stageVendorParsing(ctx *AuthPipelineContext) error {
 	ctx.IsMacAuth = vendorReq.MacAddr != "" && vendorReq.MacAddr == ctx.Username
 
 	ctx.VendorRequestForPlugin = &vendorparsers.VendorRequest{
-		MacAddr: vendorReq.MacAddr,
-		Vlanid1: vendorReq.Vlanid1,
-		Vlanid2: vendorReq.Vlanid2,
+		MacAddr:   vendorReq.MacAddr,
+		Vlanid1:   vendorReq.Vlanid1,
+		Vlanid2:   vendorReq.Vlanid2,
+		Ssid:      vendorReq.Ssid,
+		ApGroup:   vendorReq.ApGroup,
+		HostIP:    vendorReq.HostIP,
+		Realm:     vendorReq.Realm,
+		CircuitId: vendorReq.CircuitId,
+		RemoteId:  vendorReq.RemoteId,
 	}
 	return nil
 }
 
 func (s *AuthService) stageLoadUser(ctx *AuthPipelineContext) error {
 	user, err := s.GetValidUser(ctx.Username, ctx.IsMacAuth)
+	if err != nil && !ctx.IsMacAuth && isUserNotExists(err) && s.ldapDirectory.Enabled() {
+		if ctx.DryRun {
+			ctx.Note("LDAP bind not attempted in simulation, the user would be looked up and provisioned from the directory")
+			return err
+		}
+		user, err = s.provisionLDAPUser(ctx.Context, ctx.Request, ctx.Username)
+		ctx.PasswordVerified = err == nil
+	}
 	if err != nil {
 		return err
 	}
@@ -130,6 +160,10 @@ func (s *AuthService) stageEAPDispatch(ctx *AuthPipelineContext) error {
 	if !ctx.IsEAP || s.eapHelper == nil {
 		return nil
 	}
+	if ctx.DryRun {
+		ctx.Note("%s exchange not simulated, the password is checked as pap", ctx.EAPMethod)
+		return nil
+	}
 
 	handled, success, eapErr := s.eapHelper.HandleEAPAuthentication(
 		ctx.Writer,
@@ -148,16 +182,19 @@ func (s *AuthService) stageEAPDispatch(ctx *AuthPipelineContext) error {
 		)
 		_ = s.eapHelper.SendEAPFailure(ctx.Writer, ctx.Request, ctx.NAS.Secret, eapErr)
 		s.eapHelper.CleanupState(ctx.Request)
+		s.recordLockoutFailure(ctx, eapErr)
+		ctx.FailedStage, ctx.RejectErr = StageEAPDispatch, eapErr
 		ctx.Stop()
 		return nil
 	}
 
 	if handled {
 		if success {
-			err := s.AuthenticateUserWithPlugins(ctx.Context, ctx.Request, ctx.Response, ctx.User, ctx.NAS, ctx.VendorRequestForPlugin, ctx.IsMacAuth, SkipPasswordValidation())
+			err := s.AuthenticateUserWithPlugins(ctx.Context, ctx.Request, ctx.Response, ctx.User, ctx.NAS, ctx.VendorRequestForPlugin, ctx.IsMacAuth, SkipPasswordValidation(), ctx.collectDisconnects())
 			if err != nil {
 				_ = s.eapHelper.SendEAPFailure(ctx.Writer, ctx.Request, ctx.NAS.Secret, err)
 				s.eapHelper.CleanupState(ctx.Request)
+				ctx.FailedStage, ctx.RejectErr = StageEAPDispatch, err
 				ctx.Stop()
 				return nil
 			}
@@ -174,7 +211,11 @@ func (s *AuthService) stagePluginAuth(ctx *AuthPipelineContext) error {
 		return nil
 	}
 
-	err := s.AuthenticateUserWithPlugins(ctx.Context, ctx.Request, ctx.Response, ctx.User, ctx.NAS, ctx.VendorRequestForPlugin, ctx.IsMacAuth)
+	opts := []AuthPluginOption{ctx.collectDisconnects()}
+	if ctx.PasswordVerified {
+		opts = append(opts, SkipPasswordValidation())
+	}
+	err := s.AuthenticateUserWithPlugins(ctx.Context, ctx.Request, ctx.Response, ctx.User, ctx.NAS, ctx.VendorRequestForPlugin, ctx.IsMacAuth, opts...)
 	if err != nil {
 		return err
 	}
@@ -221,6 +262,8 @@ func (s *AuthService) sendAcceptResponse(ctx *AuthPipelineContext, isEapFlow boo
 		s.UpdateBind(ctx.User, vendorReq)
 		s.UpdateUserLastOnline(ctx.User.Username)
 	}
+	s.recordLockoutSuccess(ctx)
+	s.disconnectSessions(ctx.Disconnects)
 
 	zap.L().Info("radius auth success",
 		zap.String("namespace", "radius"),
@@ -0,0 +1,184 @@
+// Package authlog persists the outcome of every Access-Accept and
+// Access-Reject to the radius_auth_log table. Entries are queued and written
+// in batches so a burst of logins costs few database round trips. The queue
+// is bounded: when the database cannot keep up entries are dropped rather
+// than slowing the authentication down. Logging is enabled and retained
+// through the radius.AuthLogHistoryDays setting.
+package authlog
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// BatchSize is the most entries written by one insert
+	BatchSize = 200
+	// FlushInterval bounds how long an entry waits in the queue
+	FlushInterval = 2 * time.Second
+
+	queueSize = 8192
+)
+
+// ConfigGetter reads the radius.AuthLogHistoryDays setting, implemented by
+// *app.ConfigManager
+type ConfigGetter interface {
+	GetInt64(category, name string) int64
+}
+
+// RetentionDays returns how many days entries are kept, 0 when
+// authentications are not logged
+func RetentionDays(cfg ConfigGetter) int64 {
+	if cfg == nil {
+		return 0
+	}
+	days := cfg.GetInt64("radius", "AuthLogHistoryDays")
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// Writer queues entries and writes them in batches from one goroutine
+type Writer struct {
+	db      *gorm.DB
+	cfg     ConfigGetter
+	queue   chan domain.RadiusAuthLog
+	flushC  chan chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+	dropped atomic.Int64
+}
+
+// NewWriter starts a writer. It logs nothing without a database or while
+// the retention setting is 0.
+func NewWriter(db *gorm.DB, cfg ConfigGetter) *Writer {
+	w := &Writer{
+		db:      db,
+		cfg:     cfg,
+		queue:   make(chan domain.RadiusAuthLog, queueSize),
+		flushC:  make(chan chan struct{}),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// Record queues an entry without blocking. The ID and time are filled in
+// when missing. A nil writer ignores it.
+func (w *Writer) Record(entry domain.RadiusAuthLog) {
+	if w == nil || w.db == nil || RetentionDays(w.cfg) == 0 {
+		return
+	}
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+	if entry.ID == 0 {
+		entry.ID = common.UUIDint64()
+	}
+	if entry.AuthTime.IsZero() {
+		entry.AuthTime = time.Now()
+	}
+	select {
+	case w.queue <- entry:
+	default:
+		w.dropped.Add(1)
+	}
+}
+
+// Dropped returns the entries lost because the queue was full
+func (w *Writer) Dropped() int64 {
+	return w.dropped.Load()
+}
+
+// Flush writes the queued entries and waits until they are stored
+func (w *Writer) Flush() {
+	if w == nil {
+		return
+	}
+	done := make(chan struct{})
+	select {
+	case w.flushC <- done:
+		<-done
+	case <-w.stopped:
+	}
+}
+
+// Close writes the queued entries and stops the writer
+func (w *Writer) Close() {
+	if w == nil {
+		return
+	}
+	w.once.Do(func() {
+		close(w.done)
+	})
+	<-w.stopped
+}
+
+func (w *Writer) run() {
+	defer close(w.stopped)
+
+	ticker := time.NewTicker(FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]domain.RadiusAuthLog, 0, BatchSize)
+	write := func() {
+		if len(batch) == 0 {
+			return
+		}
+		w.write(batch)
+		batch = batch[:0]
+	}
+	drain := func() {
+		for {
+			select {
+			case entry := <-w.queue:
+				batch = append(batch, entry)
+				if len(batch) >= BatchSize {
+					write()
+				}
+			default:
+				write()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case entry := <-w.queue:
+			batch = append(batch, entry)
+			if len(batch) >= BatchSize {
+				write()
+			}
+		case <-ticker.C:
+			write()
+		case done := <-w.flushC:
+			drain()
+			close(done)
+		case <-w.done:
+			drain()
+			return
+		}
+	}
+}
+
+func (w *Writer) write(batch []domain.RadiusAuthLog) {
+	if err := w.db.CreateInBatches(batch, BatchSize).Error; err != nil {
+		zap.L().Error("write radius auth log failed",
+			zap.String("namespace", "radius"),
+			zap.Int("entries", len(batch)),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,73 @@
+package authlog
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+)
+
+type stubConfig map[string]int64
+
+func (c stubConfig) GetInt64(_, name string) int64 { return c[name] }
+
+func setupDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.RadiusAuthLog{}))
+	return db
+}
+
+func TestRetentionDays(t *testing.T) {
+	assert.Equal(t, int64(0), RetentionDays(nil))
+	assert.Equal(t, int64(0), RetentionDays(stubConfig{"AuthLogHistoryDays": -1}))
+	assert.Equal(t, int64(30), RetentionDays(stubConfig{"AuthLogHistoryDays": 30}))
+}
+
+func TestWriter(t *testing.T) {
+	db := setupDB(t)
+	w := NewWriter(db, stubConfig{"AuthLogHistoryDays": 30})
+
+	for i := 0; i < BatchSize+10; i++ {
+		w.Record(domain.RadiusAuthLog{Username: "alice", Result: domain.AuthResultReject})
+	}
+	w.Record(domain.RadiusAuthLog{Username: "bob", Result: domain.AuthResultAccept})
+	w.Flush()
+
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusAuthLog{}).Count(&count).Error)
+	assert.Equal(t, int64(BatchSize+11), count)
+
+	var entry domain.RadiusAuthLog
+	require.NoError(t, db.Where("username = ?", "bob").First(&entry).Error)
+	assert.NotZero(t, entry.ID)
+	assert.False(t, entry.AuthTime.IsZero())
+
+	// Queued entries are written on close, later ones are ignored
+	w.Record(domain.RadiusAuthLog{Username: "carol"})
+	w.Close()
+	w.Record(domain.RadiusAuthLog{Username: "dave"})
+	w.Flush()
+	require.NoError(t, db.Model(&domain.RadiusAuthLog{}).Count(&count).Error)
+	assert.Equal(t, int64(BatchSize+12), count)
+	assert.Zero(t, w.Dropped())
+}
+
+func TestWriterDisabled(t *testing.T) {
+	db := setupDB(t)
+	w := NewWriter(db, stubConfig{})
+	defer w.Close()
+
+	w.Record(domain.RadiusAuthLog{Username: "alice"})
+	w.Flush()
+
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusAuthLog{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	var nilWriter *Writer
+	nilWriter.Record(domain.RadiusAuthLog{Username: "alice"})
+}
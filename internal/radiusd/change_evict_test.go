@@ -0,0 +1,54 @@
+package radiusd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+func TestChangeEventsEvictCaches(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	db := appCtx.DB()
+	bus := appCtx.ChangeBus()
+	require.NotNil(t, bus)
+
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "alice", Password: "password", MacAddr: "aa:bb:cc:00:00:01",
+		Status: common.ENABLED, ExpireTime: time.Now().Add(time.Hour)}
+	require.NoError(t, db.Create(user).Error)
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Identifier: "nas-1", Ipaddr: "10.0.0.1", Secret: "secret", VendorCode: "0", Status: common.ENABLED}
+	require.NoError(t, db.Create(nas).Error)
+
+	_, err := radiusService.GetValidUser("alice", false)
+	require.NoError(t, err)
+	_, err = radiusService.GetValidUser("aa:bb:cc:00:00:01", true)
+	require.NoError(t, err)
+	_, err = radiusService.GetNas("10.0.0.1", "nas-1")
+	require.NoError(t, err)
+
+	require.NoError(t, db.Model(user).Update("status", common.DISABLED).Error)
+	require.NoError(t, db.Model(nas).Update("secret", "changed").Error)
+
+	// Without an event the caches still serve the old entries
+	_, err = radiusService.GetValidUser("alice", false)
+	require.NoError(t, err)
+
+	bus.Publish(app.ChangeEvent{Kind: app.ChangeUser, ID: user.ID, Keys: []string{user.Username, user.MacAddr}})
+	_, err = radiusService.GetValidUser("alice", false)
+	assert.Error(t, err)
+	_, err = radiusService.GetValidUser("aa:bb:cc:00:00:01", true)
+	assert.Error(t, err)
+
+	bus.Publish(app.ChangeEvent{Kind: app.ChangeNas, ID: nas.ID})
+	cached, err := radiusService.GetNas("10.0.0.1", "nas-1")
+	require.NoError(t, err)
+	assert.Equal(t, "changed", cached.Secret)
+}
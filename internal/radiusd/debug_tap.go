@@ -0,0 +1,75 @@
+package radiusd
+
+import (
+	"net"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/debugstream"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// debugTap copies a request watched by a debug session, its answers and its
+// errors to the debug streams
+type debugTap struct {
+	radius.ResponseWriter
+	hub     *debugstream.Hub
+	service string
+	request *radius.Request
+	subject debugstream.Subject
+}
+
+// newDebugTap publishes r when a debug session watches it and returns the
+// tap wrapping w, nil otherwise
+func newDebugTap(service string, w radius.ResponseWriter, r *radius.Request) *debugTap {
+	hub := debugstream.Default()
+	subject := debugstream.Subject{
+		Username: rfc2865.UserName_GetString(r.Packet),
+		MacAddr:  rfc2865.CallingStationID_GetString(r.Packet),
+	}
+	if r.RemoteAddr != nil {
+		subject.NasIP = r.RemoteAddr.String()
+		if host, _, err := net.SplitHostPort(subject.NasIP); err == nil {
+			subject.NasIP = host
+		}
+	}
+	if !hub.Watching(subject) {
+		return nil
+	}
+
+	tap := &debugTap{ResponseWriter: w, hub: hub, service: service, request: r, subject: subject}
+	hub.Publish(subject, debugstream.Event{
+		Kind:    debugstream.KindRequest,
+		Service: service,
+		Code:    r.Code.String(),
+		Text:    FmtRequest(r),
+	})
+	return tap
+}
+
+// Write sends the answer and publishes it
+func (t *debugTap) Write(packet *radius.Packet) error {
+	err := t.ResponseWriter.Write(packet)
+	event := debugstream.Event{
+		Kind:    debugstream.KindResponse,
+		Service: t.service,
+		Code:    packet.Code.String(),
+		Text:    FmtResponse(packet, t.request.RemoteAddr),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	t.hub.Publish(t.subject, event)
+	return err
+}
+
+// Error publishes a failure of the request, a nil tap ignores it
+func (t *debugTap) Error(err error) {
+	if t == nil || err == nil {
+		return
+	}
+	t.hub.Publish(t.subject, debugstream.Event{
+		Kind:    debugstream.KindError,
+		Service: t.service,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,72 @@
+package radiusd
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/debugstream"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestDebugTap(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Identifier: "nas-1", Ipaddr: "10.0.0.1", Secret: "secret", Status: common.ENABLED}
+	require.NoError(t, appCtx.DB().Create(nas).Error)
+
+	hub := debugstream.Default()
+	session, err := hub.Open(debugstream.Filter{Username: "nobody"}, time.Minute)
+	require.NoError(t, err)
+	defer hub.Close(session.ID)
+
+	serve := func(username string) *recordingWriter {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, username)    //nolint:errcheck
+		_ = rfc2865.UserPassword_SetString(packet, "wrong") //nolint:errcheck
+		writer := &recordingWriter{}
+		authService.ServeRADIUS(writer, &radius.Request{
+			LocalAddr:  &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1812},
+			RemoteAddr: &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 40000},
+			Packet:     packet,
+		})
+		return writer
+	}
+
+	// Other users are not copied
+	writer := serve("somebody")
+	require.Len(t, writer.packets, 1)
+	assert.Empty(t, session.Events())
+
+	writer = serve("nobody")
+	require.Len(t, writer.packets, 1)
+	assert.Equal(t, radius.CodeAccessReject, writer.packets[0].Code)
+
+	var kinds []string
+	for len(session.Events()) > 0 {
+		event := <-session.Events()
+		assert.Equal(t, "auth", event.Service)
+		assert.Equal(t, "nobody", event.Username)
+		assert.Equal(t, "10.0.0.1", event.NasIP)
+		kinds = append(kinds, event.Kind)
+		switch event.Kind {
+		case debugstream.KindRequest:
+			assert.Contains(t, event.Text, "nobody")
+		case debugstream.KindResponse:
+			assert.Equal(t, radius.CodeAccessReject.String(), event.Code)
+		case debugstream.KindError:
+			assert.NotEmpty(t, event.Error)
+		}
+	}
+	assert.Equal(t, []string{debugstream.KindRequest, debugstream.KindResponse, debugstream.KindError}, kinds)
+}
@@ -0,0 +1,275 @@
+// Package debugstream delivers the RADIUS packets and errors of selected
+// users, devices or NAS to operators watching them live. A debug session
+// selects the traffic with a filter and ends by itself after a time limit,
+// so a forgotten session does not keep copying traffic.
+package debugstream
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+const (
+	DefaultDuration = 5 * time.Minute  // Lifetime of a session opened without one
+	MaxDuration     = 30 * time.Minute // Longest lifetime of a session
+	MaxSessions     = 10               // Sessions open at the same time
+	bufferSize      = 256              // Events kept for a slow reader before dropping
+)
+
+// Event kinds
+const (
+	KindRequest  = "request"
+	KindResponse = "response"
+	KindError    = "error"
+)
+
+var (
+	ErrEmptyFilter     = errors.New("debug session filter is empty")
+	ErrTooManySessions = errors.New("too many debug sessions")
+)
+
+// Filter selects the traffic of a debug session. Every field set must match,
+// at least one must be set.
+type Filter struct {
+	Username string `json:"username,omitempty"`
+	MacAddr  string `json:"mac_addr,omitempty"`
+	NasIP    string `json:"nas_ip,omitempty"`
+}
+
+// Empty reports whether the filter would select all traffic
+func (f Filter) Empty() bool {
+	return f.Username == "" && f.MacAddr == "" && f.NasIP == ""
+}
+
+// Match reports whether the packets of subject are selected
+func (f Filter) Match(subject Subject) bool {
+	if f.Empty() {
+		return false
+	}
+	if f.Username != "" && !strings.EqualFold(f.Username, subject.Username) {
+		return false
+	}
+	if f.MacAddr != "" && normalizeMac(f.MacAddr) != normalizeMac(subject.MacAddr) {
+		return false
+	}
+	if f.NasIP != "" && f.NasIP != subject.NasIP {
+		return false
+	}
+	return true
+}
+
+// Subject identifies the user, device and NAS of a request
+type Subject struct {
+	Username string
+	MacAddr  string
+	NasIP    string
+}
+
+// Event is a packet or an error of a watched request
+type Event struct {
+	Time     time.Time `json:"time"`
+	Kind     string    `json:"kind"`
+	Service  string    `json:"service"` // auth or acct
+	Username string    `json:"username,omitempty"`
+	MacAddr  string    `json:"mac_addr,omitempty"`
+	NasIP    string    `json:"nas_ip,omitempty"`
+	Code     string    `json:"code,omitempty"` // Packet code
+	Text     string    `json:"text,omitempty"` // Decoded packet
+	Error    string    `json:"error,omitempty"`
+}
+
+// Session is an open debug session
+type Session struct {
+	ID        string    `json:"id"`
+	Filter    Filter    `json:"filter"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	events   chan Event
+	done     chan struct{}
+	once     sync.Once
+	timer    *time.Timer
+	dropped  atomic.Int64
+	attached atomic.Bool
+}
+
+// Events delivers the selected events
+func (s *Session) Events() <-chan Event {
+	return s.events
+}
+
+// Done is closed when the session expires or is closed
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Dropped returns how many events were lost because the reader was too slow
+func (s *Session) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Attach claims the session for a reader, only one reader may stream it at
+// a time. It returns false when another reader is attached.
+func (s *Session) Attach() bool {
+	return s.attached.CompareAndSwap(false, true)
+}
+
+// Detach releases the session for another reader
+func (s *Session) Detach() {
+	s.attached.Store(false)
+}
+
+func (s *Session) close() {
+	s.once.Do(func() {
+		s.timer.Stop()
+		close(s.done)
+	})
+}
+
+// Hub keeps the open debug sessions and routes events to them
+type Hub struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+	open     atomic.Int32
+}
+
+// NewHub creates an empty hub
+func NewHub() *Hub {
+	return &Hub{sessions: make(map[string]*Session)}
+}
+
+var defaultHub = NewHub()
+
+// Default returns the hub shared by the RADIUS services and the admin API
+func Default() *Hub {
+	return defaultHub
+}
+
+// Open starts a session for filter. A duration of zero means
+// DefaultDuration, longer durations are cut to MaxDuration.
+func (h *Hub) Open(filter Filter, duration time.Duration) (*Session, error) {
+	filter.Username = strings.TrimSpace(filter.Username)
+	filter.MacAddr = strings.TrimSpace(filter.MacAddr)
+	filter.NasIP = strings.TrimSpace(filter.NasIP)
+	if filter.Empty() {
+		return nil, ErrEmptyFilter
+	}
+	if duration <= 0 {
+		duration = DefaultDuration
+	}
+	if duration > MaxDuration {
+		duration = MaxDuration
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.sessions) >= MaxSessions {
+		return nil, ErrTooManySessions
+	}
+	now := time.Now()
+	session := &Session{
+		ID:        common.UUID(),
+		Filter:    filter,
+		CreatedAt: now,
+		ExpiresAt: now.Add(duration),
+		events:    make(chan Event, bufferSize),
+		done:      make(chan struct{}),
+	}
+	session.timer = time.AfterFunc(duration, func() { h.Close(session.ID) })
+	h.sessions[session.ID] = session
+	h.open.Store(int32(len(h.sessions))) //nolint:gosec // G115: bounded by MaxSessions
+	return session, nil
+}
+
+// Get returns an open session
+func (h *Hub) Get(id string) (*Session, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	session, ok := h.sessions[id]
+	return session, ok
+}
+
+// Close ends a session, it returns false when the session is not open
+func (h *Hub) Close(id string) bool {
+	h.mu.Lock()
+	session, ok := h.sessions[id]
+	delete(h.sessions, id)
+	h.open.Store(int32(len(h.sessions))) //nolint:gosec // G115: bounded by MaxSessions
+	h.mu.Unlock()
+	if ok {
+		session.close()
+	}
+	return ok
+}
+
+// Sessions returns the open sessions, oldest first
+func (h *Hub) Sessions() []*Session {
+	h.mu.RLock()
+	sessions := make([]*Session, 0, len(h.sessions))
+	for _, session := range h.sessions {
+		sessions = append(sessions, session)
+	}
+	h.mu.RUnlock()
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+	})
+	return sessions
+}
+
+// Watching reports whether any session selects the packets of subject. It
+// is cheap while no session is open, so the RADIUS services call it for
+// every request.
+func (h *Hub) Watching(subject Subject) bool {
+	if h.open.Load() == 0 {
+		return false
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, session := range h.sessions {
+		if session.Filter.Match(subject) {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish delivers event to the sessions selecting subject. A session whose
+// reader falls behind loses the event rather than slowing down the server.
+func (h *Hub) Publish(subject Subject, event Event) {
+	if h.open.Load() == 0 {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Username, event.MacAddr, event.NasIP = subject.Username, subject.MacAddr, subject.NasIP
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, session := range h.sessions {
+		if !session.Filter.Match(subject) {
+			continue
+		}
+		select {
+		case session.events <- event:
+		default:
+			session.dropped.Add(1)
+		}
+	}
+}
+
+// normalizeMac unifies the notations of a MAC address, other station IDs
+// are compared case insensitively
+func normalizeMac(mac string) string {
+	if normalized := domain.NormalizeMac(mac); normalized != "" {
+		return normalized
+	}
+	return strings.ToLower(strings.TrimSpace(mac))
+}
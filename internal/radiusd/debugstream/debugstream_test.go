@@ -0,0 +1,95 @@
+package debugstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMatch(t *testing.T) {
+	subject := Subject{Username: "Alice", MacAddr: "AA-BB-CC-00-00-01", NasIP: "10.0.0.1"}
+
+	assert.True(t, Filter{Username: "alice"}.Match(subject))
+	assert.True(t, Filter{MacAddr: "aa:bb:cc:00:00:01"}.Match(subject))
+	assert.True(t, Filter{MacAddr: "aabb.cc00.0001", NasIP: "10.0.0.1"}.Match(subject))
+	assert.False(t, Filter{Username: "alice", NasIP: "10.0.0.2"}.Match(subject))
+	assert.False(t, Filter{}.Match(subject))
+}
+
+func TestHub(t *testing.T) {
+	hub := NewHub()
+
+	_, err := hub.Open(Filter{Username: " "}, time.Minute)
+	assert.ErrorIs(t, err, ErrEmptyFilter)
+
+	subject := Subject{Username: "alice", NasIP: "10.0.0.1"}
+	assert.False(t, hub.Watching(subject))
+	hub.Publish(subject, Event{Kind: KindRequest})
+
+	session, err := hub.Open(Filter{Username: "alice"}, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, MaxDuration, session.ExpiresAt.Sub(session.CreatedAt))
+	assert.True(t, hub.Watching(subject))
+	assert.False(t, hub.Watching(Subject{Username: "bob"}))
+
+	hub.Publish(subject, Event{Kind: KindRequest, Service: "auth"})
+	hub.Publish(Subject{Username: "bob"}, Event{Kind: KindRequest})
+	select {
+	case event := <-session.Events():
+		assert.Equal(t, KindRequest, event.Kind)
+		assert.Equal(t, "alice", event.Username)
+		assert.Equal(t, "10.0.0.1", event.NasIP)
+		assert.False(t, event.Time.IsZero())
+	default:
+		t.Fatal("event not delivered")
+	}
+	assert.Empty(t, session.Events())
+
+	// A slow reader loses events instead of blocking the server
+	for i := 0; i < bufferSize+5; i++ {
+		hub.Publish(subject, Event{Kind: KindResponse})
+	}
+	assert.Equal(t, int64(5), session.Dropped())
+
+	// One reader at a time
+	assert.True(t, session.Attach())
+	assert.False(t, session.Attach())
+	session.Detach()
+	assert.True(t, session.Attach())
+
+	assert.True(t, hub.Close(session.ID))
+	assert.False(t, hub.Close(session.ID))
+	<-session.Done()
+	assert.False(t, hub.Watching(subject))
+	assert.Empty(t, hub.Sessions())
+}
+
+func TestHubLimits(t *testing.T) {
+	hub := NewHub()
+
+	expiring, err := hub.Open(Filter{NasIP: "10.0.0.1"}, 10*time.Millisecond)
+	require.NoError(t, err)
+	select {
+	case <-expiring.Done():
+	case <-time.After(time.Second):
+		t.Fatal("session did not expire")
+	}
+	_, ok := hub.Get(expiring.ID)
+	assert.False(t, ok)
+
+	for i := 0; i < MaxSessions; i++ {
+		_, err := hub.Open(Filter{NasIP: "10.0.0.1"}, 0)
+		require.NoError(t, err)
+	}
+	_, err = hub.Open(Filter{NasIP: "10.0.0.1"}, 0)
+	assert.ErrorIs(t, err, ErrTooManySessions)
+
+	sessions := hub.Sessions()
+	require.Len(t, sessions, MaxSessions)
+	assert.Equal(t, DefaultDuration, sessions[0].ExpiresAt.Sub(sessions[0].CreatedAt))
+	for _, session := range sessions {
+		hub.Close(session.ID)
+	}
+}
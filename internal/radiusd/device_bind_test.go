@@ -0,0 +1,118 @@
+package radiusd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+func TestUpdateBindLearnsDevices(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	user := &domain.RadiusUser{
+		ID:         common.UUIDint64(),
+		Username:   "family",
+		MacAddr:    "00:11:22:33:44:00",
+		BindMac:    domain.MacBindDevices,
+		MaxDevices: 2,
+		Status:     common.ENABLED,
+		ExpireTime: time.Now().AddDate(0, 1, 0),
+	}
+	require.NoError(t, appCtx.DB().Create(user).Error)
+
+	login := func(mac string) {
+		authService.UpdateBind(user, &VendorRequest{MacAddr: mac})
+	}
+	devices := func() []domain.RadiusUserDevice {
+		var list []domain.RadiusUserDevice
+		require.NoError(t, appCtx.DB().Where("user_id = ?", user.ID).Order("id").Find(&list).Error)
+		return list
+	}
+
+	login("00-11-22-33-44-01")
+	login("00:11:22:33:44:01")
+	login("00:11:22:33:44:00") // The provisioned MAC is not a learned device
+	login("00:11:22:33:44:02")
+	login("00:11:22:33:44:03") // Over the limit
+	list := devices()
+	require.Len(t, list, 2)
+	assert.Equal(t, "00:11:22:33:44:01", list[0].MacAddr)
+	assert.Equal(t, domain.DeviceSourceLearned, list[0].Source)
+	assert.Equal(t, "00:11:22:33:44:02", list[1].MacAddr)
+
+	// The MAC of the user is left as provisioned
+	stored, err := radiusService.UserRepo.GetByUsername(context.Background(), "family")
+	require.NoError(t, err)
+	assert.Equal(t, "00:11:22:33:44:00", stored.MacAddr)
+
+	// MAC authentication finds the user by any registered device
+	found, err := radiusService.UserRepo.GetByMacAddr(context.Background(), "00-11-22-33-44-02")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, found.ID)
+	_, err = radiusService.UserRepo.GetByMacAddr(context.Background(), "00:11:22:33:44:03")
+	assert.Error(t, err)
+}
+
+func TestUpdateBindLearnsDevicesConcurrently(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	user := &domain.RadiusUser{
+		ID:         common.UUIDint64(),
+		Username:   "burst",
+		BindMac:    domain.MacBindDevices,
+		MaxDevices: 2,
+		Status:     common.ENABLED,
+		ExpireTime: time.Now().AddDate(0, 1, 0),
+	}
+	require.NoError(t, appCtx.DB().Create(user).Error)
+
+	loginAll := func(macs ...string) {
+		var wg sync.WaitGroup
+		for _, mac := range macs {
+			wg.Add(1)
+			go func(mac string) {
+				defer wg.Done()
+				authService.UpdateBind(user, &VendorRequest{MacAddr: mac})
+			}(mac)
+		}
+		wg.Wait()
+	}
+	countDevices := func() int64 {
+		var count int64
+		require.NoError(t, appCtx.DB().Model(&domain.RadiusUserDevice{}).Where("user_id = ?", user.ID).Count(&count).Error)
+		return count
+	}
+
+	// Retransmitted requests of one new device register it once
+	loginAll("00:11:22:33:44:01", "00:11:22:33:44:01", "00:11:22:33:44:01", "00:11:22:33:44:01")
+	assert.Equal(t, int64(1), countDevices())
+
+	// A burst of new devices does not exceed the limit
+	var macs []string
+	for i := 2; i < 10; i++ {
+		macs = append(macs, fmt.Sprintf("00:11:22:33:44:%02x", i))
+	}
+	loginAll(macs...)
+	assert.LessOrEqual(t, countDevices(), int64(2))
+
+	// The unique index refuses duplicates written around the repository
+	err := appCtx.DB().Create(&domain.RadiusUserDevice{UserId: user.ID, Username: user.Username, MacAddr: "00:11:22:33:44:01"}).Error
+	assert.Error(t, err)
+}
@@ -0,0 +1,521 @@
+package dictionary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"layeh.com/radius"
+)
+
+const (
+	vendorSpecificType = 26
+	maxAttributeLength = 253
+)
+
+// AttributeValue is a decoded attribute value
+type AttributeValue struct {
+	Name   string `json:"name"`
+	Vendor string `json:"vendor,omitempty"`
+	Tag    byte   `json:"tag,omitempty"`
+	Value  string `json:"value"`
+}
+
+func (v AttributeValue) String() string {
+	if v.Tag > 0 {
+		return fmt.Sprintf("%s:%d = %s", v.Name, v.Tag, v.Value)
+	}
+	return fmt.Sprintf("%s = %s", v.Name, v.Value)
+}
+
+// DecodePacket decodes every attribute of p
+func (d *Dictionary) DecodePacket(p *radius.Packet) []AttributeValue {
+	if p == nil {
+		return nil
+	}
+	var values []AttributeValue
+	for _, avp := range p.Attributes {
+		values = append(values, d.Decode(avp.Type, avp.Attribute)...)
+	}
+	return values
+}
+
+// Decode decodes a wire attribute into named values. Vendor-Specific and
+// TLV attributes may yield several values. Encrypted values are never
+// decrypted and unknown attributes are rendered as hex.
+func (d *Dictionary) Decode(typ radius.Type, value radius.Attribute) []AttributeValue {
+	if typ == vendorSpecificType {
+		return d.decodeVendorSpecific(value)
+	}
+	attr := d.attributes[int(typ)]
+	if attr == nil {
+		return []AttributeValue{{Name: "Attr-" + strconv.Itoa(int(typ)), Value: hexValue(value)}}
+	}
+	return decodeAttribute(attr, value)
+}
+
+func (d *Dictionary) decodeVendorSpecific(value []byte) []AttributeValue {
+	if len(value) < 5 {
+		return []AttributeValue{{Name: "Vendor-Specific", Value: hexValue(value)}}
+	}
+	id := binary.BigEndian.Uint32(value[:4])
+	vendor := d.vendors[id]
+	if vendor == nil {
+		return []AttributeValue{{Name: fmt.Sprintf("Vendor-%d", id), Value: hexValue(value[4:])}}
+	}
+
+	var values []AttributeValue
+	data := value[4:]
+	header := vendor.TypeSize + vendor.LengthSize
+	if vendor.Continuation {
+		header++
+	}
+	for len(data) > 0 {
+		if len(data) < header {
+			break
+		}
+		code := int(readUint(data[:vendor.TypeSize]))
+		length := len(data)
+		if vendor.LengthSize > 0 {
+			length = int(readUint(data[vendor.TypeSize : vendor.TypeSize+vendor.LengthSize]))
+		}
+		if length < header || length > len(data) {
+			break
+		}
+		sub := data[header:length]
+		data = data[length:]
+
+		attr := vendor.attributes[code]
+		if attr == nil {
+			values = append(values, AttributeValue{
+				Name:   fmt.Sprintf("%s-Attr-%d", vendor.Name, code),
+				Vendor: vendor.Name,
+				Value:  hexValue(sub),
+			})
+			continue
+		}
+		values = append(values, decodeAttribute(attr, sub)...)
+	}
+	if len(data) > 0 {
+		values = append(values, AttributeValue{Name: vendor.Name + "-Unparsed", Vendor: vendor.Name, Value: hexValue(data)})
+	}
+	return values
+}
+
+func decodeAttribute(attr *Attribute, data []byte) []AttributeValue {
+	switch attr.Type {
+	case TypeTLV:
+		return decodeTLV(attr, data)
+	case TypeExtended, TypeLongExtended:
+		header := 1
+		if attr.Type == TypeLongExtended {
+			header = 2
+		}
+		if len(data) < header {
+			break
+		}
+		child := attr.children[int(data[0])]
+		if child == nil {
+			return []AttributeValue{{Name: fmt.Sprintf("%s.%d", attr.Name, data[0]), Value: hexValue(data[header:])}}
+		}
+		return decodeAttribute(child, data[header:])
+	}
+
+	v := AttributeValue{Name: attr.Name}
+	if attr.Vendor != nil {
+		v.Vendor = attr.Vendor.Name
+	}
+	if attr.HasTag && len(data) > 0 && (data[0] <= 0x1f || attr.Type == TypeInteger) {
+		v.Tag = data[0]
+		if attr.Type == TypeInteger {
+			// Tagged integers keep the tag in the first of their four octets
+			data = append([]byte{0}, data[1:]...)
+		} else {
+			data = data[1:]
+		}
+	}
+	if attr.Encrypt != EncryptNone {
+		v.Value = hexValue(data)
+	} else {
+		v.Value = formatValue(attr, data)
+	}
+	return []AttributeValue{v}
+}
+
+func decodeTLV(attr *Attribute, data []byte) []AttributeValue {
+	var values []AttributeValue
+	for len(data) >= 2 {
+		code, length := int(data[0]), int(data[1])
+		if length < 2 || length > len(data) {
+			break
+		}
+		sub := data[2:length]
+		data = data[length:]
+		if child := attr.children[code]; child != nil {
+			values = append(values, decodeAttribute(child, sub)...)
+		} else {
+			values = append(values, AttributeValue{Name: fmt.Sprintf("%s.%d", attr.Name, code), Value: hexValue(sub)})
+		}
+	}
+	if len(data) > 0 {
+		values = append(values, AttributeValue{Name: attr.Name, Value: hexValue(data)})
+	}
+	return values
+}
+
+func formatValue(attr *Attribute, data []byte) string {
+	switch attr.Type {
+	case TypeString:
+		return string(data)
+	case TypeInteger, TypeShort, TypeByte, TypeInteger64:
+		if len(data) != fixedSize(attr.Type) {
+			break
+		}
+		n := readUint(data)
+		if name, ok := attr.ValueName(n); ok {
+			return name
+		}
+		return strconv.FormatUint(n, 10)
+	case TypeSigned:
+		if len(data) != 4 {
+			break
+		}
+		return strconv.FormatInt(int64(int32(binary.BigEndian.Uint32(data))), 10) //nolint:gosec // G115: signed by definition
+	case TypeDate:
+		if len(data) != 4 {
+			break
+		}
+		return time.Unix(int64(binary.BigEndian.Uint32(data)), 0).UTC().Format(time.RFC3339)
+	case TypeIPAddr, TypeIPv6Addr, TypeComboIP:
+		if len(data) != net.IPv4len && len(data) != net.IPv6len {
+			break
+		}
+		return net.IP(data).String()
+	case TypeIPv4Prefix, TypeIPv6Prefix:
+		size := net.IPv6len
+		if attr.Type == TypeIPv4Prefix {
+			size = net.IPv4len
+		}
+		if len(data) < 2 || len(data)-2 > size || int(data[1]) > size*8 {
+			break
+		}
+		ip := make(net.IP, size)
+		copy(ip, data[2:])
+		return fmt.Sprintf("%s/%d", ip, data[1])
+	case TypeEther:
+		if len(data) != 6 {
+			break
+		}
+		return net.HardwareAddr(data).String()
+	case TypeIfID:
+		if len(data) != 8 {
+			break
+		}
+		return fmt.Sprintf("%x:%x:%x:%x", data[0:2], data[2:4], data[4:6], data[6:8])
+	}
+	return hexValue(data)
+}
+
+// Encode encodes a value for the attribute name into a wire attribute,
+// wrapping vendor, extended and TLV headers as needed. The name may carry a
+// ":tag" suffix for tagged attributes. Encrypted attributes need the packet
+// secret and must be added with AddTo.
+func (d *Dictionary) Encode(name, value string) (radius.Type, radius.Attribute, error) {
+	return d.encode(name, value, nil)
+}
+
+// AddTo encodes the value for the attribute name and appends it to p,
+// encrypting User-Password and Tunnel-Password style attributes with the
+// packet secret and authenticator.
+func (d *Dictionary) AddTo(p *radius.Packet, name, value string) error {
+	if p == nil {
+		return errors.New("dictionary: nil packet")
+	}
+	typ, attr, err := d.encode(name, value, p)
+	if err != nil {
+		return err
+	}
+	p.Add(typ, attr)
+	return nil
+}
+
+func (d *Dictionary) encode(name, value string, p *radius.Packet) (radius.Type, radius.Attribute, error) {
+	attrName, tag := splitTag(name)
+	if tag > 0x1f {
+		return 0, nil, fmt.Errorf("dictionary: invalid tag in %q", name)
+	}
+	attr, ok := d.Attribute(attrName)
+	if !ok {
+		return 0, nil, fmt.Errorf("dictionary: unknown attribute %q", attrName)
+	}
+	if tag > 0 && !attr.HasTag {
+		return 0, nil, fmt.Errorf("dictionary: attribute %s does not take a tag", attr.Name)
+	}
+
+	data, err := parseValue(attr, value)
+	if err != nil {
+		return 0, nil, fmt.Errorf("dictionary: %s: %w", attr.Name, err)
+	}
+	if attr.Encrypt != EncryptNone {
+		if p == nil {
+			return 0, nil, fmt.Errorf("dictionary: attribute %s is encrypted and needs a packet", attr.Name)
+		}
+		if data, err = encrypt(attr.Encrypt, data, p); err != nil {
+			return 0, nil, fmt.Errorf("dictionary: %s: %w", attr.Name, err)
+		}
+	}
+	if attr.HasTag {
+		if attr.Type == TypeInteger {
+			data[0] = tag
+		} else if tag > 0 || attr.Encrypt == EncryptTunnelPassword {
+			data = append([]byte{tag}, data...)
+		}
+	}
+	return wrap(attr, data)
+}
+
+// splitTag splits a "Name:tag" attribute reference
+func splitTag(name string) (string, byte) {
+	if i := strings.LastIndexByte(name, ':'); i > 0 {
+		if n, err := strconv.ParseUint(name[i+1:], 10, 8); err == nil {
+			return name[:i], byte(n)
+		}
+	}
+	return name, 0
+}
+
+func encrypt(method int, data []byte, p *radius.Packet) ([]byte, error) {
+	switch method {
+	case EncryptUserPassword:
+		return radius.NewUserPassword(data, p.Secret, p.Authenticator[:])
+	case EncryptTunnelPassword:
+		salt := make([]byte, 2)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		salt[0] |= 0x80
+		return radius.NewTunnelPassword(data, salt, p.Secret, p.Authenticator[:])
+	}
+	return nil, fmt.Errorf("unsupported encryption method %d", method)
+}
+
+// wrap adds the TLV, extended and vendor headers above attr to data
+func wrap(attr *Attribute, data []byte) (radius.Type, radius.Attribute, error) {
+	for attr.Parent != nil {
+		parent := attr.Parent
+		switch parent.Type {
+		case TypeTLV:
+			if len(data)+2 > math.MaxUint8 {
+				return 0, nil, fmt.Errorf("dictionary: %s value too long", attr.Name)
+			}
+			data = append([]byte{byte(attr.Code), byte(len(data) + 2)}, data...)
+		case TypeExtended:
+			data = append([]byte{byte(attr.Code)}, data...)
+		case TypeLongExtended:
+			data = append([]byte{byte(attr.Code), 0}, data...)
+		default:
+			return 0, nil, fmt.Errorf("dictionary: cannot encode %s inside %s", attr.Name, parent.Type)
+		}
+		attr = parent
+	}
+
+	typ := radius.Type(attr.Code)
+	if vendor := attr.Vendor; vendor != nil {
+		header := vendor.TypeSize + vendor.LengthSize
+		if vendor.Continuation {
+			header++
+		}
+		vsa := make([]byte, 4+header, 4+header+len(data))
+		binary.BigEndian.PutUint32(vsa, vendor.ID)
+		putUint(vsa[4:4+vendor.TypeSize], uint64(attr.Code)) //nolint:gosec // G115: attribute codes are non-negative
+		if vendor.LengthSize > 0 {
+			putUint(vsa[4+vendor.TypeSize:4+vendor.TypeSize+vendor.LengthSize], uint64(header+len(data))) //nolint:gosec // G115: bounded by the check below
+		}
+		data = append(vsa, data...)
+		typ = vendorSpecificType
+	}
+	if len(data) > maxAttributeLength {
+		return 0, nil, fmt.Errorf("dictionary: %s value too long", attr.Name)
+	}
+	return typ, data, nil
+}
+
+func parseValue(attr *Attribute, value string) ([]byte, error) {
+	switch attr.Type {
+	case TypeString:
+		return []byte(value), nil
+	case TypeInteger, TypeShort, TypeByte, TypeInteger64:
+		n, ok := attr.Value(value)
+		if !ok {
+			var err error
+			if n, err = strconv.ParseUint(value, 0, fixedSize(attr.Type)*8); err != nil {
+				return nil, fmt.Errorf("invalid %s value %q", attr.Type, value)
+			}
+		}
+		if attr.HasTag && attr.Type == TypeInteger && n > 0xffffff {
+			return nil, fmt.Errorf("tagged integer %q out of range", value)
+		}
+		b := make([]byte, fixedSize(attr.Type))
+		putUint(b, n)
+		return b, nil
+	case TypeSigned:
+		n, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signed value %q", value)
+		}
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, uint32(int32(n))) //nolint:gosec // G115: range checked by ParseInt
+		return b, nil
+	case TypeDate:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			n, errNum := strconv.ParseUint(value, 10, 32)
+			if errNum != nil {
+				return nil, fmt.Errorf("invalid date %q", value)
+			}
+			t = time.Unix(int64(n), 0) //nolint:gosec // G115: parsed as 32 bits
+		}
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, uint32(t.Unix())) //nolint:gosec // G115: RADIUS dates are 32 bit
+		return b, nil
+	case TypeIPAddr:
+		ip := net.ParseIP(value).To4()
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IPv4 address %q", value)
+		}
+		return ip, nil
+	case TypeIPv6Addr:
+		ip := net.ParseIP(value)
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("invalid IPv6 address %q", value)
+		}
+		return ip.To16(), nil
+	case TypeComboIP:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", value)
+		}
+		if v4 := ip.To4(); v4 != nil {
+			return v4, nil
+		}
+		return ip, nil
+	case TypeIPv4Prefix, TypeIPv6Prefix:
+		_, ipnet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %q", value)
+		}
+		ones, _ := ipnet.Mask.Size()
+		ip := ipnet.IP.To16()
+		if attr.Type == TypeIPv4Prefix {
+			if ip = ipnet.IP.To4(); ip == nil {
+				return nil, fmt.Errorf("invalid IPv4 prefix %q", value)
+			}
+		} else if ipnet.IP.To4() != nil {
+			return nil, fmt.Errorf("invalid IPv6 prefix %q", value)
+		}
+		return append([]byte{0, byte(ones)}, ip...), nil
+	case TypeEther:
+		mac, err := net.ParseMAC(value)
+		if err != nil || len(mac) != 6 {
+			return nil, fmt.Errorf("invalid MAC address %q", value)
+		}
+		return mac, nil
+	case TypeIfID:
+		b, err := hex.DecodeString(strings.ReplaceAll(value, ":", ""))
+		if err != nil || len(b) != 8 {
+			return nil, fmt.Errorf("invalid interface id %q", value)
+		}
+		return b, nil
+	case TypeTLV, TypeExtended, TypeLongExtended, TypeVSA, TypeEVS:
+		return nil, fmt.Errorf("cannot encode %s attributes directly", attr.Type)
+	}
+	// octets, abinary and unknown types take 0x prefixed hex, or raw text
+	if strings.HasPrefix(value, "0x") {
+		b, err := hex.DecodeString(value[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex value %q", value)
+		}
+		return b, nil
+	}
+	return []byte(value), nil
+}
+
+func fixedSize(t DataType) int {
+	switch t {
+	case TypeByte:
+		return 1
+	case TypeShort:
+		return 2
+	case TypeInteger64:
+		return 8
+	}
+	return 4
+}
+
+func readUint(b []byte) uint64 {
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
+
+func putUint(b []byte, n uint64) {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+}
+
+func hexValue(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// Lookup returns the values of the attribute name found in p. A ":tag"
+// suffix is ignored.
+func (d *Dictionary) Lookup(p *radius.Packet, name string) []AttributeValue {
+	if p == nil {
+		return nil
+	}
+	name, _ = splitTag(name)
+	var values []AttributeValue
+	for _, avp := range p.Attributes {
+		for _, v := range d.Decode(avp.Type, avp.Attribute) {
+			if strings.EqualFold(v.Name, name) {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// Del removes every instance of the attribute name from p. Vendor-Specific
+// attributes are removed when any of their sub-attributes matches. A ":tag"
+// suffix is ignored.
+func (d *Dictionary) Del(p *radius.Packet, name string) {
+	if p == nil {
+		return
+	}
+	name, _ = splitTag(name)
+	kept := p.Attributes[:0]
+	for _, avp := range p.Attributes {
+		match := false
+		for _, v := range d.Decode(avp.Type, avp.Attribute) {
+			if strings.EqualFold(v.Name, name) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			kept = append(kept, avp)
+		}
+	}
+	p.Attributes = kept
+}
@@ -0,0 +1,146 @@
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2868"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	d := loadBundled(t)
+
+	tests := []struct {
+		name  string
+		value string
+		typ   radius.Type
+		want  AttributeValue
+	}{
+		{"Cisco-AVPair", "ip:addr-pool=pool1", 26, AttributeValue{Name: "Cisco-AVPair", Vendor: "Cisco", Value: "ip:addr-pool=pool1"}},
+		{"Tunnel-Type:1", "VLAN", rfc2868.TunnelType_Type, AttributeValue{Name: "Tunnel-Type", Tag: 1, Value: "VLAN"}},
+		{"Tunnel-Private-Group-Id:1", "100", rfc2868.TunnelPrivateGroupID_Type, AttributeValue{Name: "Tunnel-Private-Group-Id", Tag: 1, Value: "100"}},
+		{"Session-Timeout", "3600", rfc2865.SessionTimeout_Type, AttributeValue{Name: "Session-Timeout", Value: "3600"}},
+		{"Framed-IP-Address", "10.0.0.1", rfc2865.FramedIPAddress_Type, AttributeValue{Name: "Framed-IP-Address", Value: "10.0.0.1"}},
+		{"Delegated-IPv6-Prefix", "2001:db8::/56", 123, AttributeValue{Name: "Delegated-IPv6-Prefix", Value: "2001:db8::/56"}},
+		{"WiMAX-Release", "2.1", 26, AttributeValue{Name: "WiMAX-Release", Vendor: "WiMAX", Value: "2.1"}},
+		{"USR-Last-Number-Dialed-Out", "5551234", 26, AttributeValue{Name: "USR-Last-Number-Dialed-Out", Vendor: "USR", Value: "5551234"}},
+		{"IP-Port-Type", "1", 241, AttributeValue{Name: "IP-Port-Type", Value: "1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, attr, err := d.Encode(tt.name, tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.typ, typ)
+			assert.Equal(t, []AttributeValue{tt.want}, d.Decode(typ, attr))
+		})
+	}
+}
+
+func TestEncodeWire(t *testing.T) {
+	d := loadBundled(t)
+
+	// Huawei uses the default 1,1 format
+	typ, attr, err := d.Encode("Huawei-Input-Average-Rate", "1024")
+	require.NoError(t, err)
+	assert.Equal(t, radius.Type(26), typ)
+	assert.Equal(t, radius.Attribute{0, 0, 0x07, 0xdb, 2, 6, 0, 0, 4, 0}, attr)
+
+	// WiMAX sub attributes carry a continuation octet and TLV children
+	_, attr, err = d.Encode("WiMAX-Release", "1")
+	require.NoError(t, err)
+	assert.Equal(t, radius.Attribute{0, 0, 0x60, 0xb5, 1, 6, 0, 1, 3, '1'}, attr)
+
+	// Tagged integers keep the tag in the first value octet
+	_, attr, err = d.Encode("Tunnel-Medium-Type:2", "IEEE-802")
+	require.NoError(t, err)
+	assert.Equal(t, radius.Attribute{2, 0, 0, 6}, attr)
+}
+
+func TestEncodeErrors(t *testing.T) {
+	d := loadBundled(t)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"Not-A-Real-Attribute", "1"},
+		{"Session-Timeout", "forever"},
+		{"Framed-IP-Address", "2001:db8::1"},
+		{"Session-Timeout:1", "10"},
+		{"Tunnel-Type:40", "VLAN"},
+		{"WiMAX-Capability", "x"},
+		{"Tunnel-Password:1", "secret"},
+		{"Reply-Message", string(make([]byte, 300))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := d.Encode(tt.name, tt.value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAddToEncrypted(t *testing.T) {
+	d := loadBundled(t)
+	p := radius.New(radius.CodeAccessAccept, []byte("secret"))
+
+	require.NoError(t, d.AddTo(p, "Tunnel-Password:1", "s3cret"))
+	attr := p.Get(rfc2868.TunnelPassword_Type)
+	require.NotNil(t, attr)
+	assert.Equal(t, byte(1), attr[0])
+
+	password, _, err := radius.TunnelPassword(attr[1:], p.Secret, p.Authenticator[:])
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", string(password))
+
+	values := d.Decode(rfc2868.TunnelPassword_Type, attr)
+	require.Len(t, values, 1)
+	assert.Equal(t, byte(1), values[0].Tag)
+	assert.NotContains(t, values[0].Value, "s3cret")
+}
+
+func TestDecodeUnknown(t *testing.T) {
+	d := loadBundled(t)
+
+	assert.Equal(t, []AttributeValue{{Name: "Attr-250", Value: "0x0102"}}, New().Decode(250, radius.Attribute{1, 2}))
+	assert.Equal(t, []AttributeValue{{Name: "Vendor-65535", Value: "0x0103ab"}},
+		d.Decode(26, radius.Attribute{0, 0, 0xff, 0xff, 1, 3, 0xab}))
+	assert.Equal(t, []AttributeValue{{Name: "Cisco-Attr-200", Vendor: "Cisco", Value: "0xab"}},
+		d.Decode(26, radius.Attribute{0, 0, 0, 9, 200, 3, 0xab}))
+}
+
+func TestDecodePacket(t *testing.T) {
+	d := loadBundled(t)
+	p := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	require.NoError(t, rfc2865.UserName_SetString(p, "alice"))
+	require.NoError(t, d.AddTo(p, "Cisco-AVPair", "client-mac-address=aabb.ccdd.eeff"))
+
+	values := d.DecodePacket(p)
+	require.Len(t, values, 2)
+	assert.Equal(t, "User-Name = alice", values[0].String())
+	assert.Equal(t, "Cisco-AVPair = client-mac-address=aabb.ccdd.eeff", values[1].String())
+	assert.Nil(t, d.DecodePacket(nil))
+}
+
+func TestLookupAndDel(t *testing.T) {
+	d := loadBundled(t)
+	p := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	require.NoError(t, d.AddTo(p, "Filter-Id", "std"))
+	require.NoError(t, d.AddTo(p, "Filter-Id", "extra"))
+	require.NoError(t, d.AddTo(p, "Cisco-AVPair", "ip:vrf=a"))
+	require.NoError(t, d.AddTo(p, "Session-Timeout", "60"))
+
+	filters := d.Lookup(p, "filter-id")
+	require.Len(t, filters, 2)
+	assert.Equal(t, "extra", filters[1].Value)
+
+	d.Del(p, "Filter-Id")
+	d.Del(p, "Cisco-AVPair")
+	assert.Empty(t, d.Lookup(p, "Filter-Id"))
+	assert.Empty(t, d.Lookup(p, "Cisco-AVPair"))
+	assert.Len(t, p.Attributes, 1)
+	assert.Nil(t, d.Lookup(nil, "Filter-Id"))
+}
@@ -0,0 +1,216 @@
+// Package dictionary loads FreeRADIUS format dictionaries at runtime.
+//
+// The generated packages under internal/radiusd/vendors only cover a handful
+// of vendors. A Dictionary parsed from the bundled share/ files (or from an
+// operator supplied directory) can name, decode and encode the attributes of
+// any vendor it describes, which lets new vendors be supported without a
+// code release.
+//
+// Supported syntax: $INCLUDE, $INCLUDE-, VENDOR (with format=t,l[,c]),
+// BEGIN-VENDOR/END-VENDOR, BEGIN-TLV/END-TLV, ATTRIBUTE with dotted TLV and
+// extended OIDs and the has_tag, encrypt=N, concat and array flags, and VALUE.
+// Other keywords are ignored.
+package dictionary
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// DataType is the FreeRADIUS data type of an attribute
+type DataType string
+
+const (
+	TypeString       DataType = "string"
+	TypeOctets       DataType = "octets"
+	TypeIPAddr       DataType = "ipaddr"
+	TypeIPv4Prefix   DataType = "ipv4prefix"
+	TypeInteger      DataType = "integer"
+	TypeInteger64    DataType = "integer64"
+	TypeSigned       DataType = "signed"
+	TypeShort        DataType = "short"
+	TypeByte         DataType = "byte"
+	TypeDate         DataType = "date"
+	TypeIfID         DataType = "ifid"
+	TypeIPv6Addr     DataType = "ipv6addr"
+	TypeIPv6Prefix   DataType = "ipv6prefix"
+	TypeEther        DataType = "ether"
+	TypeComboIP      DataType = "combo-ip"
+	TypeABinary      DataType = "abinary"
+	TypeTLV          DataType = "tlv"
+	TypeExtended     DataType = "extended"
+	TypeLongExtended DataType = "long-extended"
+	TypeVSA          DataType = "vsa"
+	TypeEVS          DataType = "evs"
+)
+
+// Encryption methods set by the encrypt=N attribute flag
+const (
+	EncryptNone           = 0
+	EncryptUserPassword   = 1 // RFC 2865 User-Password
+	EncryptTunnelPassword = 2 // RFC 2868 Tunnel-Password
+	EncryptAscendSecret   = 3 // Ascend-Send-Secret
+)
+
+// Attribute is a dictionary attribute definition
+type Attribute struct {
+	Name     string
+	Code     int      // Attribute number within its vendor, TLV or extended parent
+	Type     DataType // Data type, unknown types are treated as octets
+	HasTag   bool
+	Encrypt  int
+	Concat   bool
+	Array    bool
+	Vendor   *Vendor    // Nil for standard attributes
+	Parent   *Attribute // TLV or extended attribute containing this one
+	children map[int]*Attribute
+	values   map[string]uint64 // lowercase VALUE name -> number
+	names    map[uint64]string // number -> VALUE name
+}
+
+// Child returns the sub-attribute with the given code of a TLV or extended attribute
+func (a *Attribute) Child(code int) *Attribute {
+	return a.children[code]
+}
+
+// ValueName returns the VALUE name defined for n
+func (a *Attribute) ValueName(n uint64) (string, bool) {
+	name, ok := a.names[n]
+	return name, ok
+}
+
+// Value returns the number of the VALUE name, case-insensitively
+func (a *Attribute) Value(name string) (uint64, bool) {
+	n, ok := a.values[strings.ToLower(name)]
+	return n, ok
+}
+
+// Values returns the VALUE names of the attribute ordered by number
+func (a *Attribute) Values() []string {
+	keys := make([]uint64, 0, len(a.names))
+	for n := range a.names {
+		keys = append(keys, n)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	values := make([]string, 0, len(keys))
+	for _, n := range keys {
+		values = append(values, a.names[n])
+	}
+	return values
+}
+
+func (a *Attribute) addValue(name string, n uint64) {
+	if a.values == nil {
+		a.values = make(map[string]uint64)
+		a.names = make(map[uint64]string)
+	}
+	a.values[strings.ToLower(name)] = n
+	if _, exists := a.names[n]; !exists {
+		a.names[n] = name
+	}
+}
+
+// Vendor is a VENDOR definition with its wire format
+type Vendor struct {
+	Name         string
+	ID           uint32
+	TypeSize     int  // Octets of the sub-attribute type: 1, 2 or 4
+	LengthSize   int  // Octets of the sub-attribute length: 0, 1 or 2
+	Continuation bool // WiMAX style continuation octet after the length
+	attributes   map[int]*Attribute
+}
+
+// Attribute returns the vendor attribute with the given code
+func (v *Vendor) Attribute(code int) *Attribute {
+	return v.attributes[code]
+}
+
+// Attributes returns the vendor's top level attributes ordered by code
+func (v *Vendor) Attributes() []*Attribute {
+	return sortedAttributes(v.attributes)
+}
+
+// Dictionary is a parsed set of dictionary files
+type Dictionary struct {
+	attributes  map[int]*Attribute // Standard attributes by code
+	vendors     map[uint32]*Vendor
+	vendorNames map[string]*Vendor    // lowercase name -> vendor
+	names       map[string]*Attribute // lowercase name -> attribute
+}
+
+// New returns an empty dictionary
+func New() *Dictionary {
+	return &Dictionary{
+		attributes:  make(map[int]*Attribute),
+		vendors:     make(map[uint32]*Vendor),
+		vendorNames: make(map[string]*Vendor),
+		names:       make(map[string]*Attribute),
+	}
+}
+
+// Attribute looks up an attribute by name, case-insensitively
+func (d *Dictionary) Attribute(name string) (*Attribute, bool) {
+	attr, ok := d.names[strings.ToLower(name)]
+	return attr, ok
+}
+
+// AttributeRef looks up an attribute by a "Name" or "Name:tag" reference
+func (d *Dictionary) AttributeRef(ref string) (*Attribute, bool) {
+	name, _ := splitTag(ref)
+	return d.Attribute(name)
+}
+
+// StandardAttribute returns the standard attribute with the given code
+func (d *Dictionary) StandardAttribute(code int) *Attribute {
+	return d.attributes[code]
+}
+
+// Attributes returns the standard attributes ordered by code
+func (d *Dictionary) Attributes() []*Attribute {
+	return sortedAttributes(d.attributes)
+}
+
+// Vendor returns the vendor with the given enterprise number
+func (d *Dictionary) Vendor(id uint32) *Vendor {
+	return d.vendors[id]
+}
+
+// VendorByName looks up a vendor by name, case-insensitively
+func (d *Dictionary) VendorByName(name string) (*Vendor, bool) {
+	v, ok := d.vendorNames[strings.ToLower(name)]
+	return v, ok
+}
+
+// Vendors returns all vendors ordered by name
+func (d *Dictionary) Vendors() []*Vendor {
+	list := make([]*Vendor, 0, len(d.vendors))
+	for _, v := range d.vendors {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return strings.ToLower(list[i].Name) < strings.ToLower(list[j].Name)
+	})
+	return list
+}
+
+func sortedAttributes(m map[int]*Attribute) []*Attribute {
+	list := make([]*Attribute, 0, len(m))
+	for _, a := range m {
+		list = append(list, a)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+var defaultDictionary atomic.Pointer[Dictionary]
+
+// SetDefault installs d as the process wide dictionary
+func SetDefault(d *Dictionary) {
+	defaultDictionary.Store(d)
+}
+
+// Default returns the process wide dictionary, or nil when none is loaded
+func Default() *Dictionary {
+	return defaultDictionary.Load()
+}
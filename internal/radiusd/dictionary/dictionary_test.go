@@ -0,0 +1,126 @@
+package dictionary
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/share"
+)
+
+func loadBundled(t *testing.T) *Dictionary {
+	t.Helper()
+	d, err := Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+	return d
+}
+
+func TestLoadBundled(t *testing.T) {
+	d := loadBundled(t)
+
+	cisco, ok := d.VendorByName("cisco")
+	require.True(t, ok)
+	assert.Equal(t, uint32(9), cisco.ID)
+	assert.Equal(t, "Cisco-AVPair", cisco.Attribute(1).Name)
+
+	wimax := d.Vendor(24757)
+	require.NotNil(t, wimax)
+	assert.Equal(t, 1, wimax.TypeSize)
+	assert.Equal(t, 1, wimax.LengthSize)
+	assert.True(t, wimax.Continuation)
+
+	usr := d.Vendor(429)
+	require.NotNil(t, usr)
+	assert.Equal(t, 4, usr.TypeSize)
+	assert.Equal(t, 0, usr.LengthSize)
+
+	tunnelType, ok := d.Attribute("tunnel-type")
+	require.True(t, ok)
+	assert.True(t, tunnelType.HasTag)
+	n, ok := tunnelType.Value("L2TP")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), n)
+
+	tunnelPassword, ok := d.Attribute("Tunnel-Password")
+	require.True(t, ok)
+	assert.Equal(t, EncryptTunnelPassword, tunnelPassword.Encrypt)
+
+	release, ok := d.Attribute("WiMAX-Release")
+	require.True(t, ok)
+	require.NotNil(t, release.Parent)
+	assert.Equal(t, "WiMAX-Capability", release.Parent.Name)
+	assert.Equal(t, release, release.Parent.Child(1))
+}
+
+func TestLoadSyntax(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dictionary": {Data: []byte(`
+# comment
+$INCLUDE sub/dictionary.acme
+$INCLUDE- dictionary.missing
+ATTRIBUTE	Test-Int	200	integer
+VALUE	Acme-Mode	Fast	2
+`)},
+		"sub/dictionary.acme": {Data: []byte(`
+VENDOR	Acme	65000	format=2,1
+BEGIN-VENDOR	Acme
+ATTRIBUTE	Acme-Mode	1	integer
+VALUE	Acme-Mode	Slow	1
+ATTRIBUTE	Acme-Group	2	tlv
+BEGIN-TLV	Acme-Group
+ATTRIBUTE	Acme-Member	1	octets[6]
+END-TLV	Acme-Group
+END-VENDOR	Acme
+ATTRIBUTE	Acme-Legacy	3	string	Acme
+`)},
+	}
+	d, err := Load(fsys, "dictionary")
+	require.NoError(t, err)
+
+	vendor, ok := d.VendorByName("ACME")
+	require.True(t, ok)
+	assert.Equal(t, 2, vendor.TypeSize)
+	assert.Len(t, vendor.Attributes(), 3)
+
+	mode, _ := d.Attribute("Acme-Mode")
+	assert.Equal(t, []string{"Slow", "Fast"}, mode.Values())
+
+	member, ok := d.Attribute("Acme-Member")
+	require.True(t, ok)
+	assert.Equal(t, TypeOctets, member.Type)
+	assert.Equal(t, vendor, member.Vendor)
+
+	legacy, _ := d.Attribute("Acme-Legacy")
+	assert.Equal(t, vendor, legacy.Vendor)
+	assert.Equal(t, 200, d.StandardAttribute(200).Code)
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing include", "$INCLUDE dictionary.none"},
+		{"unknown vendor block", "BEGIN-VENDOR Nobody"},
+		{"bad attribute number", "ATTRIBUTE Foo x1 string"},
+		{"unknown parent", "ATTRIBUTE Foo 250.1 string"},
+		{"bad vendor format", "VENDOR Foo 1 format=3,1"},
+		{"bad value", "VALUE Foo Bar baz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(fstest.MapFS{"dictionary": {Data: []byte(tt.data)}}, "dictionary")
+			assert.ErrorContains(t, err, "dictionary:1:")
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	prev := Default()
+	defer SetDefault(prev)
+
+	d := New()
+	SetDefault(d)
+	assert.Same(t, d, Default())
+}
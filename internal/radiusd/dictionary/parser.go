@@ -0,0 +1,266 @@
+package dictionary
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Load parses the dictionary file name from fsys together with every file it
+// includes. Include paths are resolved relative to the including file.
+func Load(fsys fs.FS, name string) (*Dictionary, error) {
+	p := &parser{
+		dict:   New(),
+		fsys:   fsys,
+		parsed: make(map[string]bool),
+	}
+	if err := p.parseFile(path.Clean(name), false); err != nil {
+		return nil, err
+	}
+	for _, v := range p.pending {
+		if attr, ok := p.dict.Attribute(v.attr); ok {
+			attr.addValue(v.name, v.value)
+		}
+	}
+	return p.dict, nil
+}
+
+// LoadDir parses the "dictionary" root file of a FreeRADIUS dictionary directory
+func LoadDir(dir string) (*Dictionary, error) {
+	return Load(os.DirFS(dir), "dictionary")
+}
+
+type pendingValue struct {
+	attr  string
+	name  string
+	value uint64
+}
+
+type parser struct {
+	dict    *Dictionary
+	fsys    fs.FS
+	parsed  map[string]bool
+	vendor  *Vendor      // Current BEGIN-VENDOR block
+	tlvs    []*Attribute // Open BEGIN-TLV blocks
+	pending []pendingValue
+}
+
+func (p *parser) parseFile(name string, optional bool) error {
+	if p.parsed[name] {
+		return nil
+	}
+	p.parsed[name] = true
+
+	data, err := fs.ReadFile(p.fsys, name)
+	if err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("dictionary: %w", err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := p.parseLine(name, fields); err != nil {
+			return fmt.Errorf("dictionary: %s:%d: %w", name, lineno, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (p *parser) parseLine(name string, f []string) error {
+	switch f[0] {
+	case "$INCLUDE", "$INCLUDE-":
+		if len(f) < 2 {
+			return errors.New("missing include file")
+		}
+		inc := f[1]
+		if !path.IsAbs(inc) {
+			inc = path.Join(path.Dir(name), inc)
+		}
+		return p.parseFile(strings.TrimPrefix(path.Clean(inc), "/"), f[0] == "$INCLUDE-")
+	case "VENDOR":
+		return p.parseVendor(f)
+	case "BEGIN-VENDOR":
+		if len(f) < 2 {
+			return errors.New("missing vendor name")
+		}
+		vendor, ok := p.dict.VendorByName(f[1])
+		if !ok {
+			return fmt.Errorf("unknown vendor %q", f[1])
+		}
+		p.vendor = vendor
+	case "END-VENDOR":
+		p.vendor = nil
+	case "BEGIN-TLV":
+		if len(f) < 2 {
+			return errors.New("missing TLV name")
+		}
+		attr, ok := p.dict.Attribute(f[1])
+		if !ok || attr.Type != TypeTLV {
+			return fmt.Errorf("unknown TLV %q", f[1])
+		}
+		p.tlvs = append(p.tlvs, attr)
+	case "END-TLV":
+		if len(p.tlvs) > 0 {
+			p.tlvs = p.tlvs[:len(p.tlvs)-1]
+		}
+	case "ATTRIBUTE":
+		return p.parseAttribute(f)
+	case "VALUE":
+		return p.parseValue(f)
+	}
+	return nil
+}
+
+func (p *parser) parseVendor(f []string) error {
+	if len(f) < 3 {
+		return errors.New("invalid VENDOR line")
+	}
+	id, err := strconv.ParseUint(f[2], 0, 32)
+	if err != nil {
+		return fmt.Errorf("invalid vendor id %q", f[2])
+	}
+	vendor := &Vendor{
+		Name:       f[1],
+		ID:         uint32(id),
+		TypeSize:   1,
+		LengthSize: 1,
+		attributes: make(map[int]*Attribute),
+	}
+	if len(f) > 3 && strings.HasPrefix(f[3], "format=") {
+		parts := strings.Split(strings.TrimPrefix(f[3], "format="), ",")
+		if len(parts) < 2 {
+			return fmt.Errorf("invalid vendor format %q", f[3])
+		}
+		t, errT := strconv.Atoi(parts[0])
+		l, errL := strconv.Atoi(parts[1])
+		if errT != nil || errL != nil || (t != 1 && t != 2 && t != 4) || l < 0 || l > 2 {
+			return fmt.Errorf("invalid vendor format %q", f[3])
+		}
+		vendor.TypeSize, vendor.LengthSize = t, l
+		vendor.Continuation = len(parts) > 2 && parts[2] == "c"
+	}
+	if existing := p.dict.vendors[vendor.ID]; existing != nil {
+		// Keep attributes defined under a previous alias of the same vendor
+		vendor.attributes = existing.attributes
+	}
+	p.dict.vendors[vendor.ID] = vendor
+	p.dict.vendorNames[strings.ToLower(vendor.Name)] = vendor
+	return nil
+}
+
+func (p *parser) parseAttribute(f []string) error {
+	if len(f) < 4 {
+		return errors.New("invalid ATTRIBUTE line")
+	}
+	typ := strings.ToLower(f[3])
+	if i := strings.IndexByte(typ, '['); i >= 0 {
+		typ = typ[:i]
+	}
+	attr := &Attribute{Name: f[1], Type: DataType(typ), Vendor: p.vendor}
+
+	if len(f) > 4 {
+		for _, flag := range strings.Split(f[4], ",") {
+			key, val, _ := strings.Cut(flag, "=")
+			switch key {
+			case "has_tag":
+				attr.HasTag = true
+			case "encrypt":
+				attr.Encrypt, _ = strconv.Atoi(val) //nolint:errcheck
+			case "concat":
+				attr.Concat = true
+			case "array":
+				attr.Array = true
+			default:
+				// Old style trailing vendor name
+				if vendor, ok := p.dict.VendorByName(flag); ok {
+					attr.Vendor = vendor
+				}
+			}
+		}
+	}
+
+	oid := strings.Split(f[2], ".")
+	codes := make([]int, len(oid))
+	for i, s := range oid {
+		n, err := strconv.ParseInt(s, 0, 32)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid attribute number %q", f[2])
+		}
+		codes[i] = int(n)
+	}
+
+	var parent *Attribute
+	if len(p.tlvs) > 0 {
+		parent = p.tlvs[len(p.tlvs)-1]
+		attr.Vendor = parent.Vendor
+	}
+	for _, code := range codes[:len(codes)-1] {
+		var next *Attribute
+		switch {
+		case parent != nil:
+			next = parent.children[code]
+		case attr.Vendor != nil:
+			next = attr.Vendor.attributes[code]
+		default:
+			next = p.dict.attributes[code]
+		}
+		if next == nil {
+			return fmt.Errorf("unknown parent attribute in %q", f[2])
+		}
+		parent = next
+	}
+	attr.Code = codes[len(codes)-1]
+	attr.Parent = parent
+
+	switch {
+	case parent != nil:
+		if parent.children == nil {
+			parent.children = make(map[int]*Attribute)
+		}
+		parent.children[attr.Code] = attr
+	case attr.Vendor != nil:
+		attr.Vendor.attributes[attr.Code] = attr
+	default:
+		p.dict.attributes[attr.Code] = attr
+	}
+	p.dict.names[strings.ToLower(attr.Name)] = attr
+	return nil
+}
+
+func (p *parser) parseValue(f []string) error {
+	if len(f) < 4 {
+		return errors.New("invalid VALUE line")
+	}
+	n, err := strconv.ParseUint(f[3], 0, 64)
+	if err != nil {
+		signed, errSigned := strconv.ParseInt(f[3], 0, 64)
+		if errSigned != nil {
+			return fmt.Errorf("invalid value %q", f[3])
+		}
+		n = uint64(signed) //nolint:gosec // G115: two's complement of signed values
+	}
+	if attr, ok := p.dict.Attribute(f[1]); ok {
+		attr.addValue(f[2], n)
+	} else {
+		p.pending = append(p.pending, pendingValue{attr: f[1], name: f[2], value: n})
+	}
+	return nil
+}
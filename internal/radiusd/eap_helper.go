@@ -13,7 +13,8 @@ import (
 
 // EAPAuthHelper EAP authentication helper
 type EAPAuthHelper struct {
-	coordinator *eap.Coordinator
+	coordinator  *eap.Coordinator
+	stateManager eap.EAPStateManager
 }
 
 // NewEAPAuthHelper Create EAP authentication helper
@@ -37,7 +38,8 @@ func NewEAPAuthHelper(radiusService *RadiusService, allowedHandlers []string) *E
 	coordinator := eap.NewCoordinator(stateManager, pwdProvider, handlerRegistry, debug)
 
 	return &EAPAuthHelper{
-		coordinator: coordinator,
+		coordinator:  coordinator,
+		stateManager: stateManager,
 	}
 }
 
@@ -89,6 +91,11 @@ func (h *EAPAuthHelper) CleanupState(r *radius.Request) {
 	h.coordinator.CleanupState(r)
 }
 
+// StateManager returns the challenge state store shared with the coordinator
+func (h *EAPAuthHelper) StateManager() eap.EAPStateManager {
+	return h.stateManager
+}
+
 // GetCoordinator Get underlying coordinator(for advanced usage)
 func (h *EAPAuthHelper) GetCoordinator() *eap.Coordinator {
 	return h.coordinator
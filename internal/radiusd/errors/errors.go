@@ -223,6 +223,11 @@ func NewUserDisabledError() error {
 	return NewAuthError(app.MetricsRadiusRejectDisable, "user status is disabled")
 }
 
+// NewUserSuspendedError creates an error for accounts suspended by billing
+func NewUserSuspendedError() error {
+	return NewAuthError(app.MetricsRadiusRejectDisable, "user is suspended for insufficient balance")
+}
+
 // NewUserExpiredError creates an error for expired user accounts
 func NewUserExpiredError() error {
 	return NewAuthError(app.MetricsRadiusRejectExpire, "user expired")
@@ -233,6 +238,49 @@ func NewPasswordMismatchError() error {
 	return NewAuthError(app.MetricsRadiusRejectPasswdError, "password mismatch")
 }
 
+// NewPasswordSchemeError creates an error when the stored password scheme
+// cannot serve the authentication method of the request
+func NewPasswordSchemeError(method, scheme string) error {
+	return NewAuthError(app.MetricsRadiusRejectPasswdError,
+		fmt.Sprintf("%s authentication is not supported by the %s password of this user", method, scheme))
+}
+
+// NewLDAPError creates an error when the LDAP directory cannot authenticate the user
+func NewLDAPError(cause error) error {
+	return NewAuthErrorWithCause(app.MetricsRadiusRejectLdapError, "ldap authentication failed", cause)
+}
+
+// NewLDAPProfileError creates an error when no profile is mapped for the LDAP groups of a user
+func NewLDAPProfileError() error {
+	return NewAuthError(app.MetricsRadiusRejectLdapError, "no profile mapped for the ldap groups of this user")
+}
+
+// NewOTPError creates an error when the one-time code of a two-factor login is rejected
+func NewOTPError(message string) error {
+	return NewAuthError(app.MetricsRadiusRejectOtpError, message)
+}
+
+// NewHookRejectError creates an error when the HTTP authorization hook rejects the user
+func NewHookRejectError(reason string) error {
+	if reason == "" {
+		reason = "rejected by the authorization hook"
+	}
+	return NewAuthError(app.MetricsRadiusRejectUnauthorized, reason)
+}
+
+// NewHookError creates an error when the HTTP authorization hook cannot be consulted
+func NewHookError(cause error) error {
+	return NewAuthErrorWithCause(app.MetricsRadiusRejectHookError, "authorization hook unavailable", cause)
+}
+
+// NewPolicyRejectError creates an error when an authorization policy rule rejects the request
+func NewPolicyRejectError(message string) error {
+	if message == "" {
+		message = "rejected by authorization policy"
+	}
+	return NewAuthError(app.MetricsRadiusRejectPolicy, message)
+}
+
 // NewOnlineLimitError creates an error when online session limit is exceeded
 func NewOnlineLimitError(message string) error {
 	return NewAuthError(app.MetricsRadiusRejectLimit, message)
@@ -248,6 +296,31 @@ func NewVlanBindError() error {
 	return NewAuthError(app.MetricsRadiusRejectBindError, "vlan binding failed")
 }
 
+// NewLineBindError creates an error for access line binding failures
+func NewLineBindError() error {
+	return NewAuthError(app.MetricsRadiusRejectBindError, "line binding failed")
+}
+
+// NewNodeBindError creates an error for logins on the NAS of another node
+func NewNodeBindError() error {
+	return NewAuthError(app.MetricsRadiusRejectNode, "nas does not belong to the user node")
+}
+
+// NewLockoutError creates an error for requests of a locked user, station or NAS
+func NewLockoutError() error {
+	return NewAuthError(app.MetricsRadiusRejectLockout, "too many failed logins, try again later")
+}
+
+// NewTimeWindowError creates an error for logins outside the profile access window
+func NewTimeWindowError() error {
+	return NewAuthError(app.MetricsRadiusRejectTimeWindow, "login is not allowed at this time")
+}
+
+// NewCheckItemError creates an error for requests failing a custom check attribute
+func NewCheckItemError(attribute string) error {
+	return NewAuthError(app.MetricsRadiusRejectCheckItem, fmt.Sprintf("check item %s not matched", attribute))
+}
+
 // NewUnauthorizedNasError creates an error for unauthorized NAS access
 func NewUnauthorizedNasError(ip, identifier string, err error) error {
 	return NewAuthErrorWithCause(app.MetricsRadiusRejectUnauthorized,
@@ -157,6 +157,67 @@ func TestNewOnlineLimitError(t *testing.T) {
 	assert.Equal(t, customMessage, authErr.Message)
 }
 
+func TestNewLDAPError(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := NewLDAPError(cause)
+
+	authErr, ok := GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectLdapError, authErr.MetricsType)
+	assert.Equal(t, "ldap authentication failed", authErr.Message)
+	assert.ErrorIs(t, err, cause)
+
+	authErr, ok = GetAuthError(NewLDAPProfileError())
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectLdapError, authErr.MetricsType)
+}
+
+func TestNewOTPError(t *testing.T) {
+	authErr, ok := GetAuthError(NewOTPError("one-time code mismatch"))
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectOtpError, authErr.MetricsType)
+	assert.Equal(t, "one-time code mismatch", authErr.Message)
+}
+
+func TestNewHookErrors(t *testing.T) {
+	authErr, ok := GetAuthError(NewHookRejectError("credit limit reached"))
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectUnauthorized, authErr.MetricsType)
+	assert.Equal(t, "credit limit reached", authErr.Message)
+
+	authErr, ok = GetAuthError(NewHookRejectError(""))
+	assert.True(t, ok)
+	assert.Equal(t, "rejected by the authorization hook", authErr.Message)
+
+	cause := errors.New("connection refused")
+	err := NewHookError(cause)
+	authErr, ok = GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectHookError, authErr.MetricsType)
+	assert.ErrorIs(t, err, cause)
+}
+
+func TestNewPolicyRejectError(t *testing.T) {
+	authErr, ok := GetAuthError(NewPolicyRejectError("guest ssid closed"))
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectPolicy, authErr.MetricsType)
+	assert.Equal(t, "guest ssid closed", authErr.Message)
+
+	authErr, ok = GetAuthError(NewPolicyRejectError(""))
+	assert.True(t, ok)
+	assert.Equal(t, "rejected by authorization policy", authErr.Message)
+}
+
+func TestNewPasswordSchemeError(t *testing.T) {
+	err := NewPasswordSchemeError("chap", "bcrypt")
+	assert.NotNil(t, err)
+
+	authErr, ok := GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectPasswdError, authErr.MetricsType)
+	assert.Equal(t, "chap authentication is not supported by the bcrypt password of this user", authErr.Message)
+}
+
 func TestNewMacBindError(t *testing.T) {
 	err := NewMacBindError()
 	assert.NotNil(t, err)
@@ -177,6 +238,36 @@ func TestNewVlanBindError(t *testing.T) {
 	assert.Equal(t, "vlan binding failed", authErr.Message)
 }
 
+func TestNewLineBindError(t *testing.T) {
+	err := NewLineBindError()
+	assert.NotNil(t, err)
+
+	authErr, ok := GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectBindError, authErr.MetricsType)
+	assert.Equal(t, "line binding failed", authErr.Message)
+}
+
+func TestNewNodeBindError(t *testing.T) {
+	err := NewNodeBindError()
+	assert.NotNil(t, err)
+
+	authErr, ok := GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectNode, authErr.MetricsType)
+	assert.Equal(t, "nas does not belong to the user node", authErr.Message)
+}
+
+func TestNewLockoutError(t *testing.T) {
+	err := NewLockoutError()
+	assert.NotNil(t, err)
+
+	authErr, ok := GetAuthError(err)
+	assert.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectLockout, authErr.MetricsType)
+	assert.Equal(t, "too many failed logins, try again later", authErr.Message)
+}
+
 func TestNewUnauthorizedNasError(t *testing.T) {
 	ip := "192.168.1.1"
 	identifier := "nas-router-01"
@@ -0,0 +1,321 @@
+// Package httphook delegates authorization decisions and accounting events
+// to an external HTTP endpoint, in the spirit of FreeRADIUS rlm_rest. It is
+// configured through the httphook.* settings.
+package httphook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"layeh.com/radius"
+)
+
+var (
+	// ErrDisabled is returned when the hook or its endpoint is not configured
+	ErrDisabled = errors.New("http hook is disabled")
+	// ErrCircuitOpen is returned while the endpoint is skipped after repeated failures
+	ErrCircuitOpen = errors.New("http hook circuit is open")
+)
+
+// Authorization results
+const (
+	ResultAccept = "accept"
+	ResultReject = "reject"
+)
+
+// Accounting events
+const (
+	EventStart   = "start"
+	EventInterim = "interim"
+	EventStop    = "stop"
+)
+
+// maxBodySize limits how much of an endpoint answer is read
+const maxBodySize = 64 * 1024
+
+// pendingTTL bounds how long accepted reply attributes wait for the enhancer
+const pendingTTL = time.Minute
+
+// ConfigGetter reads the httphook.* settings, implemented by *app.ConfigManager
+type ConfigGetter interface {
+	GetString(category, name string) string
+	GetBool(category, name string) bool
+	GetInt64(category, name string) int64
+}
+
+// Config holds the hook settings
+type Config struct {
+	Enabled          bool
+	AuthURL          string
+	AcctURL          string
+	AuthHeader       string // Authorization header value, empty sends none
+	Timeout          time.Duration
+	FailOpen         bool // Accept logins while the endpoint is unavailable
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// LoadConfig reads the current settings. A nil getter yields a disabled config.
+func LoadConfig(cfg ConfigGetter) Config {
+	if cfg == nil {
+		return Config{}
+	}
+	c := Config{
+		Enabled:          cfg.GetBool("httphook", "Enabled"),
+		AuthURL:          strings.TrimSpace(cfg.GetString("httphook", "AuthURL")),
+		AcctURL:          strings.TrimSpace(cfg.GetString("httphook", "AcctURL")),
+		AuthHeader:       strings.TrimSpace(cfg.GetString("httphook", "AuthHeader")),
+		Timeout:          time.Duration(cfg.GetInt64("httphook", "Timeout")) * time.Millisecond,
+		FailOpen:         cfg.GetBool("httphook", "FailOpen"),
+		BreakerThreshold: int(cfg.GetInt64("httphook", "BreakerThreshold")),
+		BreakerCooldown:  time.Duration(cfg.GetInt64("httphook", "BreakerCooldown")) * time.Second,
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 2 * time.Second
+	}
+	if c.BreakerThreshold <= 0 {
+		c.BreakerThreshold = 5
+	}
+	if c.BreakerCooldown <= 0 {
+		c.BreakerCooldown = 30 * time.Second
+	}
+	return c
+}
+
+// AuthRequest is the body posted to the authorization endpoint
+type AuthRequest struct {
+	Username         string `json:"username"`
+	NasIP            string `json:"nas_ip"`
+	NasIdentifier    string `json:"nas_identifier"`
+	NasName          string `json:"nas_name"`
+	CallingStationID string `json:"calling_station_id"`
+	MacAddr          string `json:"mac_addr"`
+	Vlanid1          int64  `json:"vlanid1"`
+	Vlanid2          int64  `json:"vlanid2"`
+	ProfileID        int64  `json:"profile_id,string"`
+}
+
+// AuthResponse is the answer of the authorization endpoint
+type AuthResponse struct {
+	Result     string                 `json:"result"` // accept | reject
+	Reason     string                 `json:"reason"` // Reply-Message of a reject
+	ReplyAttrs []domain.AttributeItem `json:"reply_attrs"`
+}
+
+// AcctEvent is the body posted to the accounting endpoint
+type AcctEvent struct {
+	Event            string `json:"event"` // start | interim | stop
+	Username         string `json:"username"`
+	NasIP            string `json:"nas_ip"`
+	NasIdentifier    string `json:"nas_identifier"`
+	AcctSessionID    string `json:"acct_session_id"`
+	CallingStationID string `json:"calling_station_id"`
+	MacAddr          string `json:"mac_addr"`
+	Vlanid1          int64  `json:"vlanid1"`
+	Vlanid2          int64  `json:"vlanid2"`
+	FramedIP         string `json:"framed_ip"`
+	SessionTime      int    `json:"session_time"`
+	InputOctets      int64  `json:"input_octets"`
+	OutputOctets     int64  `json:"output_octets"`
+	TerminateCause   int    `json:"terminate_cause"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+// Client posts to the configured endpoints. The settings are read on every
+// call so changes apply without a restart; each endpoint has its own circuit
+// breaker.
+type Client struct {
+	cfg        ConfigGetter
+	httpClient *http.Client
+	authBreak  *breaker
+	acctBreak  *breaker
+
+	mu      sync.Mutex
+	pending map[*radius.Packet]pendingReply
+}
+
+type pendingReply struct {
+	items   []domain.AttributeItem
+	expires time.Time
+}
+
+// NewClient creates a Client reading its settings from cfg
+func NewClient(cfg ConfigGetter) *Client {
+	return &Client{
+		cfg:        cfg,
+		httpClient: &http.Client{},
+		authBreak:  &breaker{},
+		acctBreak:  &breaker{},
+		pending:    make(map[*radius.Packet]pendingReply),
+	}
+}
+
+// Config returns the current settings
+func (c *Client) Config() Config {
+	if c == nil {
+		return Config{}
+	}
+	return LoadConfig(c.cfg)
+}
+
+// Authorize posts req to the authorization endpoint. An answer that is not
+// a valid accept or reject is reported as an error.
+func (c *Client) Authorize(ctx context.Context, req AuthRequest) (*AuthResponse, error) {
+	cfg := c.Config()
+	if !cfg.Enabled || cfg.AuthURL == "" {
+		return nil, ErrDisabled
+	}
+
+	var resp AuthResponse
+	err := c.post(ctx, cfg, cfg.AuthURL, c.authBreak, req, func(body []byte) error {
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return fmt.Errorf("invalid answer: %w", err)
+		}
+		resp.Result = strings.ToLower(strings.TrimSpace(resp.Result))
+		if resp.Result != ResultAccept && resp.Result != ResultReject {
+			return fmt.Errorf("invalid result %q", resp.Result)
+		}
+		// Validate the reply attributes like the profile lists
+		raw, err := json.Marshal(resp.ReplyAttrs)
+		if err != nil {
+			return err
+		}
+		if resp.ReplyAttrs, err = domain.ParseReplyItems(string(raw)); err != nil {
+			return fmt.Errorf("invalid reply attributes: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Account posts an accounting event
+func (c *Client) Account(ctx context.Context, event AcctEvent) error {
+	cfg := c.Config()
+	if !cfg.Enabled || cfg.AcctURL == "" {
+		return ErrDisabled
+	}
+	return c.post(ctx, cfg, cfg.AcctURL, c.acctBreak, event, nil)
+}
+
+// SetPendingReply keeps the reply attributes of an accepted request until the
+// response enhancer applies them to resp
+func (c *Client) SetPendingReply(resp *radius.Packet, items []domain.AttributeItem) {
+	if resp == nil || len(items) == 0 {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Responses rejected by a later check are never enhanced
+	for packet, p := range c.pending {
+		if now.After(p.expires) {
+			delete(c.pending, packet)
+		}
+	}
+	c.pending[resp] = pendingReply{items: items, expires: now.Add(pendingTTL)}
+}
+
+// TakePendingReply returns and forgets the reply attributes kept for resp
+func (c *Client) TakePendingReply(resp *radius.Packet) []domain.AttributeItem {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.pending[resp]
+	if !ok {
+		return nil
+	}
+	delete(c.pending, resp)
+	return p.items
+}
+
+func (c *Client) post(ctx context.Context, cfg Config, url string, b *breaker, payload interface{}, decode func([]byte) error) error {
+	if !b.allow(time.Now(), cfg.BreakerThreshold, cfg.Timeout) {
+		return ErrCircuitOpen
+	}
+	err := c.do(ctx, cfg, url, payload, decode)
+	b.record(err == nil, time.Now(), cfg.BreakerThreshold, cfg.BreakerCooldown)
+	return err
+}
+
+func (c *Client) do(ctx context.Context, cfg Config, url string, payload interface{}, decode func([]byte) error) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if cfg.AuthHeader != "" {
+		req.Header.Set("Authorization", cfg.AuthHeader)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("http hook request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return fmt.Errorf("http hook response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("http hook status %d", resp.StatusCode)
+	}
+	if decode == nil {
+		return nil
+	}
+	return decode(data)
+}
+
+// breaker opens after threshold consecutive failures. Once the cooldown has
+// passed a single probe is let through; its success closes the circuit.
+type breaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func (b *breaker) allow(now time.Time, threshold int, probeTimeout time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < threshold {
+		return true
+	}
+	if now.Before(b.openUntil) {
+		return false
+	}
+	// Half-open: hold back other calls while the probe runs
+	b.openUntil = now.Add(probeTimeout)
+	return true
+}
+
+func (b *breaker) record(success bool, now time.Time, threshold int, cooldown time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if success {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return
+	}
+	b.failures++
+	if b.failures >= threshold {
+		b.openUntil = now.Add(cooldown)
+	}
+}
@@ -0,0 +1,174 @@
+package httphook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"layeh.com/radius"
+)
+
+type stubConfig struct {
+	strings map[string]string
+	bools   map[string]bool
+	ints    map[string]int64
+}
+
+func (c stubConfig) GetString(_, name string) string { return c.strings[name] }
+func (c stubConfig) GetBool(_, name string) bool     { return c.bools[name] }
+func (c stubConfig) GetInt64(_, name string) int64   { return c.ints[name] }
+
+func newStubConfig(authURL, acctURL string) stubConfig {
+	return stubConfig{
+		strings: map[string]string{"AuthURL": authURL, "AcctURL": acctURL, "AuthHeader": "Bearer token"},
+		bools:   map[string]bool{"Enabled": true},
+		ints:    map[string]int64{"Timeout": 200, "BreakerThreshold": 2, "BreakerCooldown": 60},
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert.False(t, LoadConfig(nil).Enabled)
+
+	cfg := LoadConfig(stubConfig{bools: map[string]bool{"Enabled": true}})
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, 2*time.Second, cfg.Timeout)
+	assert.Equal(t, 5, cfg.BreakerThreshold)
+	assert.Equal(t, 30*time.Second, cfg.BreakerCooldown)
+}
+
+func TestClient_Authorize(t *testing.T) {
+	var answer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		var req AuthRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "alice", req.Username)
+		assert.Equal(t, "aa:bb:cc:dd:ee:ff", req.MacAddr)
+		_, _ = w.Write([]byte(answer)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	client := NewClient(newStubConfig(server.URL, ""))
+	req := AuthRequest{Username: "alice", MacAddr: "aa:bb:cc:dd:ee:ff"}
+
+	answer = `{"result":"Accept","reply_attrs":[{"attribute":"Filter-Id","value":"gold"}]}`
+	resp, err := client.Authorize(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, ResultAccept, resp.Result)
+	require.Len(t, resp.ReplyAttrs, 1)
+	assert.Equal(t, "Filter-Id", resp.ReplyAttrs[0].Attribute)
+	assert.Equal(t, "=", resp.ReplyAttrs[0].Op)
+
+	answer = `{"result":"reject","reason":"credit limit reached"}`
+	resp, err = client.Authorize(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, ResultReject, resp.Result)
+	assert.Equal(t, "credit limit reached", resp.Reason)
+
+	for _, invalid := range []string{`not json`, `{"result":"maybe"}`, `{"result":"accept","reply_attrs":[{"attribute":"","value":"x"}]}`} {
+		answer = invalid
+		_, err = client.Authorize(context.Background(), req)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestClient_Disabled(t *testing.T) {
+	client := NewClient(nil)
+	_, err := client.Authorize(context.Background(), AuthRequest{})
+	assert.ErrorIs(t, err, ErrDisabled)
+	assert.ErrorIs(t, client.Account(context.Background(), AcctEvent{}), ErrDisabled)
+
+	// Enabled without an accounting endpoint
+	client = NewClient(newStubConfig("http://127.0.0.1:1", ""))
+	assert.ErrorIs(t, client.Account(context.Background(), AcctEvent{}), ErrDisabled)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(newStubConfig(server.URL, ""))
+	start := time.Now()
+	_, err := client.Authorize(context.Background(), AuthRequest{Username: "alice"})
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var calls int32
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"accept"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	client := NewClient(newStubConfig(server.URL, server.URL))
+	for i := 0; i < 2; i++ {
+		_, err := client.Authorize(context.Background(), AuthRequest{})
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+	}
+	_, err := client.Authorize(context.Background(), AuthRequest{})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// The accounting endpoint has its own breaker
+	assert.NotErrorIs(t, client.Account(context.Background(), AcctEvent{}), ErrCircuitOpen)
+
+	// After the cooldown a single successful probe closes the circuit
+	healthy.Store(true)
+	client.authBreak.openUntil = time.Now().Add(-time.Second)
+	_, err = client.Authorize(context.Background(), AuthRequest{})
+	require.NoError(t, err)
+	_, err = client.Authorize(context.Background(), AuthRequest{})
+	require.NoError(t, err)
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	b := &breaker{}
+	now := time.Now()
+	b.record(false, now, 1, time.Minute)
+	assert.False(t, b.allow(now, 1, time.Second))
+
+	later := now.Add(2 * time.Minute)
+	assert.True(t, b.allow(later, 1, time.Second))
+	// Other calls wait for the probe
+	assert.False(t, b.allow(later, 1, time.Second))
+	// A failed probe opens the circuit again
+	b.record(false, later, 1, time.Minute)
+	assert.False(t, b.allow(later.Add(30*time.Second), 1, time.Second))
+}
+
+func TestClient_PendingReply(t *testing.T) {
+	client := NewClient(nil)
+	resp := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	items := []domain.AttributeItem{{Attribute: "Class", Op: "=", Value: "gold"}}
+
+	client.SetPendingReply(resp, items)
+	assert.Equal(t, items, client.TakePendingReply(resp))
+	assert.Nil(t, client.TakePendingReply(resp))
+
+	// Expired entries are swept by the next call
+	stale := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	client.pending[stale] = pendingReply{items: items, expires: time.Now().Add(-time.Second)}
+	client.SetPendingReply(resp, items)
+	assert.Nil(t, client.TakePendingReply(stale))
+}
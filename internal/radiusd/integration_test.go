@@ -125,7 +125,7 @@ func TestRadiusIntegration(t *testing.T) {
 	// Initialize Radius Service
 	radiusService := NewRadiusService(appCtx)
 	defer radiusService.Release()
-	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo)
+	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
 	authService := NewAuthService(radiusService)
 	acctService := NewAcctService(radiusService)
 
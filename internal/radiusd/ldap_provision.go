@@ -0,0 +1,178 @@
+package radiusd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// StageLDAP checks the password of LDAP shadow users against the directory
+// and moves them to the profile of their current groups. It runs after the
+// two-factor stage, which checks the password itself when a code is needed.
+const StageLDAP = "ldap"
+
+// Shadow users never expire locally, the directory decides whether they may log in
+var ldapShadowExpireTime = time.Date(2099, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// newLDAPDirectory creates the directory reading the ldap.* settings
+func newLDAPDirectory(appCtx app.AppContext) *ldapauth.Directory {
+	if appCtx == nil || appCtx.ConfigMgr() == nil {
+		return ldapauth.NewDirectory(nil)
+	}
+	return ldapauth.NewDirectory(appCtx.ConfigMgr())
+}
+
+// isUserNotExists reports whether err rejects an unknown user
+func isUserNotExists(err error) bool {
+	authErr, ok := radiuserrors.GetAuthError(err)
+	return ok && authErr.MetricsType == app.MetricsRadiusRejectNotExists
+}
+
+// stageLDAP binds as an LDAP shadow user once per request, the password
+// validators are skipped afterwards
+func (s *AuthService) stageLDAP(ctx *AuthPipelineContext) error {
+	if ctx.IsEAP || ctx.IsMacAuth || ctx.PasswordVerified || ctx.User == nil || ctx.User.AuthSource != domain.AuthSourceLDAP {
+		return nil
+	}
+	password := rfc2865.UserPassword_GetString(ctx.Request.Packet)
+	// Non-PAP requests are refused by the ldap validator
+	if password == "" || ctx.DryRun {
+		return nil
+	}
+	if err := s.verifyFirstFactor(ctx, password); err != nil {
+		return err
+	}
+	ctx.PasswordVerified = true
+	return nil
+}
+
+// syncLDAPProfile moves a shadow user to the profile mapped to the groups
+// the directory returned on a successful bind, so group changes apply on the
+// next login. The updated user is returned, the cached one is left as is.
+func (s *AuthService) syncLDAPProfile(ctx context.Context, user *domain.RadiusUser, entry *ldapauth.Entry) (*domain.RadiusUser, error) {
+	profile, err := s.ldapProfile(entry)
+	if err != nil {
+		return nil, err
+	}
+	if profile.ID == user.ProfileId {
+		return user, nil
+	}
+	if err := s.UserRepo.UpdateField(ctx, user.Username, "profile_id", profile.ID); err != nil {
+		return nil, err
+	}
+	if err := s.UserRepo.UpdateField(ctx, user.Username, "node_id", profile.NodeId); err != nil {
+		return nil, err
+	}
+	s.userCache.Delete(userCacheKey(user.Username, false))
+
+	zap.L().Info("ldap shadow user profile changed",
+		zap.String("namespace", "radius"),
+		zap.String("username", user.Username),
+		zap.Int64("old_profile_id", user.ProfileId),
+		zap.String("profile", profile.Name),
+	)
+	updated := *user
+	updated.ProfileId = profile.ID
+	updated.NodeId = profile.NodeId
+	return &updated, nil
+}
+
+// ldapProfile returns the profile mapped to the groups of a directory entry
+func (s *AuthService) ldapProfile(entry *ldapauth.Entry) (*domain.RadiusProfile, error) {
+	profileName := s.ldapDirectory.Config().ProfileName(entry.Groups)
+	if profileName == "" {
+		return nil, radiuserrors.NewLDAPProfileError()
+	}
+	var profile domain.RadiusProfile
+	if err := s.appCtx.DB().Where("name = ?", profileName).First(&profile).Error; err != nil {
+		return nil, radiuserrors.NewLDAPError(fmt.Errorf("profile %q: %w", profileName, err))
+	}
+	return &profile, nil
+}
+
+// provisionLDAPUser authenticates an unknown PAP user against the LDAP
+// directory and creates the local shadow user on first login, so accounting
+// and the profile checks work as for local users. The bind checked the
+// password of the request, the caller need not check it again.
+func (s *AuthService) provisionLDAPUser(ctx context.Context, r *radius.Request, username string) (*domain.RadiusUser, error) {
+	// Only PAP carries a password the directory can verify
+	password := rfc2865.UserPassword_GetString(r.Packet)
+	if password == "" {
+		return nil, radiuserrors.NewUserNotExistsError()
+	}
+
+	entry, err := s.ldapDirectory.Authenticate(ctx, username, password)
+	switch {
+	case errors.Is(err, ldapauth.ErrUserNotFound):
+		return nil, radiuserrors.NewUserNotExistsError()
+	case errors.Is(err, ldapauth.ErrInvalidCredentials):
+		return nil, radiuserrors.NewPasswordMismatchError()
+	case err != nil:
+		return nil, radiuserrors.NewLDAPError(err)
+	}
+
+	profile, err := s.ldapProfile(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local password is never used, make it unguessable
+	unusable, err := passwd.Hash(passwd.SchemeBcrypt, common.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &domain.RadiusUser{
+		ID:              common.UUIDint64(),
+		NodeId:          profile.NodeId,
+		ProfileId:       profile.ID,
+		Realname:        entry.Realname,
+		Email:           entry.Email,
+		Mobile:          entry.Mobile,
+		Username:        username,
+		Password:        unusable,
+		AddrPool:        profile.AddrPool,
+		ActiveNum:       profile.ActiveNum,
+		UpRate:          profile.UpRate,
+		DownRate:        profile.DownRate,
+		Domain:          profile.Domain,
+		IPv6PrefixPool:  profile.IPv6PrefixPool,
+		BindMac:         profile.BindMac,
+		BindVlan:        profile.BindVlan,
+		ProfileLinkMode: domain.ProfileLinkModeDynamic,
+		AuthSource:      domain.AuthSourceLDAP,
+		ExpireTime:      ldapShadowExpireTime,
+		Status:          common.ENABLED,
+		Remark:          entry.DN,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := s.UserRepo.Create(ctx, user); err != nil {
+		// A concurrent first login may have created the user already
+		existing, getErr := s.UserRepo.GetByUsername(ctx, username)
+		if getErr != nil {
+			return nil, err
+		}
+		return s.syncLDAPProfile(ctx, existing, entry)
+	}
+
+	zap.L().Info("ldap shadow user created",
+		zap.String("namespace", "radius"),
+		zap.String("username", username),
+		zap.String("dn", entry.DN),
+		zap.String("profile", profile.Name),
+	)
+	return user, nil
+}
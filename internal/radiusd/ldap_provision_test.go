@@ -0,0 +1,237 @@
+package radiusd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth/ldaptest"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestStageLoadUser_ProvisionsLDAPUser(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	srv := ldaptest.NewServer(
+		ldaptest.Entry{
+			DN:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-secret",
+			Attrs: map[string][]string{
+				"uid":         {"alice"},
+				"displayName": {"Alice Liddell"},
+				"mail":        {"alice@example.com"},
+				"memberOf":    {"cn=staff,ou=groups,dc=example,dc=com"},
+			},
+		},
+		ldaptest.Entry{
+			DN:       "uid=bob,ou=people,dc=example,dc=com",
+			Password: "bob-secret",
+			Attrs:    map[string][]string{"uid": {"bob"}},
+		},
+	)
+	defer srv.Close()
+
+	cfg := appCtx.ConfigMgr()
+	require.NoError(t, cfg.Set("ldap", "Enabled", "true"))
+	require.NoError(t, cfg.Set("ldap", "URL", srv.URL))
+	require.NoError(t, cfg.Set("ldap", "BaseDN", "dc=example,dc=com"))
+	require.NoError(t, cfg.Set("ldap", "GroupProfiles", `{"staff": "staff-profile"}`))
+
+	profile := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "staff-profile", Status: common.ENABLED, ActiveNum: 2, UpRate: 2048, DownRate: 4096}
+	require.NoError(t, appCtx.DB().Create(profile).Error)
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	loadUser := func(username, password string) (*AuthPipelineContext, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, username) //nolint:errcheck
+		if password != "" {
+			_ = rfc2865.UserPassword_SetString(packet, password) //nolint:errcheck
+		}
+		ctx := NewAuthPipelineContext(authService, nil, &radius.Request{Packet: packet})
+		ctx.Username = username
+		return ctx, authService.stageLoadUser(ctx)
+	}
+	rejectMetric := func(err error) string {
+		authErr, ok := radiuserrors.GetAuthError(err)
+		require.True(t, ok, "expected auth error, got %v", err)
+		return authErr.MetricsType
+	}
+
+	// Wrong password creates nothing
+	_, err := loadUser("alice", "wrong")
+	assert.Equal(t, app.MetricsRadiusRejectPasswdError, rejectMetric(err))
+
+	// Users in no mapped group are rejected without a default profile
+	_, err = loadUser("bob", "bob-secret")
+	assert.Equal(t, app.MetricsRadiusRejectLdapError, rejectMetric(err))
+
+	// Unknown directory users stay unknown
+	_, err = loadUser("carol", "secret")
+	assert.Equal(t, app.MetricsRadiusRejectNotExists, rejectMetric(err))
+
+	var count int64
+	appCtx.DB().Model(&domain.RadiusUser{}).Count(&count)
+	assert.Zero(t, count)
+
+	// First successful login creates the shadow user, the bind checked the password
+	ctx, err := loadUser("alice", "alice-secret")
+	require.NoError(t, err)
+	require.NotNil(t, ctx.User)
+	assert.True(t, ctx.PasswordVerified)
+	assert.Equal(t, domain.AuthSourceLDAP, ctx.User.AuthSource)
+	assert.Equal(t, profile.ID, ctx.User.ProfileId)
+
+	var shadow domain.RadiusUser
+	require.NoError(t, appCtx.DB().Where("username = ?", "alice").First(&shadow).Error)
+	assert.Equal(t, "Alice Liddell", shadow.Realname)
+	assert.Equal(t, "alice@example.com", shadow.Email)
+	assert.Equal(t, 2, shadow.ActiveNum)
+	assert.Equal(t, domain.ProfileLinkModeDynamic, shadow.ProfileLinkMode)
+	assert.Equal(t, common.ENABLED, shadow.Status)
+	assert.NotEqual(t, "alice-secret", shadow.Password)
+
+	// Later logins find the shadow user, the password is checked by the ldap validator
+	ctx, err = loadUser("alice", "")
+	require.NoError(t, err)
+	assert.Equal(t, shadow.ID, ctx.User.ID)
+
+	// Nothing is provisioned while LDAP is disabled
+	require.NoError(t, cfg.Set("ldap", "Enabled", "false"))
+	_, err = loadUser("bob", "bob-secret")
+	assert.Equal(t, app.MetricsRadiusRejectNotExists, rejectMetric(err))
+}
+
+func TestStageLDAP_SyncsProfile(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	srv := ldaptest.NewServer(ldaptest.Entry{
+		DN:       "uid=alice,ou=people,dc=example,dc=com",
+		Password: "alice-secret",
+		Attrs: map[string][]string{
+			"uid":      {"alice"},
+			"memberOf": {"cn=staff,ou=groups,dc=example,dc=com", "cn=vpn,ou=groups,dc=example,dc=com"},
+		},
+	})
+	defer srv.Close()
+
+	cfg := appCtx.ConfigMgr()
+	require.NoError(t, cfg.Set("ldap", "Enabled", "true"))
+	require.NoError(t, cfg.Set("ldap", "URL", srv.URL))
+	require.NoError(t, cfg.Set("ldap", "BaseDN", "dc=example,dc=com"))
+	require.NoError(t, cfg.Set("ldap", "GroupProfiles", `{"staff": "staff-profile", "vpn": "vpn-profile"}`))
+
+	staff := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "staff-profile", Status: common.ENABLED, NodeId: 1}
+	vpn := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "vpn-profile", Status: common.ENABLED, NodeId: 2}
+	require.NoError(t, appCtx.DB().Create(staff).Error)
+	require.NoError(t, appCtx.DB().Create(vpn).Error)
+
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
+	authService := NewAuthService(radiusService)
+
+	login := func(password string) (*AuthPipelineContext, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, "alice")      //nolint:errcheck
+		_ = rfc2865.UserPassword_SetString(packet, password) //nolint:errcheck
+		ctx := NewAuthPipelineContext(authService, nil, &radius.Request{Packet: packet})
+		ctx.Username = "alice"
+		if err := authService.stageLoadUser(ctx); err != nil {
+			return ctx, err
+		}
+		if err := authService.stagePolicy(ctx); err != nil {
+			return ctx, err
+		}
+		return ctx, authService.stageLDAP(ctx)
+	}
+
+	// The first login binds once and maps the first matching group
+	ctx, err := login("alice-secret")
+	require.NoError(t, err)
+	assert.True(t, ctx.PasswordVerified)
+	assert.Equal(t, staff.ID, ctx.User.ProfileId)
+	assert.Len(t, srv.Binds(), 1)
+
+	// A changed mapping applies on the next login
+	require.NoError(t, cfg.Set("ldap", "GroupProfiles", `{"vpn": "vpn-profile", "staff": "staff-profile"}`))
+	ctx, err = login("alice-secret")
+	require.NoError(t, err)
+	assert.True(t, ctx.PasswordVerified)
+	assert.Equal(t, vpn.ID, ctx.User.ProfileId)
+	assert.Equal(t, int64(2), ctx.User.NodeId)
+	assert.Len(t, srv.Binds(), 2)
+
+	var shadow domain.RadiusUser
+	require.NoError(t, appCtx.DB().Where("username = ?", "alice").First(&shadow).Error)
+	assert.Equal(t, vpn.ID, shadow.ProfileId)
+
+	// A wrong password neither logs in nor changes the profile
+	require.NoError(t, cfg.Set("ldap", "GroupProfiles", `{"staff": "staff-profile"}`))
+	ctx, err = login("wrong")
+	assert.Error(t, err)
+	assert.False(t, ctx.PasswordVerified)
+	require.NoError(t, appCtx.DB().Where("username = ?", "alice").First(&shadow).Error)
+	assert.Equal(t, vpn.ID, shadow.ProfileId)
+
+	// Policy overrides apply to the group profile and survive the sync
+	gold := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "gold-profile", Status: common.ENABLED}
+	require.NoError(t, appCtx.DB().Create(gold).Error)
+	require.NoError(t, appCtx.DB().Create(&domain.PolicyRule{Name: "staff upgrade", Priority: 1, Status: common.ENABLED,
+		Action: domain.PolicyActionAccept, ProfileId: staff.ID, SetProfileId: gold.ID}).Error)
+	appCtx.PolicyCache().Invalidate()
+	ctx, err = login("alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, gold.ID, ctx.User.ProfileId, "rules are evaluated again for the new group profile")
+	ctx, err = login("alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, gold.ID, ctx.User.ProfileId)
+	require.NoError(t, appCtx.DB().Where("username = ?", "alice").First(&shadow).Error)
+	assert.Equal(t, staff.ID, shadow.ProfileId)
+
+	// A wrong password looking like password and code binds once
+	require.NoError(t, radiusService.UserRepo.UpdateField(ctx.Context, "alice", "totp_secret", "JBSWY3DPEHPK3PXP"))
+	radiusService.userCache.Delete(userCacheKey("alice", false))
+	failed := len(srv.FailedBinds())
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.UserName_SetString(packet, "alice")           //nolint:errcheck
+	_ = rfc2865.UserPassword_SetString(packet, "wrong123456") //nolint:errcheck
+	ctx = NewAuthPipelineContext(authService, nil, &radius.Request{Packet: packet})
+	ctx.Username = "alice"
+	require.NoError(t, authService.stageLoadUser(ctx))
+	assert.Error(t, authService.stageTOTP(ctx))
+	assert.Len(t, srv.FailedBinds(), failed+1)
+
+	// A simulated request neither binds nor syncs the profile
+	binds := len(srv.Binds())
+	_ = rfc2865.UserPassword_SetString(packet, "wrong") //nolint:errcheck
+	ctx = NewAuthPipelineContext(authService, &dryRunWriter{}, &radius.Request{Packet: packet})
+	ctx.Username, ctx.DryRun = "alice", true
+	require.NoError(t, authService.stageLoadUser(ctx))
+	require.NoError(t, authService.stageTOTP(ctx))
+	assert.Contains(t, ctx.takeNotes(), "LDAP bind not attempted in simulation, the password is not checked")
+	assert.Len(t, srv.Binds(), binds)
+	assert.Len(t, srv.FailedBinds(), failed+1)
+	require.NoError(t, radiusService.UserRepo.UpdateField(ctx.Context, "alice", "totp_secret", ""))
+	radiusService.userCache.Delete(userCacheKey("alice", false))
+
+	// Users left without a mapped group are rejected
+	require.NoError(t, cfg.Set("ldap", "GroupProfiles", `{"admins": "staff-profile"}`))
+	_, err = login("alice-secret")
+	authErr, ok := radiuserrors.GetAuthError(err)
+	require.True(t, ok, "expected auth error, got %v", err)
+	assert.Equal(t, app.MetricsRadiusRejectLdapError, authErr.MetricsType)
+}
@@ -0,0 +1,256 @@
+// Package ldapauth authenticates RADIUS users against an LDAP or Active
+// Directory server configured through the ldap.* settings.
+package ldapauth
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// ErrDisabled is returned when LDAP authentication is not enabled
+	ErrDisabled = errors.New("ldap authentication is disabled")
+	// ErrUserNotFound is returned when the user filter matches no entry
+	ErrUserNotFound = errors.New("ldap user not found")
+	// ErrInvalidCredentials is returned when the user bind is refused
+	ErrInvalidCredentials = errors.New("ldap invalid credentials")
+)
+
+// ConfigGetter reads the ldap.* settings, implemented by *app.ConfigManager
+type ConfigGetter interface {
+	GetString(category, name string) string
+	GetBool(category, name string) bool
+	GetInt64(category, name string) int64
+}
+
+// Config holds the LDAP settings
+type Config struct {
+	Enabled            bool
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	BaseDN             string
+	UserFilter         string
+	GroupAttribute     string
+	GroupProfiles      []GroupProfile // In the order of the setting, the first match wins
+	DefaultProfile     string
+	Timeout            time.Duration
+}
+
+// GroupProfile maps a group DN or CN to a profile name
+type GroupProfile struct {
+	Group   string
+	Profile string
+}
+
+// LoadConfig reads the current settings. A nil getter yields a disabled config.
+func LoadConfig(cfg ConfigGetter) Config {
+	if cfg == nil {
+		return Config{}
+	}
+	c := Config{
+		Enabled:            cfg.GetBool("ldap", "Enabled"),
+		URL:                strings.TrimSpace(cfg.GetString("ldap", "URL")),
+		StartTLS:           cfg.GetBool("ldap", "StartTLS"),
+		InsecureSkipVerify: cfg.GetBool("ldap", "InsecureSkipVerify"),
+		BindDN:             cfg.GetString("ldap", "BindDN"),
+		BindPassword:       cfg.GetString("ldap", "BindPassword"),
+		BaseDN:             cfg.GetString("ldap", "BaseDN"),
+		UserFilter:         cfg.GetString("ldap", "UserFilter"),
+		GroupAttribute:     cfg.GetString("ldap", "GroupAttribute"),
+		DefaultProfile:     strings.TrimSpace(cfg.GetString("ldap", "DefaultProfile")),
+		Timeout:            time.Duration(cfg.GetInt64("ldap", "Timeout")) * time.Second,
+	}
+	if c.UserFilter == "" {
+		c.UserFilter = "(uid={username})"
+	}
+	if c.GroupAttribute == "" {
+		c.GroupAttribute = "memberOf"
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	if raw := strings.TrimSpace(cfg.GetString("ldap", "GroupProfiles")); raw != "" {
+		c.GroupProfiles, _ = parseGroupProfiles(raw) //nolint:errcheck // invalid JSON maps no groups
+	}
+	return c
+}
+
+// parseGroupProfiles decodes the group mapping JSON object keeping the order
+// of its keys, which a Go map would lose
+func parseGroupProfiles(raw string) ([]GroupProfile, error) {
+	dec := json.NewDecoder(strings.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("ldap group profiles must be a JSON object")
+	}
+	var mappings []GroupProfile
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		group, _ := tok.(string)
+		var profile string
+		if err := dec.Decode(&profile); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, GroupProfile{Group: group, Profile: profile})
+	}
+	return mappings, nil
+}
+
+// ProfileName returns the profile of the first mapping, in the order of the
+// setting, that matches one of the groups, or the default profile. Groups
+// match on their full DN or on their CN.
+func (c Config) ProfileName(groups []string) string {
+	for _, mapping := range c.GroupProfiles {
+		for _, group := range groups {
+			if strings.EqualFold(mapping.Group, group) || strings.EqualFold(mapping.Group, groupCN(group)) {
+				return mapping.Profile
+			}
+		}
+	}
+	return c.DefaultProfile
+}
+
+// Entry is the directory entry of an authenticated user
+type Entry struct {
+	DN       string
+	Realname string
+	Email    string
+	Mobile   string
+	Groups   []string
+}
+
+// Directory authenticates users against the configured LDAP server. The
+// settings are read on every call so changes apply without a restart.
+type Directory struct {
+	cfg ConfigGetter
+}
+
+// NewDirectory creates a Directory reading its settings from cfg
+func NewDirectory(cfg ConfigGetter) *Directory {
+	return &Directory{cfg: cfg}
+}
+
+// Config returns the current settings
+func (d *Directory) Config() Config {
+	if d == nil {
+		return Config{}
+	}
+	return LoadConfig(d.cfg)
+}
+
+// Enabled reports whether LDAP authentication is configured
+func (d *Directory) Enabled() bool {
+	c := d.Config()
+	return c.Enabled && c.URL != ""
+}
+
+// Authenticate locates username with the user filter and binds as the found
+// entry with password.
+func (d *Directory) Authenticate(ctx context.Context, username, password string) (*Entry, error) {
+	c := d.Config()
+	if !c.Enabled || c.URL == "" {
+		return nil, ErrDisabled
+	}
+	// An empty password would be an unauthenticated bind, which succeeds
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }() //nolint:errcheck
+
+	if c.BindDN != "" {
+		if err := conn.Bind(c.BindDN, c.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap service bind: %w", err)
+		}
+	}
+
+	filter := strings.ReplaceAll(c.UserFilter, "{username}", ldap.EscapeFilter(username))
+	result, err := conn.Search(ldap.NewSearchRequest(
+		c.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(c.Timeout/time.Second), false, filter,
+		[]string{"cn", "displayName", "mail", "mobile", "telephoneNumber", c.GroupAttribute},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("ldap search: %w", err)
+	}
+	if result == nil || len(result.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	if len(result.Entries) > 1 {
+		return nil, fmt.Errorf("ldap user filter matched more than one entry for %q", username)
+	}
+	found := result.Entries[0]
+
+	if err := conn.Bind(found.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap user bind: %w", err)
+	}
+
+	return &Entry{
+		DN:       found.DN,
+		Realname: firstNonEmpty(found.GetAttributeValue("displayName"), found.GetAttributeValue("cn")),
+		Email:    found.GetAttributeValue("mail"),
+		Mobile:   firstNonEmpty(found.GetAttributeValue("mobile"), found.GetAttributeValue("telephoneNumber")),
+		Groups:   found.GetAttributeValues(c.GroupAttribute),
+	}, nil
+}
+
+func (c Config) dial(ctx context.Context) (*ldap.Conn, error) {
+	dialer := &net.Dialer{Timeout: c.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify} //nolint:gosec // opt-in via ldap.InsecureSkipVerify
+
+	conn, err := ldap.DialURL(c.URL, ldap.DialWithDialer(dialer), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("ldap connect: %w", err)
+	}
+	conn.SetTimeout(c.Timeout)
+
+	if c.StartTLS && strings.HasPrefix(strings.ToLower(c.URL), "ldap://") {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close() //nolint:errcheck
+			return nil, fmt.Errorf("ldap starttls: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// groupCN returns the value of the first RDN of a group DN
+func groupCN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 || len(parsed.RDNs[0].Attributes) == 0 {
+		return ""
+	}
+	return parsed.RDNs[0].Attributes[0].Value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
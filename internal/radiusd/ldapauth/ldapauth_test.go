@@ -0,0 +1,130 @@
+package ldapauth
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth/ldaptest"
+)
+
+type mapConfig map[string]string
+
+func (m mapConfig) GetString(category, name string) string { return m[category+"."+name] }
+func (m mapConfig) GetBool(category, name string) bool     { return m[category+"."+name] == "true" }
+func (m mapConfig) GetInt64(category, name string) int64 {
+	v, _ := strconv.ParseInt(m[category+"."+name], 10, 64) //nolint:errcheck
+	return v
+}
+
+func newTestDirectory(t *testing.T) (*ldaptest.Server, mapConfig) {
+	t.Helper()
+	srv := ldaptest.NewServer(
+		ldaptest.Entry{DN: "cn=radius,ou=services,dc=example,dc=com", Password: "service-secret"},
+		ldaptest.Entry{
+			DN:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-secret",
+			Attrs: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"alice"},
+				"cn":          {"Alice"},
+				"displayName": {"Alice Liddell"},
+				"mail":        {"alice@example.com"},
+				"memberOf":    {"cn=staff,ou=groups,dc=example,dc=com", "cn=vpn,ou=groups,dc=example,dc=com"},
+			},
+		},
+		ldaptest.Entry{
+			DN:       "uid=bob,ou=people,dc=example,dc=com",
+			Password: "bob-secret",
+			Attrs: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"bob"},
+				"cn":          {"Bob"},
+			},
+		},
+	)
+	srv.RequireBind = true
+	t.Cleanup(srv.Close)
+
+	return srv, mapConfig{
+		"ldap.Enabled":      "true",
+		"ldap.URL":          srv.URL,
+		"ldap.BindDN":       "cn=radius,ou=services,dc=example,dc=com",
+		"ldap.BindPassword": "service-secret",
+		"ldap.BaseDN":       "ou=people,dc=example,dc=com",
+		"ldap.UserFilter":   "(&(objectClass=person)(uid={username}))",
+		"ldap.Timeout":      "2",
+	}
+}
+
+func TestDirectoryAuthenticate(t *testing.T) {
+	srv, cfg := newTestDirectory(t)
+	dir := NewDirectory(cfg)
+	require.True(t, dir.Enabled())
+
+	entry, err := dir.Authenticate(context.Background(), "alice", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "uid=alice,ou=people,dc=example,dc=com", entry.DN)
+	assert.Equal(t, "Alice Liddell", entry.Realname)
+	assert.Equal(t, "alice@example.com", entry.Email)
+	assert.Len(t, entry.Groups, 2)
+	assert.Contains(t, srv.Binds(), "uid=alice,ou=people,dc=example,dc=com")
+
+	_, err = dir.Authenticate(context.Background(), "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = dir.Authenticate(context.Background(), "alice", "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = dir.Authenticate(context.Background(), "carol", "secret")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+
+	// The username is escaped, so it cannot widen the filter
+	_, err = dir.Authenticate(context.Background(), "*", "bob-secret")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestDirectoryAuthenticate_ServiceBindFails(t *testing.T) {
+	_, cfg := newTestDirectory(t)
+	cfg["ldap.BindPassword"] = "wrong"
+
+	_, err := NewDirectory(cfg).Authenticate(context.Background(), "alice", "alice-secret")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestDirectoryAuthenticate_Disabled(t *testing.T) {
+	_, cfg := newTestDirectory(t)
+	cfg["ldap.Enabled"] = "false"
+
+	dir := NewDirectory(cfg)
+	assert.False(t, dir.Enabled())
+	_, err := dir.Authenticate(context.Background(), "alice", "alice-secret")
+	assert.ErrorIs(t, err, ErrDisabled)
+
+	assert.False(t, NewDirectory(nil).Enabled())
+}
+
+func TestConfigProfileName(t *testing.T) {
+	cfg := LoadConfig(mapConfig{
+		"ldap.GroupProfiles":  `{"cn=staff,ou=groups,dc=example,dc=com": "staff", "Students": "students"}`,
+		"ldap.DefaultProfile": "guest",
+	})
+
+	assert.Equal(t, "staff", cfg.ProfileName([]string{"cn=vpn,ou=groups,dc=example,dc=com", "CN=Staff,OU=Groups,DC=example,DC=com"}))
+	assert.Equal(t, "students", cfg.ProfileName([]string{"cn=students,ou=groups,dc=example,dc=com"}))
+	assert.Equal(t, "guest", cfg.ProfileName([]string{"cn=vpn,ou=groups,dc=example,dc=com"}))
+	assert.Equal(t, "guest", cfg.ProfileName(nil))
+
+	// A user in several mapped groups gets the profile mapped first
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, "staff", cfg.ProfileName([]string{"cn=students,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"}))
+	}
+
+	// Invalid JSON maps no groups
+	cfg = LoadConfig(mapConfig{"ldap.GroupProfiles": `["staff"]`, "ldap.DefaultProfile": "guest"})
+	assert.Empty(t, cfg.GroupProfiles)
+	assert.Equal(t, "guest", cfg.ProfileName([]string{"cn=staff,ou=groups,dc=example,dc=com"}))
+}
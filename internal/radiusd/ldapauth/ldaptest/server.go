@@ -0,0 +1,252 @@
+// Package ldaptest provides an in-process LDAP server for tests. It answers
+// simple binds and subtree searches with and/or/not, equality and presence
+// filters, which is all the ldapauth package needs.
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP protocol operations and result codes
+const (
+	opBindRequest       = 0
+	opBindResponse      = 1
+	opUnbindRequest     = 2
+	opSearchRequest     = 3
+	opSearchResultEntry = 4
+	opSearchResultDone  = 5
+
+	resultSuccess            = 0
+	resultProtocolError      = 2
+	resultInvalidCredentials = 49
+	resultInsufficientAccess = 50
+)
+
+// Entry is a directory entry served by the Server
+type Entry struct {
+	DN       string
+	Password string // Simple bind password, empty refuses binds
+	Attrs    map[string][]string
+}
+
+// Server is an in-process LDAP server listening on the loopback interface
+type Server struct {
+	URL string
+	// RequireBind refuses anonymous searches, like Active Directory does
+	RequireBind bool
+
+	listener net.Listener
+	mu       sync.Mutex
+	entries  []Entry
+	binds    []string
+	failures []string
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server serving entries. It panics when it cannot listen.
+func NewServer(entries ...Entry) *Server {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("ldaptest: failed to listen: %v", err))
+	}
+	s := &Server{
+		URL:      "ldap://" + ln.Addr().String(),
+		listener: ln,
+		entries:  entries,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Close stops the server
+func (s *Server) Close() {
+	_ = s.listener.Close() //nolint:errcheck
+	s.wg.Wait()
+}
+
+// Binds returns the DNs of all successful binds
+func (s *Server) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+// FailedBinds returns the DNs of all binds refused for invalid credentials
+func (s *Server) FailedBinds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.failures...)
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }() //nolint:errcheck
+
+	bound := false
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, _ := packet.Children[0].Value.(int64) //nolint:errcheck
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case opBindRequest:
+			code := s.bind(op)
+			bound = code == resultSuccess && len(op.Children) > 1 && op.Children[1].Value != ""
+			s.reply(conn, messageID, opBindResponse, code)
+		case opSearchRequest:
+			if s.RequireBind && !bound {
+				s.reply(conn, messageID, opSearchResultDone, resultInsufficientAccess)
+				continue
+			}
+			s.search(conn, messageID, op)
+		case opUnbindRequest:
+			return
+		default:
+			s.reply(conn, messageID, opSearchResultDone, resultProtocolError)
+		}
+	}
+}
+
+func (s *Server) bind(op *ber.Packet) int {
+	if len(op.Children) < 3 {
+		return resultProtocolError
+	}
+	dn, _ := op.Children[1].Value.(string) //nolint:errcheck
+	password := op.Children[2].Data.String()
+	if dn == "" && password == "" {
+		return resultSuccess // anonymous
+	}
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			s.mu.Lock()
+			s.binds = append(s.binds, e.DN)
+			s.mu.Unlock()
+			return resultSuccess
+		}
+	}
+	s.mu.Lock()
+	s.failures = append(s.failures, dn)
+	s.mu.Unlock()
+	return resultInvalidCredentials
+}
+
+func (s *Server) search(conn net.Conn, messageID int64, op *ber.Packet) {
+	if len(op.Children) < 8 {
+		s.reply(conn, messageID, opSearchResultDone, resultProtocolError)
+		return
+	}
+	baseDN, _ := op.Children[0].Value.(string) //nolint:errcheck
+	filter := op.Children[6]
+
+	for _, e := range s.entries {
+		if !inSubtree(e.DN, baseDN) || !matches(filter, e) {
+			continue
+		}
+		result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, opSearchResultEntry, nil, "Search Result Entry")
+		result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range e.Attrs {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, v := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+			}
+			attr.AppendChild(set)
+			attrs.AppendChild(attr)
+		}
+		result.AppendChild(attrs)
+		s.write(conn, messageID, result)
+	}
+	s.reply(conn, messageID, opSearchResultDone, resultSuccess)
+}
+
+func (s *Server) reply(conn net.Conn, messageID int64, op ber.Tag, code int) {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, op, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	s.write(conn, messageID, result)
+}
+
+func (s *Server) write(conn net.Conn, messageID int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	envelope.AppendChild(op)
+	_, _ = conn.Write(envelope.Bytes()) //nolint:errcheck
+}
+
+func inSubtree(dn, baseDN string) bool {
+	dn, baseDN = strings.ToLower(dn), strings.ToLower(baseDN)
+	return baseDN == "" || dn == baseDN || strings.HasSuffix(dn, ","+baseDN)
+}
+
+// matches evaluates the RFC 4511 filter choices used by ldapauth
+func matches(filter *ber.Packet, e Entry) bool {
+	switch filter.Tag {
+	case 0: // and
+		for _, child := range filter.Children {
+			if !matches(child, e) {
+				return false
+			}
+		}
+		return true
+	case 1: // or
+		for _, child := range filter.Children {
+			if matches(child, e) {
+				return true
+			}
+		}
+		return false
+	case 2: // not
+		return len(filter.Children) == 1 && !matches(filter.Children[0], e)
+	case 3: // equalityMatch
+		if len(filter.Children) != 2 {
+			return false
+		}
+		name, _ := filter.Children[0].Value.(string)  //nolint:errcheck
+		value, _ := filter.Children[1].Value.(string) //nolint:errcheck
+		for _, v := range attrValues(e, name) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case 7: // present
+		return len(attrValues(e, filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func attrValues(e Entry, name string) []string {
+	for k, values := range e.Attrs {
+		if strings.EqualFold(k, name) {
+			return values
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package radiusd
+
+import (
+	"errors"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/lockout"
+	eap "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
+	"go.uber.org/zap"
+)
+
+// StageLockout rejects the requests of a locked username, calling station or
+// NAS before the user is loaded or any password is checked.
+const StageLockout = "lockout"
+
+// newLockoutManager creates the lockout manager of the service, disabled
+// without a database or settings
+func newLockoutManager(appCtx app.AppContext) *lockout.Manager {
+	if appCtx == nil || appCtx.DB() == nil || appCtx.ConfigMgr() == nil {
+		return lockout.NewManager(nil, nil)
+	}
+	return lockout.NewManager(appCtx.DB(), appCtx.ConfigMgr())
+}
+
+func (s *AuthService) stageLockout(ctx *AuthPipelineContext) error {
+	if !s.lockouts.Config().Enabled {
+		return nil
+	}
+	locked, err := s.lockouts.Locked(ctx.Context, lockoutSubjects(ctx))
+	if err != nil {
+		// Lockouts are a protection on top of the authentication, a storage
+		// failure must not reject every login
+		zap.L().Error("query lockouts failed",
+			zap.String("namespace", "radius"),
+			zap.String("username", ctx.Username),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if locked != nil {
+		zap.L().Debug("radius login locked",
+			zap.String("namespace", "radius"),
+			zap.String("username", ctx.Username),
+			zap.String("scope", locked.Scope),
+			zap.String("subject", locked.Subject),
+			zap.Time("locked_until", locked.LockedUntil),
+		)
+		return radiuserrors.NewLockoutError()
+	}
+	return nil
+}
+
+// recordLockoutFailure counts a rejected login. Only rejects pointing at
+// guessed credentials count, so an expired or misconfigured account does not
+// lock itself out.
+func (s *AuthService) recordLockoutFailure(ctx *AuthPipelineContext, err error) {
+	if err == nil || !isCredentialError(err) || !s.lockouts.Config().Enabled {
+		return
+	}
+	if _, err := s.lockouts.Fail(ctx.Context, lockoutSubjects(ctx), err.Error()); err != nil {
+		zap.L().Error("record login failure failed",
+			zap.String("namespace", "radius"),
+			zap.String("username", ctx.Username),
+			zap.Error(err),
+		)
+	}
+}
+
+// recordLockoutSuccess clears the failure counters of an accepted login
+func (s *AuthService) recordLockoutSuccess(ctx *AuthPipelineContext) {
+	if !s.lockouts.Config().Enabled {
+		return
+	}
+	if err := s.lockouts.Succeed(ctx.Context, lockoutSubjects(ctx)); err != nil {
+		zap.L().Error("clear login failures failed",
+			zap.String("namespace", "radius"),
+			zap.String("username", ctx.Username),
+			zap.Error(err),
+		)
+	}
+}
+
+// lockoutSubjects returns the username, station and NAS of a request. The
+// station is the MAC address parsed by the vendor when there is one.
+func lockoutSubjects(ctx *AuthPipelineContext) lockout.Subjects {
+	station := ctx.CallingStationID
+	if ctx.VendorRequest != nil && ctx.VendorRequest.MacAddr != "" {
+		station = ctx.VendorRequest.MacAddr
+	}
+	return lockout.Subjects{
+		Username: ctx.Username,
+		Station:  lockout.StationSubject(station),
+		NasIP:    ctx.RemoteIP,
+	}
+}
+
+// isCredentialError reports whether err rejects an unknown user or a wrong
+// password or one-time code
+func isCredentialError(err error) bool {
+	if errors.Is(err, eap.ErrPasswordMismatch) {
+		return true
+	}
+	authErr, ok := radiuserrors.GetAuthError(err)
+	if !ok {
+		return false
+	}
+	switch authErr.MetricsType {
+	case app.MetricsRadiusRejectNotExists,
+		app.MetricsRadiusRejectPasswdError,
+		app.MetricsRadiusRejectOtpError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,358 @@
+// Package lockout locks usernames, calling stations and NAS devices after
+// repeated failed logins. Counters and lockouts are kept in the
+// radius_lockout table so every instance applies them and they survive
+// restarts. It is configured through the lockout.* settings.
+package lockout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notifyTimeout bounds a call to the notification endpoint
+const notifyTimeout = 5 * time.Second
+
+// ConfigGetter reads the lockout.* settings, implemented by *app.ConfigManager
+type ConfigGetter interface {
+	GetString(category, name string) string
+	GetBool(category, name string) bool
+	GetInt64(category, name string) int64
+}
+
+// Config holds the lockout settings
+type Config struct {
+	Enabled            bool
+	MaxFailures        int // Failures that lock a username
+	StationMaxFailures int // Failures that lock a calling station, 0 disables
+	NasMaxFailures     int // Failures that lock a NAS, 0 disables
+	Window             time.Duration
+	Duration           time.Duration // First lockout, doubled by every further one
+	MaxDuration        time.Duration
+	NotifyURL          string
+}
+
+// LoadConfig reads the current settings. A nil getter yields a disabled config.
+func LoadConfig(cfg ConfigGetter) Config {
+	if cfg == nil {
+		return Config{}
+	}
+	c := Config{
+		Enabled:            cfg.GetBool("lockout", "Enabled"),
+		MaxFailures:        int(cfg.GetInt64("lockout", "MaxFailures")),
+		StationMaxFailures: int(cfg.GetInt64("lockout", "StationMaxFailures")),
+		NasMaxFailures:     int(cfg.GetInt64("lockout", "NasMaxFailures")),
+		Window:             time.Duration(cfg.GetInt64("lockout", "WindowSeconds")) * time.Second,
+		Duration:           time.Duration(cfg.GetInt64("lockout", "DurationSeconds")) * time.Second,
+		MaxDuration:        time.Duration(cfg.GetInt64("lockout", "MaxDurationSeconds")) * time.Second,
+		NotifyURL:          strings.TrimSpace(cfg.GetString("lockout", "NotifyURL")),
+	}
+	if c.MaxFailures <= 0 {
+		c.MaxFailures = 5
+	}
+	if c.StationMaxFailures < 0 {
+		c.StationMaxFailures = 0
+	}
+	if c.NasMaxFailures < 0 {
+		c.NasMaxFailures = 0
+	}
+	if c.Window <= 0 {
+		c.Window = 15 * time.Minute
+	}
+	if c.Duration <= 0 {
+		c.Duration = 5 * time.Minute
+	}
+	if c.MaxDuration < c.Duration {
+		c.MaxDuration = c.Duration
+	}
+	return c
+}
+
+// Threshold returns the failures that lock a subject of scope, zero when
+// the scope is not counted
+func (c Config) Threshold(scope string) int {
+	switch scope {
+	case domain.LockoutScopeUsername:
+		return c.MaxFailures
+	case domain.LockoutScopeStation:
+		return c.StationMaxFailures
+	case domain.LockoutScopeNas:
+		return c.NasMaxFailures
+	}
+	return 0
+}
+
+// LockDuration returns how long the level-th lockout of a subject lasts
+func (c Config) LockDuration(level int) time.Duration {
+	d := c.Duration
+	for i := 1; i < level && d < c.MaxDuration; i++ {
+		d *= 2
+	}
+	if d > c.MaxDuration {
+		d = c.MaxDuration
+	}
+	return d
+}
+
+// Subjects identifies the origin of a login attempt. Empty fields are not
+// counted.
+type Subjects struct {
+	Username string
+	Station  string // See StationSubject
+	NasIP    string
+}
+
+func (s Subjects) each(fn func(scope, subject string)) {
+	if s.Username != "" {
+		fn(domain.LockoutScopeUsername, s.Username)
+	}
+	if s.Station != "" {
+		fn(domain.LockoutScopeStation, s.Station)
+	}
+	if s.NasIP != "" {
+		fn(domain.LockoutScopeNas, s.NasIP)
+	}
+}
+
+// StationSubject returns the station subject of a Calling-Station-Id: the
+// normalized MAC address when it is one, the trimmed value otherwise, e.g.
+// the source IP of a VPN client.
+func StationSubject(callingStationID string) string {
+	if mac := domain.NormalizeMac(callingStationID); mac != "" {
+		return mac
+	}
+	return strings.ToLower(strings.TrimSpace(callingStationID))
+}
+
+// Event is posted to the notification URL when a lockout starts
+type Event struct {
+	Scope       string    `json:"scope"`
+	Subject     string    `json:"subject"`
+	Level       int       `json:"level"`
+	LockedUntil time.Time `json:"locked_until"`
+	Reason      string    `json:"reason"`
+	Username    string    `json:"username"`
+	Station     string    `json:"station"`
+	NasIP       string    `json:"nas_ip"`
+}
+
+// Manager records failed logins and answers whether a login is locked. The
+// settings are read on every call so changes apply without a restart.
+type Manager struct {
+	db         *gorm.DB
+	cfg        ConfigGetter
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewManager creates a Manager storing its state in db
+func NewManager(db *gorm.DB, cfg ConfigGetter) *Manager {
+	return &Manager{
+		db:         db,
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: notifyTimeout},
+		now:        time.Now,
+	}
+}
+
+// Config returns the current settings. A manager without a database is
+// always disabled.
+func (m *Manager) Config() Config {
+	if m == nil || m.db == nil {
+		return Config{}
+	}
+	return LoadConfig(m.cfg)
+}
+
+// Locked returns the active lockout of any of the subjects, or nil when the
+// login may proceed
+func (m *Manager) Locked(ctx context.Context, s Subjects) (*domain.RadiusLockout, error) {
+	if !m.Config().Enabled {
+		return nil, nil
+	}
+
+	db := m.db.WithContext(ctx)
+	match := db.Where("1 = 0")
+	s.each(func(scope, subject string) {
+		match = match.Or("scope = ? AND subject = ?", scope, subject)
+	})
+
+	var lockout domain.RadiusLockout
+	err := db.Where(match).Where("locked_until > ?", m.now()).
+		Order("locked_until DESC").First(&lockout).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// Fail records a failed login against the counted subjects and returns the
+// lockouts it started. A notification is sent for each of them.
+func (m *Manager) Fail(ctx context.Context, s Subjects, reason string) ([]domain.RadiusLockout, error) {
+	cfg := m.Config()
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+
+	var started []domain.RadiusLockout
+	var errs []error
+	s.each(func(scope, subject string) {
+		threshold := cfg.Threshold(scope)
+		if threshold <= 0 {
+			return
+		}
+		lockout, err := m.fail(ctx, cfg, scope, subject, threshold, reason)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", scope, subject, err))
+			return
+		}
+		if lockout != nil {
+			started = append(started, *lockout)
+		}
+	})
+
+	for _, lockout := range started {
+		zap.L().Warn("radius login locked",
+			zap.String("namespace", "radius"),
+			zap.String("scope", lockout.Scope),
+			zap.String("subject", lockout.Subject),
+			zap.Int("level", lockout.Level),
+			zap.Time("locked_until", lockout.LockedUntil),
+		)
+		if cfg.NotifyURL != "" {
+			go m.notify(cfg.NotifyURL, Event{
+				Scope:       lockout.Scope,
+				Subject:     lockout.Subject,
+				Level:       lockout.Level,
+				LockedUntil: lockout.LockedUntil,
+				Reason:      lockout.Reason,
+				Username:    s.Username,
+				Station:     s.Station,
+				NasIP:       s.NasIP,
+			})
+		}
+	}
+	return started, errors.Join(errs...)
+}
+
+// fail counts one failure of a subject and returns the lockout when it
+// reached the threshold
+func (m *Manager) fail(ctx context.Context, cfg Config, scope, subject string, threshold int, reason string) (*domain.RadiusLockout, error) {
+	now := m.now()
+	var locked bool
+	var lockout domain.RadiusLockout
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Concurrent first failures share one row, and every failure waits
+		// for the row lock so none of them is lost
+		seed := domain.RadiusLockout{Scope: scope, Subject: subject, CreatedAt: now, UpdatedAt: now}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&seed).Error; err != nil {
+			return err
+		}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("scope = ? AND subject = ?", scope, subject).First(&lockout).Error
+		if err != nil {
+			return err
+		}
+		if lockout.IsLocked(now) {
+			// Requests of a locked subject are rejected before they fail
+			return nil
+		}
+
+		if now.Sub(lockout.LastFailure) > cfg.Window {
+			lockout.Failures = 0
+		}
+		// The backoff starts over once a subject stayed clean long enough
+		if lockout.Level > 0 && now.Sub(lockout.LockedUntil) > cfg.MaxDuration {
+			lockout.Level = 0
+		}
+		lockout.Failures++
+		lockout.LastFailure = now
+		lockout.Reason = reason
+		if lockout.Failures >= threshold {
+			lockout.Level++
+			lockout.Failures = 0
+			lockout.LockedUntil = now.Add(cfg.LockDuration(lockout.Level))
+			locked = true
+		}
+		lockout.UpdatedAt = now
+		return tx.Save(&lockout).Error
+	})
+	if err != nil || !locked {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// Succeed clears the username and station counters after a successful
+// login. The NAS counter is kept, one good login says nothing about the
+// other users of a NAS.
+func (m *Manager) Succeed(ctx context.Context, s Subjects) error {
+	if !m.Config().Enabled || (s.Username == "" && s.Station == "") {
+		return nil
+	}
+
+	db := m.db.WithContext(ctx)
+	match := db.Where("1 = 0")
+	if s.Username != "" {
+		match = match.Or("scope = ? AND subject = ?", domain.LockoutScopeUsername, s.Username)
+	}
+	if s.Station != "" {
+		match = match.Or("scope = ? AND subject = ?", domain.LockoutScopeStation, s.Station)
+	}
+	return db.Where(match).Where("locked_until <= ?", m.now()).Delete(&domain.RadiusLockout{}).Error
+}
+
+// Purge removes the counters no longer in effect: the subject has not failed
+// within the window and its backoff has started over. Failures of unknown
+// usernames would otherwise stay in the table forever.
+func (m *Manager) Purge(ctx context.Context) (int64, error) {
+	if m == nil || m.db == nil {
+		return 0, nil
+	}
+	cfg := LoadConfig(m.cfg)
+	now := m.now()
+	res := m.db.WithContext(ctx).
+		Where("last_failure < ? AND locked_until < ?", now.Add(-cfg.Window), now.Add(-cfg.MaxDuration)).
+		Delete(&domain.RadiusLockout{})
+	return res.RowsAffected, res.Error
+}
+
+// notify posts a lockout event to the notification URL
+func (m *Manager) notify(url string, event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	resp, err := m.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+	}
+	if err != nil {
+		zap.L().Warn("lockout notification failed",
+			zap.String("namespace", "radius"),
+			zap.String("url", url),
+			zap.Error(err),
+		)
+	}
+}
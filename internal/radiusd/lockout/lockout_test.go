@@ -0,0 +1,254 @@
+package lockout
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"gorm.io/gorm"
+)
+
+type stubConfig struct {
+	strings map[string]string
+	bools   map[string]bool
+	ints    map[string]int64
+}
+
+func (c stubConfig) GetString(_, name string) string { return c.strings[name] }
+func (c stubConfig) GetBool(_, name string) bool     { return c.bools[name] }
+func (c stubConfig) GetInt64(_, name string) int64   { return c.ints[name] }
+
+func setupManager(t *testing.T, cfg stubConfig) (*Manager, *gorm.DB, *time.Time) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.RadiusLockout{}))
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := NewManager(db, cfg)
+	m.now = func() time.Time { return now }
+	return m, db, &now
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert.False(t, LoadConfig(nil).Enabled)
+
+	cfg := LoadConfig(stubConfig{bools: map[string]bool{"Enabled": true}})
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, 5, cfg.MaxFailures)
+	assert.Zero(t, cfg.StationMaxFailures)
+	assert.Equal(t, 15*time.Minute, cfg.Window)
+	assert.Equal(t, 5*time.Minute, cfg.Duration)
+	assert.Equal(t, 5*time.Minute, cfg.MaxDuration)
+}
+
+func TestConfig_LockDuration(t *testing.T) {
+	cfg := Config{Duration: time.Minute, MaxDuration: 10 * time.Minute}
+	assert.Equal(t, time.Minute, cfg.LockDuration(1))
+	assert.Equal(t, 2*time.Minute, cfg.LockDuration(2))
+	assert.Equal(t, 8*time.Minute, cfg.LockDuration(4))
+	assert.Equal(t, 10*time.Minute, cfg.LockDuration(5))
+	assert.Equal(t, 10*time.Minute, cfg.LockDuration(50))
+}
+
+func TestStationSubject(t *testing.T) {
+	assert.Equal(t, "aa:bb:cc:00:00:01", StationSubject("AA-BB-CC-00-00-01"))
+	assert.Equal(t, "203.0.113.7", StationSubject(" 203.0.113.7 "))
+	assert.Equal(t, "", StationSubject(""))
+}
+
+func TestManager_Disabled(t *testing.T) {
+	m, db, _ := setupManager(t, stubConfig{})
+	started, err := m.Fail(context.Background(), Subjects{Username: "alice"}, "password mismatch")
+	require.NoError(t, err)
+	assert.Empty(t, started)
+
+	var count int64
+	require.NoError(t, db.Model(&domain.RadiusLockout{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	var nilManager *Manager
+	locked, err := nilManager.Locked(context.Background(), Subjects{Username: "alice"})
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+}
+
+func TestManager_ProgressiveLockout(t *testing.T) {
+	m, _, now := setupManager(t, stubConfig{
+		bools: map[string]bool{"Enabled": true},
+		ints:  map[string]int64{"MaxFailures": 3, "WindowSeconds": 60, "DurationSeconds": 60, "MaxDurationSeconds": 3600},
+	})
+	ctx := context.Background()
+	alice := Subjects{Username: "alice", Station: "aa:bb:cc:00:00:01", NasIP: "10.0.0.1"}
+
+	fail := func() []domain.RadiusLockout {
+		started, err := m.Fail(ctx, alice, "password mismatch")
+		require.NoError(t, err)
+		return started
+	}
+
+	assert.Empty(t, fail())
+	assert.Empty(t, fail())
+	// Failures outside the window start over
+	*now = now.Add(2 * time.Minute)
+	assert.Empty(t, fail())
+	assert.Empty(t, fail())
+	started := fail()
+	require.Len(t, started, 1, "only the username scope is counted by default")
+	assert.Equal(t, domain.LockoutScopeUsername, started[0].Scope)
+	assert.Equal(t, 1, started[0].Level)
+	assert.Equal(t, now.Add(time.Minute), started[0].LockedUntil)
+
+	locked, err := m.Locked(ctx, Subjects{Username: "alice"})
+	require.NoError(t, err)
+	require.NotNil(t, locked)
+	locked, err = m.Locked(ctx, Subjects{Username: "bob", Station: "aa:bb:cc:00:00:01"})
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+
+	// The second lockout lasts twice as long
+	*now = now.Add(2 * time.Minute)
+	locked, err = m.Locked(ctx, alice)
+	require.NoError(t, err)
+	assert.Nil(t, locked)
+	fail()
+	fail()
+	started = fail()
+	require.Len(t, started, 1)
+	assert.Equal(t, 2, started[0].Level)
+	assert.Equal(t, now.Add(2*time.Minute), started[0].LockedUntil)
+
+	// A successful login does not lift an active lockout but clears it after
+	require.NoError(t, m.Succeed(ctx, alice))
+	locked, err = m.Locked(ctx, alice)
+	require.NoError(t, err)
+	require.NotNil(t, locked)
+	*now = now.Add(3 * time.Minute)
+	require.NoError(t, m.Succeed(ctx, alice))
+	fail()
+	fail()
+	started = fail()
+	require.Len(t, started, 1)
+	assert.Equal(t, 1, started[0].Level)
+}
+
+func TestManager_ConcurrentFailures(t *testing.T) {
+	m, db, _ := setupManager(t, stubConfig{
+		bools: map[string]bool{"Enabled": true},
+		ints:  map[string]int64{"MaxFailures": 100},
+	})
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.Fail(context.Background(), Subjects{Username: "alice"}, "password mismatch")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	var lockouts []domain.RadiusLockout
+	require.NoError(t, db.Find(&lockouts).Error)
+	require.Len(t, lockouts, 1)
+	assert.Equal(t, 20, lockouts[0].Failures)
+}
+
+func TestManager_Purge(t *testing.T) {
+	m, db, now := setupManager(t, stubConfig{
+		bools: map[string]bool{"Enabled": true},
+		ints:  map[string]int64{"MaxFailures": 2, "WindowSeconds": 60, "DurationSeconds": 60, "MaxDurationSeconds": 600},
+	})
+	ctx := context.Background()
+	fail := func(username string) {
+		_, err := m.Fail(ctx, Subjects{Username: username}, "user not exists")
+		require.NoError(t, err)
+	}
+
+	fail("ghost")
+	fail("alice")
+	fail("alice")
+	*now = now.Add(2 * time.Minute)
+	fail("bob")
+
+	// ghost is past the window, alice still backs off, bob failed just now
+	removed, err := m.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), removed)
+	var subjects []string
+	require.NoError(t, db.Model(&domain.RadiusLockout{}).Order("subject").Pluck("subject", &subjects).Error)
+	assert.Equal(t, []string{"alice", "bob"}, subjects)
+
+	*now = now.Add(time.Hour)
+	removed, err = m.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), removed)
+}
+
+func TestManager_StationAndNas(t *testing.T) {
+	m, _, _ := setupManager(t, stubConfig{
+		bools: map[string]bool{"Enabled": true},
+		ints:  map[string]int64{"MaxFailures": 100, "StationMaxFailures": 3, "NasMaxFailures": 4},
+	})
+	ctx := context.Background()
+
+	// A credential stuffing run from one station against many usernames
+	for i, name := range []string{"u1", "u2", "u3"} {
+		started, err := m.Fail(ctx, Subjects{Username: name, Station: "203.0.113.7", NasIP: "10.0.0.1"}, "user not exists")
+		require.NoError(t, err)
+		if i < 2 {
+			assert.Empty(t, started)
+		} else {
+			require.Len(t, started, 1)
+			assert.Equal(t, domain.LockoutScopeStation, started[0].Scope)
+		}
+	}
+	locked, err := m.Locked(ctx, Subjects{Username: "u9", Station: "203.0.113.7"})
+	require.NoError(t, err)
+	require.NotNil(t, locked)
+	assert.Equal(t, "203.0.113.7", locked.Subject)
+
+	started, err := m.Fail(ctx, Subjects{Username: "u4", Station: "203.0.113.8", NasIP: "10.0.0.1"}, "user not exists")
+	require.NoError(t, err)
+	require.Len(t, started, 1)
+	assert.Equal(t, domain.LockoutScopeNas, started[0].Scope)
+}
+
+func TestManager_Notify(t *testing.T) {
+	events := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events <- event
+	}))
+	defer server.Close()
+
+	m, _, _ := setupManager(t, stubConfig{
+		strings: map[string]string{"NotifyURL": server.URL},
+		bools:   map[string]bool{"Enabled": true},
+		ints:    map[string]int64{"MaxFailures": 1},
+	})
+	_, err := m.Fail(context.Background(), Subjects{Username: "alice", NasIP: "10.0.0.1"}, "password mismatch")
+	require.NoError(t, err)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, domain.LockoutScopeUsername, event.Scope)
+		assert.Equal(t, "alice", event.Subject)
+		assert.Equal(t, "10.0.0.1", event.NasIP)
+		assert.Equal(t, "password mismatch", event.Reason)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no lockout notification")
+	}
+}
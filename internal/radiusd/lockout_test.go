@@ -0,0 +1,79 @@
+package radiusd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	eap "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
+	"layeh.com/radius"
+)
+
+func TestStageLockout(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	stages := authService.authPipeline.Stages()
+	require.Greater(t, len(stages), 4)
+	assert.Equal(t, StageVendorParsing, stages[3].Name())
+	assert.Equal(t, StageLockout, stages[4].Name())
+
+	newCtx := func(username, station string) *AuthPipelineContext {
+		ctx := NewAuthPipelineContext(authService, nil, &radius.Request{Packet: radius.New(radius.CodeAccessRequest, []byte("secret"))})
+		ctx.Username = username
+		ctx.CallingStationID = station
+		ctx.RemoteIP = "10.0.0.1"
+		return ctx
+	}
+	rejectMetric := func(err error) string {
+		authErr, ok := radiuserrors.GetAuthError(err)
+		require.True(t, ok, "expected auth error, got %v", err)
+		return authErr.MetricsType
+	}
+
+	// Disabled by default
+	ctx := newCtx("alice", "AA-BB-CC-00-00-01")
+	for i := 0; i < 10; i++ {
+		authService.recordLockoutFailure(ctx, radiuserrors.NewPasswordMismatchError())
+	}
+	require.NoError(t, authService.stageLockout(ctx))
+
+	cfg := appCtx.ConfigMgr()
+	require.NoError(t, cfg.Set("lockout", "Enabled", "true"))
+	require.NoError(t, cfg.Set("lockout", "MaxFailures", "3"))
+
+	// Rejects unrelated to the credentials are not counted
+	for i := 0; i < 5; i++ {
+		authService.recordLockoutFailure(ctx, radiuserrors.NewUserExpiredError())
+	}
+	require.NoError(t, authService.stageLockout(ctx))
+
+	authService.recordLockoutFailure(ctx, radiuserrors.NewPasswordMismatchError())
+	authService.recordLockoutFailure(ctx, eap.ErrPasswordMismatch)
+	require.NoError(t, authService.stageLockout(ctx))
+	authService.recordLockoutFailure(ctx, radiuserrors.NewOTPError("invalid one-time code"))
+	err := authService.stageLockout(ctx)
+	require.Error(t, err)
+	assert.Equal(t, app.MetricsRadiusRejectLockout, rejectMetric(err))
+	require.NoError(t, authService.stageLockout(newCtx("bob", "AA-BB-CC-00-00-01")))
+
+	var lockout domain.RadiusLockout
+	require.NoError(t, appCtx.DB().Where("scope = ? AND subject = ?", domain.LockoutScopeUsername, "alice").First(&lockout).Error)
+	assert.Equal(t, 1, lockout.Level)
+	assert.Equal(t, "invalid one-time code", lockout.Reason)
+
+	// A successful login clears the counters of the username
+	bob := newCtx("bob", "")
+	authService.recordLockoutFailure(bob, radiuserrors.NewPasswordMismatchError())
+	authService.recordLockoutSuccess(bob)
+	var count int64
+	require.NoError(t, appCtx.DB().Model(&domain.RadiusLockout{}).Where("subject = ?", "bob").Count(&count).Error)
+	assert.Zero(t, count)
+}
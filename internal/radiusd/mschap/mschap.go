@@ -0,0 +1,54 @@
+// Package mschap implements the MS-CHAPv2 (RFC 2759) and MPPE key (RFC 3079)
+// computations on top of the NT password hash, so that users stored with an
+// nt-hash password can authenticate without a cleartext password.
+package mschap
+
+import (
+	"crypto/sha1" //nolint:gosec // required by RFC 2759
+	"encoding/hex"
+	"strings"
+
+	"layeh.com/radius/rfc2759"
+	"layeh.com/radius/rfc3079"
+)
+
+var (
+	// RFC 2759, 8.7 "Magic server to client signing constant"
+	magic1 = []byte("Magic server to client signing constant")
+	// RFC 2759, 8.7 "Pad to make it do more than one iteration"
+	magic2 = []byte("Pad to make it do more than one iteration")
+)
+
+// NTResponse computes the 24 byte NT-Response of RFC 2759, 8.1.
+func NTResponse(authChallenge, peerChallenge, username, ntHash []byte) []byte {
+	challenge := rfc2759.ChallengeHash(peerChallenge, authChallenge, username)
+	return rfc2759.ChallengeResponse(challenge, ntHash)
+}
+
+// AuthenticatorResponse computes the "S=" authenticator response of
+// RFC 2759, 8.7.
+func AuthenticatorResponse(authChallenge, peerChallenge, ntResponse, username, ntHash []byte) string {
+	hashHash := rfc2759.NTPasswordHash(ntHash)
+
+	sha := sha1.New() //nolint:gosec
+	sha.Write(hashHash)
+	sha.Write(ntResponse)
+	sha.Write(magic1)
+	digest := sha.Sum(nil)
+
+	challenge := rfc2759.ChallengeHash(peerChallenge, authChallenge, username)
+
+	sha = sha1.New() //nolint:gosec
+	sha.Write(digest)
+	sha.Write(challenge)
+	sha.Write(magic2)
+	digest = sha.Sum(nil)
+
+	return "S=" + strings.ToUpper(hex.EncodeToString(digest))
+}
+
+// MakeKey derives the 128 bit MPPE send or receive key of RFC 3079, 3.4.
+func MakeKey(ntResponse, ntHash []byte, isSend bool) ([]byte, error) {
+	masterKey := rfc3079.GetMasterKey(rfc2759.NTPasswordHash(ntHash), ntResponse)
+	return rfc3079.GetAsymmetricStartKey(masterKey, rfc3079.KeyLength128Bit, isSend)
+}
@@ -0,0 +1,41 @@
+package mschap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"layeh.com/radius/rfc2759"
+	"layeh.com/radius/rfc3079"
+)
+
+// The results must match the cleartext based implementations
+func TestMatchesCleartextImplementation(t *testing.T) {
+	authChallenge := []byte{0x5B, 0x5D, 0x7C, 0x7D, 0x7B, 0x3F, 0x2F, 0x3E, 0x3C, 0x2C, 0x60, 0x21, 0x32, 0x26, 0x26, 0x28}
+	peerChallenge := []byte{0x21, 0x40, 0x23, 0x24, 0x25, 0x5E, 0x26, 0x2A, 0x28, 0x29, 0x5F, 0x2B, 0x3A, 0x33, 0x7C, 0x7E}
+	username := []byte("User")
+	password := []byte("clientPass")
+
+	ucs2, err := rfc2759.ToUTF16(password)
+	require.NoError(t, err)
+	ntHash := rfc2759.NTPasswordHash(ucs2)
+
+	expected, err := rfc2759.GenerateNTResponse(authChallenge, peerChallenge, username, password)
+	require.NoError(t, err)
+	ntResponse := NTResponse(authChallenge, peerChallenge, username, ntHash)
+	assert.Equal(t, expected, ntResponse)
+
+	expectedAuth, err := rfc2759.GenerateAuthenticatorResponse(authChallenge, peerChallenge, ntResponse, username, password)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAuth, AuthenticatorResponse(authChallenge, peerChallenge, ntResponse, username, ntHash))
+	// RFC 2759, 9.2 example
+	assert.Equal(t, "S=407A5589115FD0D6209F510FE9C04566932CDA56", expectedAuth)
+
+	for _, isSend := range []bool{true, false} {
+		expectedKey, err := rfc3079.MakeKey(ntResponse, password, isSend)
+		require.NoError(t, err)
+		key, err := MakeKey(ntResponse, ntHash, isSend)
+		require.NoError(t, err)
+		assert.Equal(t, expectedKey, key)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
@@ -273,11 +274,7 @@ func FmtRequest(p *radius.Request) string {
 		} else if attribute.Type != rfc2865.VendorSpecific_Type {
 			_, _ = fmt.Fprintf(buff, "\t\t%s: %s\n", StringType(attribute.Type), FormatType(attribute.Type, attribute.Attribute))
 		} else {
-			_, _ = fmt.Fprintf(buff, "\t\t%s(%d:%d): %x\n",
-				StringType(attribute.Type),
-				binary.BigEndian.Uint16(attribute.Attribute[2:4]),
-				attribute.Attribute[4:5][0],
-				attribute.Attribute[6:])
+			writeVendorSpecific(buff, attribute.Attribute)
 		}
 	}
 	return buff.String()
@@ -297,11 +294,7 @@ func FmtResponse(p *radius.Packet, RemoteAddr net.Addr) string {
 		if attribute.Type != rfc2865.VendorSpecific_Type {
 			_, _ = fmt.Fprintf(buff, "\t\t%s: %s\n", StringType(attribute.Type), FormatType(attribute.Type, attribute.Attribute))
 		} else {
-			_, _ = fmt.Fprintf(buff, "\t\t%s(%d:%d): %x\n",
-				StringType(attribute.Type),
-				binary.BigEndian.Uint16(attribute.Attribute[2:4]),
-				attribute.Attribute[4:5][0],
-				attribute.Attribute[6:])
+			writeVendorSpecific(buff, attribute.Attribute)
 		}
 	}
 	return buff.String()
@@ -321,16 +314,32 @@ func FmtPacket(p *radius.Packet) string {
 		if attribute.Type != rfc2865.VendorSpecific_Type {
 			_, _ = fmt.Fprintf(buff, "\t\t%s: %s\n", StringType(attribute.Type), FormatType(attribute.Type, attribute.Attribute))
 		} else {
-			_, _ = fmt.Fprintf(buff, "\t\t%s(%d:%d): %x\n",
-				StringType(attribute.Type),
-				binary.BigEndian.Uint16(attribute.Attribute[2:4]),
-				attribute.Attribute[4:5][0],
-				attribute.Attribute[6:])
+			writeVendorSpecific(buff, attribute.Attribute)
 		}
 	}
 	return buff.String()
 }
 
+// writeVendorSpecific writes a Vendor-Specific attribute, decoded by name
+// when a runtime dictionary is loaded and as raw hex otherwise.
+func writeVendorSpecific(buff *strings.Builder, attr radius.Attribute) {
+	if dict := dictionary.Default(); dict != nil {
+		for _, v := range dict.Decode(rfc2865.VendorSpecific_Type, attr) {
+			_, _ = fmt.Fprintf(buff, "\t\t%s\n", v.String())
+		}
+		return
+	}
+	if len(attr) < 6 {
+		_, _ = fmt.Fprintf(buff, "\t\t%s: %x\n", StringType(rfc2865.VendorSpecific_Type), attr)
+		return
+	}
+	_, _ = fmt.Fprintf(buff, "\t\t%s(%d:%d): %x\n",
+		StringType(rfc2865.VendorSpecific_Type),
+		binary.BigEndian.Uint16(attr[2:4]),
+		attr[4],
+		attr[6:])
+}
+
 func Length(p *radius.Packet) int {
 	if p == nil {
 		return 0
@@ -6,7 +6,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
+	"github.com/talkincode/toughradius/v9/share"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 	"layeh.com/radius/rfc2866"
@@ -262,6 +264,31 @@ func TestFmtPacketNil(t *testing.T) {
 	}
 }
 
+func TestFmtPacketVendorSpecific(t *testing.T) {
+	packet := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	vsa := radius.Attribute{0, 0, 0, 9, 1, 12}
+	vsa = append(vsa, []byte("ip:vrf=a1")...)
+	vsa[5] = byte(len(vsa) - 4)
+	packet.Add(rfc2865.VendorSpecific_Type, vsa)
+
+	prev := dictionary.Default()
+	defer dictionary.SetDefault(prev)
+
+	dictionary.SetDefault(nil)
+	if result := FmtPacket(packet); !strings.Contains(result, "VendorSpecific(9:1): 69703a7672663d6131") {
+		t.Errorf("expected raw vendor attribute, got:\n%s", result)
+	}
+
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dictionary.SetDefault(dict)
+	if result := FmtPacket(packet); !strings.Contains(result, "Cisco-AVPair = ip:vrf=a1") {
+		t.Errorf("expected decoded vendor attribute, got:\n%s", result)
+	}
+}
+
 func TestLength(t *testing.T) {
 	tests := []struct {
 		name   string
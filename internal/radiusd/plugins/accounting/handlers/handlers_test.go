@@ -77,6 +77,16 @@ func (m *mockSessionRepository) CountByUsername(ctx context.Context, username st
 	return 0, nil
 }
 
+func (m *mockSessionRepository) ListByUsername(ctx context.Context, username string) ([]domain.RadiusOnline, error) {
+	var list []domain.RadiusOnline
+	for _, session := range m.sessions {
+		if session.Username == username {
+			list = append(list, *session)
+		}
+	}
+	return list, nil
+}
+
 func (m *mockSessionRepository) Exists(ctx context.Context, sessionId string) (bool, error) {
 	_, ok := m.sessions[sessionId]
 	return ok, nil
@@ -207,6 +217,22 @@ func TestStartHandler_Handle_Success(t *testing.T) {
 	assert.Len(t, acctRepo.records, 1)
 }
 
+func TestStartHandler_Handle_StoresLineId(t *testing.T) {
+	sessionRepo := newMockSessionRepo()
+	acctRepo := newMockAccountingRepo()
+	handler := NewStartHandler(sessionRepo, acctRepo)
+
+	ctx := createMockAccountingContext(int(rfc2866.AcctStatusType_Value_Start))
+	ctx.VendorReq = &vendorparserspkg.VendorRequest{CircuitId: "olt1 0/1/0/3:100", RemoteId: "cpe-1"}
+	require.NoError(t, handler.Handle(ctx))
+
+	require.Len(t, sessionRepo.sessions, 1)
+	for _, online := range sessionRepo.sessions {
+		assert.Equal(t, "olt1 0/1/0/3:100", online.CircuitId)
+		assert.Equal(t, "cpe-1", online.RemoteId)
+	}
+}
+
 func TestStartHandler_Handle_SessionCreateError(t *testing.T) {
 	sessionRepo := newMockSessionRepo()
 	sessionRepo.createErr = errors.New("database error")
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/accounting"
+	vendorparserspkg "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"go.uber.org/zap"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+// HTTPHookHandler posts Start, Interim and Stop events to the external
+// accounting endpoint. It runs after the handlers storing the session.
+type HTTPHookHandler struct {
+	client *httphook.Client
+}
+
+// NewHTTPHookHandler Create the accounting HTTP hook handler
+func NewHTTPHookHandler(client *httphook.Client) *HTTPHookHandler {
+	return &HTTPHookHandler{client: client}
+}
+
+func (h *HTTPHookHandler) Name() string {
+	return "HTTPHookHandler"
+}
+
+func (h *HTTPHookHandler) CanHandle(ctx *accounting.AccountingContext) bool {
+	return h.client != nil && hookEvent(ctx.StatusType) != ""
+}
+
+func (h *HTTPHookHandler) Handle(acctCtx *accounting.AccountingContext) error {
+	vendorReq := acctCtx.VendorReq
+	if vendorReq == nil {
+		vendorReq = &vendorparserspkg.VendorRequest{}
+	}
+	r := acctCtx.Request
+	online := buildOnlineFromRequest(acctCtx, vendorReq)
+
+	event := httphook.AcctEvent{
+		Event:            hookEvent(acctCtx.StatusType),
+		Username:         acctCtx.Username,
+		NasIP:            acctCtx.NASIP,
+		AcctSessionID:    online.AcctSessionId,
+		CallingStationID: rfc2865.CallingStationID_GetString(r.Packet),
+		MacAddr:          vendorReq.MacAddr,
+		Vlanid1:          vendorReq.Vlanid1,
+		Vlanid2:          vendorReq.Vlanid2,
+		SessionTime:      online.AcctSessionTime,
+		InputOctets:      online.AcctInputTotal,
+		OutputOctets:     online.AcctOutputTotal,
+		TerminateCause:   int(rfc2866.AcctTerminateCause_Get(r.Packet)),
+		Timestamp:        time.Now().Unix(),
+	}
+	if ip := rfc2865.FramedIPAddress_Get(r.Packet); ip != nil {
+		event.FramedIP = ip.String()
+	}
+	if acctCtx.NAS != nil {
+		event.NasIdentifier = acctCtx.NAS.Identifier
+	}
+
+	// The session is already stored, a failing endpoint must not make the NAS
+	// retry the request
+	err := h.client.Account(acctCtx.Context, event)
+	if err != nil && !errors.Is(err, httphook.ErrDisabled) {
+		zap.L().Warn("http hook accounting event failed",
+			zap.String("namespace", "radius"),
+			zap.String("username", acctCtx.Username),
+			zap.String("event", event.Event),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+func hookEvent(statusType int) string {
+	switch statusType {
+	case int(rfc2866.AcctStatusType_Value_Start):
+		return httphook.EventStart
+	case int(rfc2866.AcctStatusType_Value_InterimUpdate):
+		return httphook.EventInterim
+	case int(rfc2866.AcctStatusType_Value_Stop):
+		return httphook.EventStop
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"layeh.com/radius/rfc2866"
+)
+
+type hookConfig struct {
+	url string
+}
+
+func (c hookConfig) GetString(_, name string) string {
+	if name == "AcctURL" {
+		return c.url
+	}
+	return ""
+}
+
+func (c hookConfig) GetBool(_, name string) bool { return name == "Enabled" }
+func (c hookConfig) GetInt64(_, _ string) int64  { return 0 }
+
+func TestHTTPHookHandler_CanHandle(t *testing.T) {
+	handler := NewHTTPHookHandler(httphook.NewClient(nil))
+	assert.Equal(t, "HTTPHookHandler", handler.Name())
+	assert.True(t, handler.CanHandle(createMockAccountingContext(int(rfc2866.AcctStatusType_Value_Start))))
+	assert.True(t, handler.CanHandle(createMockAccountingContext(int(rfc2866.AcctStatusType_Value_InterimUpdate))))
+	assert.True(t, handler.CanHandle(createMockAccountingContext(int(rfc2866.AcctStatusType_Value_Stop))))
+	assert.False(t, handler.CanHandle(createMockAccountingContext(int(rfc2866.AcctStatusType_Value_AccountingOn))))
+	assert.False(t, NewHTTPHookHandler(nil).CanHandle(createMockAccountingContext(int(rfc2866.AcctStatusType_Value_Start))))
+}
+
+func TestHTTPHookHandler_Handle(t *testing.T) {
+	events := make(chan httphook.AcctEvent, 1)
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event httphook.AcctEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events <- event
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	handler := NewHTTPHookHandler(httphook.NewClient(hookConfig{url: server.URL}))
+	acctCtx := createMockAccountingContext(int(rfc2866.AcctStatusType_Value_Stop))
+	require.NoError(t, rfc2866.AcctSessionTime_Set(acctCtx.Request.Packet, 120))
+	require.NoError(t, handler.Handle(acctCtx))
+
+	event := <-events
+	assert.Equal(t, httphook.EventStop, event.Event)
+	assert.Equal(t, "testuser", event.Username)
+	assert.Equal(t, "nas-01", event.NasIdentifier)
+	assert.Equal(t, "test-session-123", event.AcctSessionID)
+	assert.Equal(t, 120, event.SessionTime)
+
+	// Endpoint failures are only logged
+	status = http.StatusInternalServerError
+	assert.NoError(t, handler.Handle(acctCtx))
+	<-events
+
+	// Disabled hook posts nothing
+	assert.NoError(t, NewHTTPHookHandler(httphook.NewClient(nil)).Handle(acctCtx))
+}
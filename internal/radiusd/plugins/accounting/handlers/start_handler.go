@@ -102,6 +102,8 @@ func (h *StartHandler) buildRadiusOnline(r *radius.Request, vr *vendorparserspkg
 		NasPort:             0,
 		NasClass:            common.NA,
 		NasPortId:           common.IfEmptyStr(rfc2869.NASPortID_GetString(r.Packet), common.NA),
+		CircuitId:           vr.CircuitId,
+		RemoteId:            vr.RemoteId,
 		NasPortType:         0,
 		ServiceType:         0,
 		AcctSessionId:       rfc2866.AcctSessionID_GetString(r.Packet),
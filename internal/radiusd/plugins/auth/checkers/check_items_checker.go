@@ -0,0 +1,154 @@
+package checkers
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"go.uber.org/zap"
+)
+
+// CheckItemsChecker matches the Access-Request against the custom check
+// attribute lists of the profile and user
+type CheckItemsChecker struct {
+	dict func() *dictionary.Dictionary
+}
+
+func NewCheckItemsChecker() *CheckItemsChecker {
+	return &CheckItemsChecker{dict: dictionary.Default}
+}
+
+func (c *CheckItemsChecker) Name() string {
+	return "check_items"
+}
+
+func (c *CheckItemsChecker) Order() int {
+	return 25 // Execute after the bind checks
+}
+
+func (c *CheckItemsChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	user := authCtx.User
+	if user == nil || authCtx.Request == nil || authCtx.Request.Packet == nil {
+		return nil
+	}
+
+	var profileCache interface{}
+	if authCtx.Metadata != nil {
+		profileCache = authCtx.Metadata["profile_cache"]
+	}
+	items, err := user.CheckItems(profileCache)
+	if err != nil {
+		zap.L().Warn("invalid custom check attributes",
+			zap.String("username", user.Username),
+			zap.Error(err))
+		return nil
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	dict := c.dict()
+	if dict == nil {
+		zap.L().Warn("custom check attributes skipped, RADIUS dictionary not loaded",
+			zap.String("username", user.Username))
+		return nil
+	}
+
+	for _, item := range items {
+		var values []string
+		for _, v := range dict.Lookup(authCtx.Request.Packet, item.Attribute) {
+			values = append(values, v.Value)
+		}
+		attr, _ := dict.AttributeRef(item.Attribute)
+		if !matchCheckItem(attr, item, values) {
+			return errors.NewCheckItemError(item.Attribute)
+		}
+	}
+	return nil
+}
+
+// matchCheckItem evaluates a check item against the request values of its attribute
+func matchCheckItem(attr *dictionary.Attribute, item domain.AttributeItem, values []string) bool {
+	switch item.Op {
+	case "=*":
+		return len(values) > 0
+	case "!*":
+		return len(values) == 0
+	case "!=":
+		for _, v := range values {
+			if equalCheckValue(attr, v, item.Value) {
+				return false
+			}
+		}
+		return true
+	case "!~":
+		re, err := regexp.Compile(item.Value)
+		if err != nil {
+			return false
+		}
+		for _, v := range values {
+			if re.MatchString(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range values {
+		switch item.Op {
+		case "==":
+			if equalCheckValue(attr, v, item.Value) {
+				return true
+			}
+		case "=~":
+			if re, err := regexp.Compile(item.Value); err == nil && re.MatchString(v) {
+				return true
+			}
+		case ">", ">=", "<", "<=":
+			got, ok1 := checkNumber(attr, v)
+			want, ok2 := checkNumber(attr, item.Value)
+			if ok1 && ok2 && compareNumbers(item.Op, got, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func equalCheckValue(attr *dictionary.Attribute, got, want string) bool {
+	if got == want {
+		return true
+	}
+	// Enumerated integers may be configured by name or by number
+	a, ok1 := checkNumber(attr, got)
+	b, ok2 := checkNumber(attr, want)
+	return ok1 && ok2 && a == b
+}
+
+func checkNumber(attr *dictionary.Attribute, s string) (int64, bool) {
+	if attr != nil {
+		if n, ok := attr.Value(s); ok {
+			return int64(n), true //nolint:gosec // G115: dictionary values fit in 64 bits
+		}
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	return n, err == nil
+}
+
+func compareNumbers(op string, a, b int64) bool {
+	switch op {
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/share"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestCheckItemsChecker_Name(t *testing.T) {
+	checker := NewCheckItemsChecker()
+	assert.Equal(t, "check_items", checker.Name())
+	assert.Equal(t, 25, checker.Order())
+}
+
+func TestCheckItemsChecker_Check(t *testing.T) {
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+
+	cache := stubProfileCache{
+		1: {ID: 1, CheckAttrs: `[{"attribute":"Called-Station-Id","op":"=~","value":"^00-11-22-33-44-55:corp"}]`},
+		2: {ID: 2, CheckAttrs: `[{"attribute":"NAS-Port-Type","op":"==","value":"Ethernet"},{"attribute":"Framed-Protocol","op":"!*"}]`},
+		3: {ID: 3, CheckAttrs: `not json`},
+	}
+
+	tests := []struct {
+		name        string
+		profileID   int64
+		userAttrs   string
+		expectError bool
+	}{
+		{"regex matches", 1, "", false},
+		{"user overrides profile item", 1, `[{"attribute":"Called-Station-Id","op":"=~","value":":guest$"}]`, true},
+		{"enumerated value by name", 2, "", false},
+		{"user numeric comparison", 0, `[{"attribute":"NAS-Port","op":">=","value":"10"}]`, false},
+		{"user numeric comparison fails", 0, `[{"attribute":"NAS-Port","op":"<","value":"10"}]`, true},
+		{"not equal", 0, `[{"attribute":"User-Name","op":"!=","value":"bob"}]`, false},
+		{"absent attribute must be present", 0, `[{"attribute":"Framed-Protocol","op":"=*"}]`, true},
+		{"invalid profile items are ignored", 3, "", false},
+		{"no items", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			require.NoError(t, rfc2865.UserName_SetString(packet, "alice"))
+			require.NoError(t, rfc2865.CalledStationID_SetString(packet, "00-11-22-33-44-55:corp"))
+			require.NoError(t, rfc2865.NASPortType_Set(packet, rfc2865.NASPortType_Value_Ethernet))
+			require.NoError(t, rfc2865.NASPort_Set(packet, 12))
+
+			checker := &CheckItemsChecker{dict: func() *dictionary.Dictionary { return dict }}
+			authCtx := &auth.AuthContext{
+				Request:  &radius.Request{Packet: packet},
+				User:     &domain.RadiusUser{Username: "alice", ProfileId: tt.profileID, CheckAttrs: tt.userAttrs},
+				Metadata: map[string]interface{}{"profile_cache": cache},
+			}
+
+			err := checker.Check(context.Background(), authCtx)
+			if tt.expectError {
+				require.Error(t, err)
+				authErr, ok := errors.GetAuthError(err)
+				require.True(t, ok)
+				assert.Equal(t, "radus_reject_check_item", authErr.MetricsKey())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckItemsChecker_NoDictionary(t *testing.T) {
+	checker := &CheckItemsChecker{dict: func() *dictionary.Dictionary { return nil }}
+	authCtx := &auth.AuthContext{
+		Request: &radius.Request{Packet: radius.New(radius.CodeAccessRequest, []byte("secret"))},
+		User:    &domain.RadiusUser{Username: "alice", CheckAttrs: `[{"attribute":"User-Name","op":"=*"}]`},
+	}
+	assert.NoError(t, checker.Check(context.Background(), authCtx))
+}
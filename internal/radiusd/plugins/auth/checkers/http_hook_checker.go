@@ -0,0 +1,77 @@
+package checkers
+
+import (
+	"context"
+	"errors"
+
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"go.uber.org/zap"
+	"layeh.com/radius/rfc2865"
+)
+
+// HTTPHookChecker asks the external authorization endpoint whether the user
+// may log in. Reply attributes of an accept are applied by HTTPHookEnhancer.
+type HTTPHookChecker struct {
+	client *httphook.Client
+}
+
+func NewHTTPHookChecker(client *httphook.Client) *HTTPHookChecker {
+	return &HTTPHookChecker{client: client}
+}
+
+func (c *HTTPHookChecker) Name() string {
+	return "http_hook"
+}
+
+func (c *HTTPHookChecker) Order() int {
+	return 40 // Execute last, only requests passing the local checks reach the endpoint
+}
+
+func (c *HTTPHookChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	user := authCtx.User
+	if user == nil || c.client == nil {
+		return nil
+	}
+	if auth.IsDryRun(authCtx) {
+		// The endpoint may count or log the request
+		auth.AddNote(authCtx, "external authorization endpoint not called")
+		return nil
+	}
+
+	req := httphook.AuthRequest{
+		Username:  user.Username,
+		ProfileID: user.ProfileId,
+	}
+	if nas := authCtx.Nas; nas != nil {
+		req.NasIP, req.NasIdentifier, req.NasName = nas.Ipaddr, nas.Identifier, nas.Name
+	}
+	if authCtx.Request != nil && authCtx.Request.Packet != nil {
+		req.CallingStationID = rfc2865.CallingStationID_GetString(authCtx.Request.Packet)
+	}
+	if vendorReq, ok := authCtx.VendorRequest.(*vendorparsers.VendorRequest); ok && vendorReq != nil {
+		req.MacAddr, req.Vlanid1, req.Vlanid2 = vendorReq.MacAddr, vendorReq.Vlanid1, vendorReq.Vlanid2
+	}
+
+	resp, err := c.client.Authorize(ctx, req)
+	switch {
+	case errors.Is(err, httphook.ErrDisabled):
+		return nil
+	case err != nil:
+		if c.client.Config().FailOpen {
+			zap.L().Warn("http hook unavailable, accepting",
+				zap.String("namespace", "radius"),
+				zap.String("username", user.Username),
+				zap.Error(err))
+			return nil
+		}
+		return radiuserrors.NewHookError(err)
+	case resp.Result == httphook.ResultReject:
+		return radiuserrors.NewHookRejectError(resp.Reason)
+	}
+
+	c.client.SetPendingReply(authCtx.Response, resp.ReplyAttrs)
+	return nil
+}
@@ -0,0 +1,115 @@
+package checkers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"layeh.com/radius"
+)
+
+type hookConfig struct {
+	url      string
+	failOpen bool
+}
+
+func (c hookConfig) GetString(_, name string) string {
+	if name == "AuthURL" {
+		return c.url
+	}
+	return ""
+}
+
+func (c hookConfig) GetBool(_, name string) bool {
+	return name == "Enabled" || (name == "FailOpen" && c.failOpen)
+}
+
+func (c hookConfig) GetInt64(_, name string) int64 {
+	if name == "Timeout" {
+		return 500
+	}
+	return 0
+}
+
+func TestHTTPHookChecker_NameAndOrder(t *testing.T) {
+	checker := NewHTTPHookChecker(nil)
+	assert.Equal(t, "http_hook", checker.Name())
+	assert.Equal(t, 40, checker.Order())
+}
+
+func TestHTTPHookChecker_Check(t *testing.T) {
+	var answer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if answer == "" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(answer)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	newAuthCtx := func() *auth.AuthContext {
+		return &auth.AuthContext{
+			User:     &domain.RadiusUser{Username: "alice"},
+			Nas:      &domain.NetNas{Ipaddr: "10.0.0.1", Identifier: "nas-1"},
+			Response: radius.New(radius.CodeAccessAccept, []byte("secret")),
+		}
+	}
+	rejectMetric := func(err error) string {
+		authErr, ok := errors.GetAuthError(err)
+		require.True(t, ok, "expected auth error, got %v", err)
+		return authErr.MetricsType
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		checker := NewHTTPHookChecker(httphook.NewClient(nil))
+		assert.NoError(t, checker.Check(context.Background(), newAuthCtx()))
+	})
+
+	t.Run("accept keeps reply attributes", func(t *testing.T) {
+		answer = `{"result":"accept","reply_attrs":[{"attribute":"Class","value":"gold"}]}`
+		client := httphook.NewClient(hookConfig{url: server.URL})
+		authCtx := newAuthCtx()
+		require.NoError(t, NewHTTPHookChecker(client).Check(context.Background(), authCtx))
+		items := client.TakePendingReply(authCtx.Response)
+		require.Len(t, items, 1)
+		assert.Equal(t, "gold", items[0].Value)
+	})
+
+	t.Run("reject with reason", func(t *testing.T) {
+		answer = `{"result":"reject","reason":"credit limit reached"}`
+		err := NewHTTPHookChecker(httphook.NewClient(hookConfig{url: server.URL})).Check(context.Background(), newAuthCtx())
+		authErr, ok := errors.GetAuthError(err)
+		require.True(t, ok)
+		assert.Equal(t, app.MetricsRadiusRejectUnauthorized, authErr.MetricsType)
+		assert.Equal(t, "credit limit reached", authErr.Message)
+	})
+
+	t.Run("fail closed", func(t *testing.T) {
+		answer = ""
+		err := NewHTTPHookChecker(httphook.NewClient(hookConfig{url: server.URL})).Check(context.Background(), newAuthCtx())
+		assert.Equal(t, app.MetricsRadiusRejectHookError, rejectMetric(err))
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		answer = ""
+		checker := NewHTTPHookChecker(httphook.NewClient(hookConfig{url: server.URL, failOpen: true}))
+		assert.NoError(t, checker.Check(context.Background(), newAuthCtx()))
+	})
+
+	t.Run("dry run skips the endpoint", func(t *testing.T) {
+		answer = `{"result":"reject","reason":"credit limit reached"}`
+		authCtx := newAuthCtx()
+		authCtx.Metadata = map[string]interface{}{auth.MetadataDryRun: true}
+		require.NoError(t, NewHTTPHookChecker(httphook.NewClient(hookConfig{url: server.URL})).Check(context.Background(), authCtx))
+		assert.Len(t, auth.TakeNotes(authCtx), 1)
+	})
+}
@@ -0,0 +1,61 @@
+package checkers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+)
+
+// LineBindChecker enforces access line binding on the RFC 4679
+// Agent-Circuit-Id / Agent-Remote-Id of the request.
+//
+// In learn mode a user without a bound line is accepted and the line is
+// recorded after the login succeeds. In strict mode the line must have been
+// provisioned and the request must carry it.
+type LineBindChecker struct{}
+
+func (c *LineBindChecker) Name() string {
+	return "line_bind"
+}
+
+func (c *LineBindChecker) Order() int {
+	return 22 // Execute after MAC and VLAN bind
+}
+
+func (c *LineBindChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	user := authCtx.User
+	if user == nil || user.BindLine == domain.LineBindOff {
+		return nil
+	}
+
+	var circuitId, remoteId string
+	if vendorReq, ok := authCtx.VendorRequest.(*vendorparsers.VendorRequest); ok && vendorReq != nil {
+		circuitId, remoteId = vendorReq.CircuitId, vendorReq.RemoteId
+	}
+
+	strict := user.BindLine == domain.LineBindStrict
+	if strict && !user.HasLineId() {
+		return errors.NewLineBindError()
+	}
+	if !matchLineId(user.CircuitId, circuitId, strict) || !matchLineId(user.RemoteId, remoteId, strict) {
+		return errors.NewLineBindError()
+	}
+
+	return nil
+}
+
+// matchLineId compares a bound line identifier with the one of the request.
+// Only strict mode rejects requests that omit a bound identifier.
+func matchLineId(bound, requested string, strict bool) bool {
+	if !common.IsNotEmptyAndNA(bound) {
+		return true
+	}
+	if requested == "" {
+		return !strict
+	}
+	return bound == requested
+}
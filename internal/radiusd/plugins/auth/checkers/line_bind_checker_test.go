@@ -0,0 +1,89 @@
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+)
+
+func TestLineBindChecker_Name(t *testing.T) {
+	checker := &LineBindChecker{}
+	assert.Equal(t, "line_bind", checker.Name())
+}
+
+func TestLineBindChecker_Order(t *testing.T) {
+	checker := &LineBindChecker{}
+	assert.Equal(t, 22, checker.Order())
+}
+
+func TestLineBindChecker_Check(t *testing.T) {
+	checker := &LineBindChecker{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		bindLine    int
+		userCircuit string
+		userRemote  string
+		reqCircuit  string
+		reqRemote   string
+		expectError bool
+	}{
+		{"bind disabled", domain.LineBindOff, "olt1 0/1/0/3:100", "", "olt1 0/1/0/4:100", "", false},
+		{"learn without bound line", domain.LineBindLearn, "", "", "olt1 0/1/0/3:100", "", false},
+		{"learn circuit matches", domain.LineBindLearn, "olt1 0/1/0/3:100", "", "olt1 0/1/0/3:100", "cpe-1", false},
+		{"learn circuit mismatch", domain.LineBindLearn, "olt1 0/1/0/3:100", "", "olt1 0/1/0/4:100", "", true},
+		{"learn remote mismatch", domain.LineBindLearn, "", "cpe-1", "", "cpe-2", true},
+		{"learn request without line", domain.LineBindLearn, "olt1 0/1/0/3:100", "", "", "", false},
+		{"learn bound line is N/A", domain.LineBindLearn, "N/A", "", "olt1 0/1/0/3:100", "", false},
+		{"strict matches", domain.LineBindStrict, "olt1 0/1/0/3:100", "cpe-1", "olt1 0/1/0/3:100", "cpe-1", false},
+		{"strict not provisioned", domain.LineBindStrict, "", "", "olt1 0/1/0/3:100", "", true},
+		{"strict request without line", domain.LineBindStrict, "olt1 0/1/0/3:100", "", "", "", true},
+		{"strict request missing remote id", domain.LineBindStrict, "olt1 0/1/0/3:100", "cpe-1", "olt1 0/1/0/3:100", "", true},
+		{"strict circuit mismatch", domain.LineBindStrict, "olt1 0/1/0/3:100", "", "olt1 0/1/0/4:100", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authCtx := &auth.AuthContext{
+				User: &domain.RadiusUser{
+					Username:  "testuser",
+					BindLine:  tt.bindLine,
+					CircuitId: tt.userCircuit,
+					RemoteId:  tt.userRemote,
+				},
+				VendorRequest: &vendorparsers.VendorRequest{
+					CircuitId: tt.reqCircuit,
+					RemoteId:  tt.reqRemote,
+				},
+			}
+
+			err := checker.Check(ctx, authCtx)
+			if tt.expectError {
+				require.Error(t, err)
+				authErr, ok := errors.GetAuthError(err)
+				assert.True(t, ok)
+				assert.Contains(t, authErr.Message, "line")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLineBindChecker_Check_NoVendorRequest(t *testing.T) {
+	checker := &LineBindChecker{}
+	ctx := context.Background()
+
+	user := &domain.RadiusUser{Username: "testuser", BindLine: domain.LineBindLearn, CircuitId: "olt1 0/1/0/3:100"}
+	require.NoError(t, checker.Check(ctx, &auth.AuthContext{User: user}))
+
+	user.BindLine = domain.LineBindStrict
+	require.Error(t, checker.Check(ctx, &auth.AuthContext{User: user}))
+}
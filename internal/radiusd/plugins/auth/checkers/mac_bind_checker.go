@@ -2,15 +2,32 @@ package checkers
 
 import (
 	"context"
+	stderrors "errors"
 
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/repository"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"gorm.io/gorm"
 )
 
-// MacBindChecker enforces MAC binding
-type MacBindChecker struct{}
+// MacBindChecker enforces MAC binding.
+//
+// In single MAC mode the request must come from the MAC address of the user.
+// In device mode it may come from any device registered to the user; an
+// unknown device is accepted while the user has fewer than MaxDevices
+// devices and is learned after the login succeeds.
+type MacBindChecker struct {
+	deviceRepo repository.DeviceRepository
+}
+
+// NewMacBindChecker creates a MAC binding checker. Without a device
+// repository device mode only accepts the MAC address of the user.
+func NewMacBindChecker(deviceRepo repository.DeviceRepository) *MacBindChecker {
+	return &MacBindChecker{deviceRepo: deviceRepo}
+}
 
 func (c *MacBindChecker) Name() string {
 	return "mac_bind"
@@ -30,7 +47,8 @@ func (c *MacBindChecker) Check(ctx context.Context, authCtx *auth.AuthContext) e
 	}
 
 	// Skip MAC bind check
-	if user.GetBindMac(profileCache) == 0 {
+	bindMac := user.GetBindMac(profileCache)
+	if bindMac == domain.MacBindOff {
 		return nil
 	}
 
@@ -40,6 +58,10 @@ func (c *MacBindChecker) Check(ctx context.Context, authCtx *auth.AuthContext) e
 		return nil
 	}
 
+	if bindMac == domain.MacBindDevices && vendorReq.MacAddr != "" {
+		return c.checkDevice(ctx, user, vendorReq.MacAddr, profileCache)
+	}
+
 	// e.g., if both the user MAC and request MAC are present, ensure they match
 	if common.IsNotEmptyAndNA(user.MacAddr) && vendorReq.MacAddr != "" && user.MacAddr != vendorReq.MacAddr {
 		return errors.NewMacBindError()
@@ -47,3 +69,30 @@ func (c *MacBindChecker) Check(ctx context.Context, authCtx *auth.AuthContext) e
 
 	return nil
 }
+
+func (c *MacBindChecker) checkDevice(ctx context.Context, user *domain.RadiusUser, macAddr string, profileCache interface{}) error {
+	if common.IsNotEmptyAndNA(user.MacAddr) && domain.NormalizeMac(user.MacAddr) == domain.NormalizeMac(macAddr) {
+		return nil
+	}
+	if c.deviceRepo == nil {
+		return errors.NewMacBindError()
+	}
+
+	_, err := c.deviceRepo.GetByUserMac(ctx, user.ID, macAddr)
+	if err == nil {
+		return nil
+	}
+	if !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	// Unknown device, accepted while there is room to learn it
+	count, err := c.deviceRepo.CountByUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if count >= user.GetMaxDevices(profileCache) {
+		return errors.NewMacBindError()
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"gorm.io/gorm"
 )
 
 func TestMacBindChecker_Name(t *testing.T) {
@@ -147,3 +148,86 @@ func TestMacBindChecker_Check_InvalidVendorRequest(t *testing.T) {
 	err := checker.Check(ctx, authCtx)
 	require.NoError(t, err)
 }
+
+type mockDeviceRepository struct {
+	devices []domain.RadiusUserDevice
+}
+
+func (m *mockDeviceRepository) GetByUserMac(ctx context.Context, userId int64, macAddr string) (*domain.RadiusUserDevice, error) {
+	for i := range m.devices {
+		if m.devices[i].UserId == userId && m.devices[i].MacAddr == domain.NormalizeMac(macAddr) {
+			return &m.devices[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *mockDeviceRepository) CountByUser(ctx context.Context, userId int64) (int, error) {
+	count := 0
+	for _, d := range m.devices {
+		if d.UserId == userId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockDeviceRepository) Learn(ctx context.Context, device *domain.RadiusUserDevice, maxDevices int) (bool, error) {
+	if count, _ := m.CountByUser(ctx, device.UserId); count >= maxDevices {
+		return false, nil
+	}
+	m.devices = append(m.devices, *device)
+	return true, nil
+}
+
+func (m *mockDeviceRepository) UpdateLastSeen(ctx context.Context, id int64) error {
+	return nil
+}
+
+func TestMacBindChecker_Check_Devices(t *testing.T) {
+	repo := &mockDeviceRepository{devices: []domain.RadiusUserDevice{
+		{ID: 1, UserId: 1, MacAddr: "00:11:22:33:44:01"},
+		{ID: 2, UserId: 1, MacAddr: "00:11:22:33:44:02"},
+	}}
+	cache := stubProfileCache{7: {ID: 7, MaxDevices: 3}}
+
+	tests := []struct {
+		name        string
+		checker     *MacBindChecker
+		user        domain.RadiusUser
+		requestMac  string
+		expectError bool
+	}{
+		{"registered device", NewMacBindChecker(repo), domain.RadiusUser{ID: 1, MaxDevices: 2}, "00-11-22-33-44-02", false},
+		{"user mac", NewMacBindChecker(repo), domain.RadiusUser{ID: 1, MaxDevices: 2, MacAddr: "00:11:22:33:44:99"}, "00:11:22:33:44:99", false},
+		{"device limit reached", NewMacBindChecker(repo), domain.RadiusUser{ID: 1, MaxDevices: 2}, "00:11:22:33:44:03", true},
+		{"room to learn", NewMacBindChecker(repo), domain.RadiusUser{ID: 1, MaxDevices: 3}, "00:11:22:33:44:03", false},
+		{"limit from profile", NewMacBindChecker(repo), domain.RadiusUser{ID: 1, ProfileId: 7}, "00:11:22:33:44:03", false},
+		{"registered devices only", NewMacBindChecker(repo), domain.RadiusUser{ID: 2}, "00:11:22:33:44:03", true},
+		{"request without mac", NewMacBindChecker(repo), domain.RadiusUser{ID: 2}, "", false},
+		{"no device repository", NewMacBindChecker(nil), domain.RadiusUser{ID: 1, MaxDevices: 3}, "00:11:22:33:44:01", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := tt.user
+			user.Username = "testuser"
+			user.BindMac = domain.MacBindDevices
+			authCtx := &auth.AuthContext{
+				User:          &user,
+				VendorRequest: &vendorparsers.VendorRequest{MacAddr: tt.requestMac},
+				Metadata:      map[string]interface{}{"profile_cache": cache},
+			}
+
+			err := tt.checker.Check(context.Background(), authCtx)
+			if tt.expectError {
+				require.Error(t, err)
+				authErr, ok := errors.GetAuthError(err)
+				require.True(t, ok)
+				assert.Equal(t, "mac address binding failed", authErr.Message)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package checkers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"go.uber.org/zap"
+)
+
+// NodeBindChecker restricts users to the NAS devices of their own node and
+// the nodes their profile allows. The radius.NodeBindEnabled setting turns it
+// on for everyone, a profile can turn it on or off for its users, and users
+// flagged as roaming are never restricted. The check also applies to MAC
+// authentication, a device must not log in on the NAS of another node either.
+// A NAS not assigned to any node belongs to no node, users with a home node
+// are rejected there.
+type NodeBindChecker struct {
+	config interface{ GetBool(string, string) bool }
+}
+
+func NewNodeBindChecker(config interface{ GetBool(string, string) bool }) *NodeBindChecker {
+	return &NodeBindChecker{config: config}
+}
+
+func (c *NodeBindChecker) Name() string {
+	return "node_bind"
+}
+
+func (c *NodeBindChecker) Order() int {
+	return 12 // Execute after expiration check, before the time window
+}
+
+func (c *NodeBindChecker) AppliesToMacAuth() bool {
+	return true
+}
+
+func (c *NodeBindChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	user := authCtx.User
+	nas := authCtx.Nas
+	if user == nil || nas == nil || user.Roaming == 1 {
+		return nil
+	}
+
+	var profile *domain.RadiusProfile
+	var profileCache interface{}
+	if authCtx.Metadata != nil {
+		profileCache = authCtx.Metadata["profile_cache"]
+	}
+	if getter, ok := profileCache.(domain.ProfileCacheGetter); ok && user.ProfileId != 0 {
+		if p, err := getter.Get(user.ProfileId); err == nil {
+			profile = p
+		}
+	}
+
+	enabled := c.config != nil && c.config.GetBool("radius", "NodeBindEnabled")
+	if profile != nil {
+		switch profile.NodeBind {
+		case domain.NodeBindOn:
+			enabled = true
+		case domain.NodeBindOff:
+			enabled = false
+		}
+	}
+	if !enabled {
+		return nil
+	}
+
+	home := user.HomeNode(profile)
+	if home == 0 || nas.NodeId == home {
+		return nil
+	}
+	if profile != nil {
+		allowed, err := profile.AllowedNodeList()
+		if err != nil {
+			zap.L().Warn("invalid profile allowed nodes",
+				zap.Int64("profile_id", profile.ID),
+				zap.Error(err))
+		}
+		for _, id := range allowed {
+			if id == nas.NodeId {
+				return nil
+			}
+		}
+	}
+
+	if nas.NodeId == 0 {
+		zap.L().Warn("node binding rejects user on a nas without node",
+			zap.String("namespace", "radius"),
+			zap.String("username", user.Username),
+			zap.String("nasip", nas.Ipaddr),
+			zap.Int64("home_node", home))
+		auth.AddNote(authCtx, "nas %s is not assigned to a node", nas.Ipaddr)
+	}
+	return errors.NewNodeBindError()
+}
@@ -0,0 +1,97 @@
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+)
+
+type nodeBindConfig bool
+
+func (c nodeBindConfig) GetBool(_, name string) bool {
+	return name == "NodeBindEnabled" && bool(c)
+}
+
+func TestNodeBindChecker_Name(t *testing.T) {
+	checker := NewNodeBindChecker(nil)
+	assert.Equal(t, "node_bind", checker.Name())
+	assert.Equal(t, 12, checker.Order())
+
+	var macChecker auth.MacAuthPolicyChecker = checker
+	assert.True(t, macChecker.AppliesToMacAuth())
+}
+
+func TestNodeBindChecker_Check(t *testing.T) {
+	cache := stubProfileCache{
+		1: {ID: 1, NodeId: 10},
+		2: {ID: 2, NodeId: 10, NodeBind: domain.NodeBindOn, AllowedNodes: "30, 40"},
+		3: {ID: 3, NodeId: 10, NodeBind: domain.NodeBindOff},
+		4: {ID: 4, NodeBind: domain.NodeBindOn, AllowedNodes: "bad"},
+	}
+
+	tests := []struct {
+		name        string
+		global      bool
+		user        domain.RadiusUser
+		nasNode     int64
+		expectError bool
+	}{
+		{"disabled globally", false, domain.RadiusUser{NodeId: 10, ProfileId: 1}, 20, false},
+		{"same node", true, domain.RadiusUser{NodeId: 10, ProfileId: 1}, 10, false},
+		{"other node", true, domain.RadiusUser{NodeId: 10, ProfileId: 1}, 20, true},
+		{"node from profile", true, domain.RadiusUser{ProfileId: 1}, 20, true},
+		{"user without node", true, domain.RadiusUser{ProfileId: 4}, 20, false},
+		{"roaming user", true, domain.RadiusUser{NodeId: 10, ProfileId: 1, Roaming: 1}, 20, false},
+		{"profile enables", false, domain.RadiusUser{NodeId: 10, ProfileId: 2}, 20, true},
+		{"allowed node", false, domain.RadiusUser{NodeId: 10, ProfileId: 2}, 40, false},
+		{"profile disables", true, domain.RadiusUser{NodeId: 10, ProfileId: 3}, 20, false},
+		{"invalid allowed nodes", false, domain.RadiusUser{NodeId: 10, ProfileId: 4}, 20, true},
+		{"unknown profile", true, domain.RadiusUser{NodeId: 10, ProfileId: 9}, 20, true},
+		{"nas without node", true, domain.RadiusUser{NodeId: 10, ProfileId: 1}, 0, true},
+		{"user and nas without node", true, domain.RadiusUser{ProfileId: 4}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewNodeBindChecker(nodeBindConfig(tt.global))
+			user := tt.user
+			authCtx := &auth.AuthContext{
+				User:     &user,
+				Nas:      &domain.NetNas{ID: 1, NodeId: tt.nasNode},
+				Metadata: map[string]interface{}{"profile_cache": cache},
+			}
+
+			err := checker.Check(context.Background(), authCtx)
+			if tt.expectError {
+				require.Error(t, err)
+				authErr, ok := errors.GetAuthError(err)
+				require.True(t, ok)
+				assert.Equal(t, "radus_reject_node", authErr.MetricsKey())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNodeBindChecker_Check_NoNas(t *testing.T) {
+	checker := NewNodeBindChecker(nodeBindConfig(true))
+	err := checker.Check(context.Background(), &auth.AuthContext{User: &domain.RadiusUser{NodeId: 10}})
+	assert.NoError(t, err)
+}
+
+func TestNodeBindChecker_Check_NasWithoutNode(t *testing.T) {
+	checker := NewNodeBindChecker(nodeBindConfig(true))
+	authCtx := &auth.AuthContext{
+		User:     &domain.RadiusUser{Username: "alice", NodeId: 10},
+		Nas:      &domain.NetNas{Ipaddr: "10.0.0.1"},
+		Metadata: map[string]interface{}{auth.MetadataDryRun: true},
+	}
+	require.Error(t, checker.Check(context.Background(), authCtx))
+	assert.Equal(t, []string{"nas 10.0.0.1 is not assigned to a node"}, auth.TakeNotes(authCtx))
+}
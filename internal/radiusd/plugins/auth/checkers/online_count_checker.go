@@ -2,20 +2,44 @@ package checkers
 
 import (
 	"context"
+	"time"
 
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/repository"
+	"go.uber.org/zap"
 )
 
-// OnlineCountChecker enforces online count limits
+// defaultInterimInterval matches the Acct-Interim-Interval sent when none is configured
+const defaultInterimInterval = 120
+
+// SessionDisconnector ends an online session at its NAS and removes it from
+// the online table. The checker only records the sessions to end, the
+// authentication pipeline disconnects them once the login is accepted.
+type SessionDisconnector interface {
+	DisconnectSession(ctx context.Context, session *domain.RadiusOnline) error
+}
+
+// OnlineCountChecker enforces online count limits.
+//
+// Sessions without an accounting update for radius.StaleSessionIntervals
+// interim intervals are probably ghosts left by a lost Stop and do not hold
+// a slot. When the live sessions reach the limit the session policy of the
+// profile decides: reject the new login, or disconnect the oldest or all
+// other sessions and accept it. The sessions are disconnected after the
+// Access-Accept, a later check may still reject the login.
 type OnlineCountChecker struct {
-	sessionRepo repository.SessionRepository
+	sessionRepo  repository.SessionRepository
+	disconnector SessionDisconnector
+	config       interface{ GetInt64(string, string) int64 }
 }
 
-// NewOnlineCountChecker creates an online count checker
-func NewOnlineCountChecker(sessionRepo repository.SessionRepository) *OnlineCountChecker {
-	return &OnlineCountChecker{sessionRepo: sessionRepo}
+// NewOnlineCountChecker creates an online count checker. Without a
+// disconnector every policy rejects the new login, without a config no
+// session is considered stale.
+func NewOnlineCountChecker(sessionRepo repository.SessionRepository, disconnector SessionDisconnector, config interface{ GetInt64(string, string) int64 }) *OnlineCountChecker {
+	return &OnlineCountChecker{sessionRepo: sessionRepo, disconnector: disconnector, config: config}
 }
 
 func (c *OnlineCountChecker) Name() string {
@@ -41,14 +65,76 @@ func (c *OnlineCountChecker) Check(ctx context.Context, authCtx *auth.AuthContex
 		return nil
 	}
 
+	// The cached count answers the common case without listing sessions
 	count, err := c.sessionRepo.CountByUsername(ctx, user.Username)
 	if err != nil {
 		return err
 	}
+	if count < activeNum {
+		return nil
+	}
 
-	if count >= activeNum {
+	sessions, err := c.sessionRepo.ListByUsername(ctx, user.Username)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	staleAfter := c.staleAfter()
+	live := make([]domain.RadiusOnline, 0, len(sessions))
+	stale := 0
+	for _, session := range sessions {
+		if session.IsStale(now, staleAfter) {
+			stale++
+			continue
+		}
+		live = append(live, session)
+	}
+	if stale > 0 {
+		zap.L().Debug("stale sessions not counted",
+			zap.String("namespace", "radius"),
+			zap.String("username", user.Username),
+			zap.Int("live", len(live)),
+			zap.Int("stale", stale),
+		)
+	}
+	if len(live) < activeNum {
+		return nil
+	}
+
+	var victims []domain.RadiusOnline
+	switch user.GetSessionPolicy(profileCache) {
+	case domain.SessionPolicyDisconnectOldest:
+		victims = live[:len(live)-activeNum+1]
+	case domain.SessionPolicyDisconnectOthers:
+		victims = sessions
+	}
+	if len(victims) == 0 || c.disconnector == nil {
 		return errors.NewOnlineLimitError("user online count exceeded")
 	}
+	if auth.IsDryRun(authCtx) {
+		for _, victim := range victims {
+			auth.AddNote(authCtx, "would disconnect session %s at nas %s", victim.AcctSessionId, victim.NasAddr)
+		}
+		return nil
+	}
 
+	auth.RequestDisconnect(authCtx, victims...)
 	return nil
 }
+
+// staleAfter returns how long a session may go without an accounting update
+// before it is considered stale, zero when the detection is disabled
+func (c *OnlineCountChecker) staleAfter() time.Duration {
+	if c.config == nil {
+		return 0
+	}
+	intervals := c.config.GetInt64("radius", "StaleSessionIntervals")
+	if intervals <= 0 {
+		return 0
+	}
+	interim := c.config.GetInt64("radius", "AcctInterimInterval")
+	if interim <= 0 {
+		interim = defaultInterimInterval
+	}
+	return time.Duration(intervals*interim) * time.Second
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,13 +16,32 @@ import (
 // mockSessionRepository simulates a SessionRepository
 type mockSessionRepository struct {
 	countByUsername func(ctx context.Context, username string) (int, error)
+	sessions        []domain.RadiusOnline
+	deleted         []string
 }
 
 func (m *mockSessionRepository) CountByUsername(ctx context.Context, username string) (int, error) {
 	if m.countByUsername != nil {
 		return m.countByUsername(ctx, username)
 	}
-	return 0, nil
+	return len(m.sessions), nil
+}
+
+// ListByUsername returns the configured sessions, or as many live sessions
+// as countByUsername reports
+func (m *mockSessionRepository) ListByUsername(ctx context.Context, username string) ([]domain.RadiusOnline, error) {
+	if m.sessions != nil || m.countByUsername == nil {
+		return m.sessions, nil
+	}
+	count, err := m.countByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]domain.RadiusOnline, count)
+	for i := range list {
+		list[i] = domain.RadiusOnline{Username: username, LastUpdate: time.Now()}
+	}
+	return list, nil
 }
 
 // Implement other interface methods (unused in tests)
@@ -54,12 +74,12 @@ func (m *mockSessionRepository) BatchDeleteByNas(ctx context.Context, nasAddr, n
 }
 
 func TestOnlineCountChecker_Name(t *testing.T) {
-	checker := NewOnlineCountChecker(&mockSessionRepository{})
+	checker := NewOnlineCountChecker(&mockSessionRepository{}, nil, nil)
 	assert.Equal(t, "online_count", checker.Name())
 }
 
 func TestOnlineCountChecker_Order(t *testing.T) {
-	checker := NewOnlineCountChecker(&mockSessionRepository{})
+	checker := NewOnlineCountChecker(&mockSessionRepository{}, nil, nil)
 	assert.Equal(t, 30, checker.Order())
 }
 
@@ -119,7 +139,7 @@ func TestOnlineCountChecker_Check(t *testing.T) {
 				},
 			}
 
-			checker := NewOnlineCountChecker(mockRepo)
+			checker := NewOnlineCountChecker(mockRepo, nil, nil)
 
 			user := &domain.RadiusUser{
 				Username:  "testuser",
@@ -149,3 +169,100 @@ func TestOnlineCountChecker_Check(t *testing.T) {
 		})
 	}
 }
+
+// mockDisconnector records the disconnected sessions
+type mockDisconnector struct {
+	repo *mockSessionRepository
+}
+
+func (m *mockDisconnector) DisconnectSession(ctx context.Context, session *domain.RadiusOnline) error {
+	m.repo.deleted = append(m.repo.deleted, session.AcctSessionId)
+	return nil
+}
+
+type mockInt64Config map[string]int64
+
+func (m mockInt64Config) GetInt64(_, name string) int64 { return m[name] }
+
+func TestOnlineCountChecker_SessionPolicy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	config := mockInt64Config{"StaleSessionIntervals": 2, "AcctInterimInterval": 60}
+
+	newRepo := func() *mockSessionRepository {
+		return &mockSessionRepository{sessions: []domain.RadiusOnline{
+			{AcctSessionId: "ghost", AcctStartTime: now.Add(-3 * time.Hour), LastUpdate: now.Add(-time.Hour)},
+			{AcctSessionId: "s1", AcctStartTime: now.Add(-2 * time.Hour), LastUpdate: now.Add(-time.Minute)},
+			{AcctSessionId: "s2", AcctStartTime: now.Add(-time.Hour), LastUpdate: now.Add(-time.Minute)},
+		}}
+	}
+	// check returns the sessions the checker asks to disconnect
+	check := func(repo *mockSessionRepository, disconnector SessionDisconnector, config interface{ GetInt64(string, string) int64 }, activeNum int, policy string) ([]string, error) {
+		cache := stubProfileCache{1: {ID: 1, SessionPolicy: policy}}
+		user := &domain.RadiusUser{Username: "alice", ProfileId: 1, ActiveNum: activeNum}
+		checker := NewOnlineCountChecker(repo, disconnector, config)
+		authCtx := &auth.AuthContext{User: user, Metadata: map[string]interface{}{"profile_cache": cache}}
+		err := checker.Check(ctx, authCtx)
+		var ids []string
+		for _, session := range auth.TakeDisconnects(authCtx) {
+			ids = append(ids, session.AcctSessionId)
+		}
+		return ids, err
+	}
+
+	t.Run("stale sessions do not hold a slot", func(t *testing.T) {
+		_, err := check(newRepo(), nil, config, 3, "")
+		require.NoError(t, err)
+		_, err = check(newRepo(), nil, nil, 3, "")
+		require.Error(t, err)
+		_, err = check(newRepo(), nil, config, 2, domain.SessionPolicyRejectNew)
+		require.Error(t, err)
+	})
+
+	t.Run("disconnect oldest", func(t *testing.T) {
+		repo := newRepo()
+		ids, err := check(repo, &mockDisconnector{repo: repo}, config, 2, domain.SessionPolicyDisconnectOldest)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s1"}, ids)
+
+		repo = newRepo()
+		ids, err = check(repo, &mockDisconnector{repo: repo}, config, 1, domain.SessionPolicyDisconnectOldest)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s1", "s2"}, ids)
+	})
+
+	t.Run("disconnect others", func(t *testing.T) {
+		repo := newRepo()
+		ids, err := check(repo, &mockDisconnector{repo: repo}, config, 2, domain.SessionPolicyDisconnectOthers)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ghost", "s1", "s2"}, ids)
+	})
+
+	t.Run("disconnects wait for the accept", func(t *testing.T) {
+		repo := newRepo()
+		_, err := check(repo, &mockDisconnector{repo: repo}, config, 2, domain.SessionPolicyDisconnectOldest)
+		require.NoError(t, err)
+		assert.Empty(t, repo.deleted)
+	})
+
+	t.Run("dry run only notes the disconnects", func(t *testing.T) {
+		repo := newRepo()
+		cache := stubProfileCache{1: {ID: 1, SessionPolicy: domain.SessionPolicyDisconnectOldest}}
+		authCtx := &auth.AuthContext{
+			User:     &domain.RadiusUser{Username: "alice", ProfileId: 1, ActiveNum: 2},
+			Metadata: map[string]interface{}{"profile_cache": cache, auth.MetadataDryRun: true},
+		}
+		checker := NewOnlineCountChecker(repo, &mockDisconnector{repo: repo}, config)
+		require.NoError(t, checker.Check(ctx, authCtx))
+		assert.Empty(t, repo.deleted)
+		notes := auth.TakeNotes(authCtx)
+		require.Len(t, notes, 1)
+		assert.Contains(t, notes[0], "session s1")
+	})
+
+	t.Run("no disconnector rejects", func(t *testing.T) {
+		_, err := check(newRepo(), nil, config, 2, domain.SessionPolicyDisconnectOldest)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeded")
+	})
+}
@@ -3,6 +3,7 @@ package checkers
 import (
 	"context"
 
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/pkg/common"
@@ -25,6 +26,9 @@ func (c *StatusChecker) Check(ctx context.Context, authCtx *auth.AuthContext) er
 	if user.Status == common.DISABLED {
 		return errors.NewUserDisabledError()
 	}
+	if user.Status == domain.UserStatusSuspended {
+		return errors.NewUserSuspendedError()
+	}
 
 	return nil
 }
@@ -30,6 +30,7 @@ func TestStatusChecker_Check(t *testing.T) {
 		name        string
 		userStatus  string
 		expectError bool
+		errContains string
 	}{
 		{
 			name:        "enabled user",
@@ -40,6 +41,13 @@ func TestStatusChecker_Check(t *testing.T) {
 			name:        "disabled user",
 			userStatus:  common.DISABLED,
 			expectError: true,
+			errContains: "disabled",
+		},
+		{
+			name:        "suspended user",
+			userStatus:  domain.UserStatusSuspended,
+			expectError: true,
+			errContains: "suspended",
 		},
 	}
 
@@ -60,7 +68,7 @@ func TestStatusChecker_Check(t *testing.T) {
 				require.Error(t, err)
 				authErr, ok := errors.GetAuthError(err)
 				assert.True(t, ok)
-				assert.Contains(t, authErr.Message, "disabled")
+				assert.Contains(t, authErr.Message, tt.errContains)
 			} else {
 				require.NoError(t, err)
 			}
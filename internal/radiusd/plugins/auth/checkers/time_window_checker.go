@@ -0,0 +1,60 @@
+package checkers
+
+import (
+	"context"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"go.uber.org/zap"
+)
+
+// TimeWindowChecker rejects logins outside the profile access windows
+type TimeWindowChecker struct {
+	now func() time.Time
+}
+
+func (c *TimeWindowChecker) Name() string {
+	return "time_window"
+}
+
+func (c *TimeWindowChecker) Order() int {
+	return 15 // Execute after expiration check
+}
+
+func (c *TimeWindowChecker) Check(ctx context.Context, authCtx *auth.AuthContext) error {
+	user := authCtx.User
+
+	// Access windows are a profile setting, read through the profile cache
+	var profileCache interface{}
+	if authCtx.Metadata != nil {
+		profileCache = authCtx.Metadata["profile_cache"]
+	}
+	getter, ok := profileCache.(domain.ProfileCacheGetter)
+	if !ok || user.ProfileId == 0 {
+		return nil
+	}
+	profile, err := getter.Get(user.ProfileId)
+	if err != nil || profile.AccessWindows == "" {
+		return nil
+	}
+
+	windows, err := profile.AccessWindowList()
+	if err != nil {
+		zap.L().Warn("invalid profile access windows",
+			zap.Int64("profile_id", profile.ID),
+			zap.Error(err))
+		return nil
+	}
+
+	now := time.Now()
+	if c.now != nil {
+		now = c.now()
+	}
+	if allowed, _ := domain.WindowsAllow(windows, now); !allowed {
+		return errors.NewTimeWindowError()
+	}
+
+	return nil
+}
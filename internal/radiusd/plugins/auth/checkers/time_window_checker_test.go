@@ -0,0 +1,75 @@
+package checkers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+)
+
+type stubProfileCache map[int64]*domain.RadiusProfile
+
+func (s stubProfileCache) Get(profileID int64) (*domain.RadiusProfile, error) {
+	if p, ok := s[profileID]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("profile %d not found", profileID)
+}
+
+func TestTimeWindowChecker_Name(t *testing.T) {
+	checker := &TimeWindowChecker{}
+	assert.Equal(t, "time_window", checker.Name())
+	assert.Equal(t, 15, checker.Order())
+}
+
+func TestTimeWindowChecker_Check(t *testing.T) {
+	cache := stubProfileCache{
+		1: {ID: 1, AccessWindows: `[{"days":"mon-fri","start":"08:00","end":"18:00"}]`},
+		2: {ID: 2},
+		3: {ID: 3, AccessWindows: `not json`},
+	}
+	monday := func(hour int) func() time.Time {
+		return func() time.Time { return time.Date(2025, 1, 6, hour, 0, 0, 0, time.Local) }
+	}
+
+	tests := []struct {
+		name        string
+		profileID   int64
+		now         func() time.Time
+		cache       interface{}
+		expectError bool
+	}{
+		{"inside window", 1, monday(9), cache, false},
+		{"outside window", 1, monday(20), cache, true},
+		{"no window configured", 2, monday(20), cache, false},
+		{"invalid windows are ignored", 3, monday(20), cache, false},
+		{"unknown profile", 9, monday(20), cache, false},
+		{"no profile cache", 1, monday(20), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &TimeWindowChecker{now: tt.now}
+			authCtx := &auth.AuthContext{
+				User:     &domain.RadiusUser{Username: "testuser", ProfileId: tt.profileID},
+				Metadata: map[string]interface{}{"profile_cache": tt.cache},
+			}
+
+			err := checker.Check(context.Background(), authCtx)
+			if tt.expectError {
+				require.Error(t, err)
+				authErr, ok := errors.GetAuthError(err)
+				require.True(t, ok)
+				assert.Equal(t, "radus_reject_time_window", authErr.MetricsKey())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
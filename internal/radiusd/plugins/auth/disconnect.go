@@ -0,0 +1,29 @@
+package auth
+
+import "github.com/talkincode/toughradius/v9/internal/domain"
+
+const metadataDisconnects = "disconnect_sessions"
+
+// RequestDisconnect records online sessions to end once the login is
+// accepted. A later checker or stage may still reject the login, so plugins
+// must not disconnect sessions themselves.
+func RequestDisconnect(authCtx *AuthContext, sessions ...domain.RadiusOnline) {
+	if authCtx == nil || len(sessions) == 0 {
+		return
+	}
+	if authCtx.Metadata == nil {
+		authCtx.Metadata = map[string]interface{}{}
+	}
+	pending, _ := authCtx.Metadata[metadataDisconnects].([]domain.RadiusOnline)
+	authCtx.Metadata[metadataDisconnects] = append(pending, sessions...)
+}
+
+// TakeDisconnects returns and clears the sessions recorded by RequestDisconnect
+func TakeDisconnects(authCtx *AuthContext) []domain.RadiusOnline {
+	if authCtx == nil || authCtx.Metadata == nil {
+		return nil
+	}
+	sessions, _ := authCtx.Metadata[metadataDisconnects].([]domain.RadiusOnline)
+	delete(authCtx.Metadata, metadataDisconnects)
+	return sessions
+}
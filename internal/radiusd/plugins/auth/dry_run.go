@@ -0,0 +1,40 @@
+package auth
+
+import "fmt"
+
+const (
+	// MetadataDryRun marks the context of a simulated request. Plugins must
+	// not change any state or call external systems for it.
+	MetadataDryRun = "dry_run"
+
+	metadataNotes = "dry_run_notes"
+)
+
+// IsDryRun reports whether authCtx belongs to a simulated request
+func IsDryRun(authCtx *AuthContext) bool {
+	if authCtx == nil || authCtx.Metadata == nil {
+		return false
+	}
+	dryRun, _ := authCtx.Metadata[MetadataDryRun].(bool)
+	return dryRun
+}
+
+// AddNote records what a plugin did or would have done for a simulated
+// request, it does nothing for real requests
+func AddNote(authCtx *AuthContext, format string, args ...interface{}) {
+	if !IsDryRun(authCtx) {
+		return
+	}
+	notes, _ := authCtx.Metadata[metadataNotes].([]string)
+	authCtx.Metadata[metadataNotes] = append(notes, fmt.Sprintf(format, args...))
+}
+
+// TakeNotes returns and clears the notes recorded by AddNote
+func TakeNotes(authCtx *AuthContext) []string {
+	if authCtx == nil || authCtx.Metadata == nil {
+		return nil
+	}
+	notes, _ := authCtx.Metadata[metadataNotes].([]string)
+	delete(authCtx.Metadata, metadataNotes)
+	return notes
+}
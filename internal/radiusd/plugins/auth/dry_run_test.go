@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunNotes(t *testing.T) {
+	real := &AuthContext{Metadata: map[string]interface{}{}}
+	assert.False(t, IsDryRun(real))
+	AddNote(real, "ignored")
+	assert.Empty(t, TakeNotes(real))
+	assert.False(t, IsDryRun(nil))
+
+	simulated := &AuthContext{Metadata: map[string]interface{}{MetadataDryRun: true}}
+	assert.True(t, IsDryRun(simulated))
+	AddNote(simulated, "first %d", 1)
+	AddNote(simulated, "second")
+	assert.Equal(t, []string{"first 1", "second"}, TakeNotes(simulated))
+	assert.Empty(t, TakeNotes(simulated))
+}
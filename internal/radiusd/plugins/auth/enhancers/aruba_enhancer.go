@@ -0,0 +1,41 @@
+package enhancers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/aruba"
+)
+
+type ArubaAcceptEnhancer struct{}
+
+func NewArubaAcceptEnhancer() *ArubaAcceptEnhancer {
+	return &ArubaAcceptEnhancer{}
+}
+
+func (e *ArubaAcceptEnhancer) Name() string {
+	return "accept-aruba"
+}
+
+func (e *ArubaAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || authCtx.User == nil {
+		return nil
+	}
+	if !matchVendor(authCtx, vendors.CodeAruba) {
+		return nil
+	}
+
+	resp := authCtx.Response
+
+	// User role and dynamic VLAN from the profile
+	role, vlan := wlanPolicy(authCtx)
+	if role != "" {
+		_ = aruba.ArubaUserRole_SetString(resp, role) //nolint:errcheck
+	}
+	if vlan > 0 {
+		_ = aruba.ArubaUserVlan_Set(resp, aruba.ArubaUserVlan(vlan)) //nolint:errcheck,gosec // G115: VLAN is validated to 1-4094
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/aruba"
+	"layeh.com/radius"
+)
+
+func TestArubaAcceptEnhancer_Name(t *testing.T) {
+	enhancer := NewArubaAcceptEnhancer()
+	assert.Equal(t, "accept-aruba", enhancer.Name())
+}
+
+func TestArubaAcceptEnhancer_Enhance(t *testing.T) {
+	enhancer := NewArubaAcceptEnhancer()
+	cache := stubProfileCache{
+		1: {ID: 1, RoleName: "staff", DynamicVlan: 120},
+		2: {ID: 2},
+	}
+
+	tests := []struct {
+		name         string
+		vendorCode   string
+		profileID    int64
+		expectedRole string
+		expectedVlan aruba.ArubaUserVlan
+	}{
+		{"role and vlan", vendors.CodeAruba, 1, "staff", 120},
+		{"profile without wlan policy", vendors.CodeAruba, 2, "", 0},
+		{"no profile", vendors.CodeAruba, 0, "", 0},
+		{"other vendor", vendors.CodeRuckus, 1, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+			authCtx := &auth.AuthContext{
+				Response: response,
+				User:     &domain.RadiusUser{Username: "testuser", ProfileId: tt.profileID},
+				Nas:      &domain.NetNas{VendorCode: tt.vendorCode},
+				Metadata: map[string]interface{}{"profile_cache": cache},
+			}
+
+			require.NoError(t, enhancer.Enhance(context.Background(), authCtx))
+
+			assert.Equal(t, tt.expectedRole, aruba.ArubaUserRole_GetString(response))
+			assert.Equal(t, tt.expectedVlan, aruba.ArubaUserVlan_Get(response))
+		})
+	}
+}
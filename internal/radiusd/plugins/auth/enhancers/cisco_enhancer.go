@@ -0,0 +1,111 @@
+package enhancers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/cisco"
+	"layeh.com/radius"
+)
+
+// Cisco rate delivery modes, selected by the radius.CiscoRateMode setting
+const (
+	CiscoRateModeRateLimit   = "rate-limit"   // Cisco-AVPair ip:rate-limit policers
+	CiscoRateModeQosPolicy   = "qos-policy"   // Cisco-AVPair ip:sub-qos-policy-in/out named policies
+	CiscoRateModeAccountInfo = "account-info" // Cisco-Account-Info parameterized QoS (QU/QD)
+)
+
+type CiscoAcceptEnhancer struct{}
+
+func NewCiscoAcceptEnhancer() *CiscoAcceptEnhancer {
+	return &CiscoAcceptEnhancer{}
+}
+
+func (e *CiscoAcceptEnhancer) Name() string {
+	return "accept-cisco"
+}
+
+func (e *CiscoAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || authCtx.User == nil {
+		return nil
+	}
+	if !matchVendor(authCtx, vendors.CodeCisco) {
+		return nil
+	}
+
+	resp := authCtx.Response
+	rate := resolveRate(authCtx)
+
+	// Bandwidth rates, including any active rate band
+	switch getStringConfig(authCtx, "CiscoRateMode", CiscoRateModeRateLimit) {
+	case CiscoRateModeQosPolicy:
+		format := getStringConfig(authCtx, "CiscoQosPolicyFormat", "RATE_{rate}K")
+		if rate.UpRate > 0 {
+			_ = cisco.CiscoAVPair_AddString(resp, "ip:sub-qos-policy-in="+ratePolicyName(format, rate.UpRate)) //nolint:errcheck
+		}
+		if rate.DownRate > 0 {
+			_ = cisco.CiscoAVPair_AddString(resp, "ip:sub-qos-policy-out="+ratePolicyName(format, rate.DownRate)) //nolint:errcheck
+		}
+	case CiscoRateModeAccountInfo:
+		if info := ciscoQosAccountInfo(rate); info != "" {
+			_ = cisco.CiscoAccountInfo_AddString(resp, info) //nolint:errcheck
+		}
+	default:
+		SetVendorRate(resp, vendors.CodeCisco, rate)
+	}
+
+	// ISG services activated automatically for every subscriber
+	for _, service := range strings.Split(getStringConfig(authCtx, "CiscoServices", ""), ",") {
+		if service = strings.TrimSpace(service); service != "" {
+			_ = cisco.CiscoAccountInfo_AddString(resp, "A"+service) //nolint:errcheck
+		}
+	}
+
+	return nil
+}
+
+// setCiscoRateLimit adds ip:rate-limit policers for both directions. A rate
+// of 0 leaves that direction unlimited.
+func setCiscoRateLimit(packet *radius.Packet, rate RateLimit) {
+	if avpair := ciscoRateLimitAVPair("input", rate.UpRate); avpair != "" {
+		_ = cisco.CiscoAVPair_AddString(packet, avpair) //nolint:errcheck
+	}
+	if avpair := ciscoRateLimitAVPair("output", rate.DownRate); avpair != "" {
+		_ = cisco.CiscoAVPair_AddString(packet, avpair) //nolint:errcheck
+	}
+}
+
+// ciscoRateLimitAVPair formats a CAR policer with a normal burst of 1.5
+// seconds of traffic and an excess burst of twice that.
+func ciscoRateLimitAVPair(direction string, kbps int) string {
+	if kbps <= 0 {
+		return ""
+	}
+	bps := int64(kbps) * 1024
+	normal := bps / 8 * 3 / 2
+	return fmt.Sprintf("ip:rate-limit=%s %d %d %d conform-action transmit exceed-action drop",
+		direction, bps, normal, normal*2)
+}
+
+// ciscoQosAccountInfo formats the ISG parameterized QoS service,
+// e.g. "QU;1048576;D;2097152".
+func ciscoQosAccountInfo(rate RateLimit) string {
+	var parts []string
+	if rate.UpRate > 0 {
+		parts = append(parts, "QU", strconv.FormatInt(int64(rate.UpRate)*1024, 10))
+	}
+	if rate.DownRate > 0 {
+		parts = append(parts, "D", strconv.FormatInt(int64(rate.DownRate)*1024, 10))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	if parts[0] == "D" {
+		parts[0] = "QD"
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,96 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/cisco"
+	"layeh.com/radius"
+)
+
+func TestCiscoAcceptEnhancer_Name(t *testing.T) {
+	enhancer := NewCiscoAcceptEnhancer()
+	assert.Equal(t, "accept-cisco", enhancer.Name())
+}
+
+func TestCiscoAcceptEnhancer_Enhance_VendorMatch(t *testing.T) {
+	enhancer := NewCiscoAcceptEnhancer()
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		vendorCode string
+		expected   []string
+	}{
+		{
+			name:       "cisco vendor",
+			vendorCode: vendors.CodeCisco,
+			expected: []string{
+				"ip:rate-limit=input 1048576 196608 393216 conform-action transmit exceed-action drop",
+				"ip:rate-limit=output 2097152 393216 786432 conform-action transmit exceed-action drop",
+			},
+		},
+		{
+			name:       "other vendor",
+			vendorCode: vendors.CodeHuawei,
+			expected:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+			authCtx := &auth.AuthContext{
+				Response: response,
+				User:     &domain.RadiusUser{Username: "testuser", UpRate: 1024, DownRate: 2048},
+				Nas:      &domain.NetNas{VendorCode: tt.vendorCode},
+			}
+
+			require.NoError(t, enhancer.Enhance(ctx, authCtx))
+
+			avpairs, err := cisco.CiscoAVPair_GetStrings(response)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, avpairs)
+			assert.Empty(t, cisco.CiscoAccountInfo_GetString(response))
+		})
+	}
+}
+
+func TestCiscoAcceptEnhancer_Enhance_UnlimitedDirection(t *testing.T) {
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	authCtx := &auth.AuthContext{
+		Response: response,
+		User:     &domain.RadiusUser{Username: "testuser", UpRate: 0, DownRate: 512},
+		Nas:      &domain.NetNas{VendorCode: vendors.CodeCisco},
+	}
+
+	require.NoError(t, NewCiscoAcceptEnhancer().Enhance(context.Background(), authCtx))
+
+	avpairs, err := cisco.CiscoAVPair_GetStrings(response)
+	require.NoError(t, err)
+	require.Len(t, avpairs, 1)
+	assert.Contains(t, avpairs[0], "ip:rate-limit=output 524288 ")
+}
+
+func TestCiscoQosAccountInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     RateLimit
+		expected string
+	}{
+		{"both directions", RateLimit{UpRate: 1024, DownRate: 2048}, "QU;1048576;D;2097152"},
+		{"upstream only", RateLimit{UpRate: 512}, "QU;524288"},
+		{"downstream only", RateLimit{DownRate: 512}, "QD;524288"},
+		{"unlimited", RateLimit{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ciscoQosAccountInfo(tt.rate))
+		})
+	}
+}
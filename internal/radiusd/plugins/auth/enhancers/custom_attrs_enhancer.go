@@ -0,0 +1,72 @@
+package enhancers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+// CustomAttrsAcceptEnhancer applies the custom reply attribute lists of the
+// profile and user. It is registered last so ":=" items can replace what the
+// vendor enhancers set.
+type CustomAttrsAcceptEnhancer struct {
+	dict func() *dictionary.Dictionary
+}
+
+func NewCustomAttrsAcceptEnhancer() *CustomAttrsAcceptEnhancer {
+	return &CustomAttrsAcceptEnhancer{dict: dictionary.Default}
+}
+
+func (e *CustomAttrsAcceptEnhancer) Name() string {
+	return "accept-custom-attrs"
+}
+
+func (e *CustomAttrsAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || authCtx.User == nil {
+		return nil
+	}
+
+	items, err := authCtx.User.ReplyItems(profileCacheOf(authCtx))
+	if err != nil {
+		zap.L().Warn("invalid custom reply attributes",
+			zap.String("username", authCtx.User.Username),
+			zap.Error(err))
+		return nil
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	dict := e.dict()
+	if dict == nil {
+		zap.L().Warn("custom reply attributes skipped, RADIUS dictionary not loaded",
+			zap.String("username", authCtx.User.Username))
+		return nil
+	}
+
+	applyReplyItems(dict, authCtx.Response, items, authCtx.User.Username)
+	return nil
+}
+
+// applyReplyItems adds reply attribute items to resp honouring their operators
+func applyReplyItems(dict *dictionary.Dictionary, resp *radius.Packet, items []domain.AttributeItem, username string) {
+	for _, item := range items {
+		switch item.Op {
+		case "=":
+			if len(dict.Lookup(resp, item.Attribute)) > 0 {
+				continue
+			}
+		case ":=":
+			dict.Del(resp, item.Attribute)
+		}
+		if err := dict.AddTo(resp, item.Attribute, item.Value); err != nil {
+			zap.L().Warn("add custom reply attribute error",
+				zap.String("username", username),
+				zap.String("attribute", item.Attribute),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"github.com/talkincode/toughradius/v9/share"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestCustomAttrsAcceptEnhancer_Name(t *testing.T) {
+	enhancer := NewCustomAttrsAcceptEnhancer()
+	assert.Equal(t, "accept-custom-attrs", enhancer.Name())
+}
+
+func TestCustomAttrsAcceptEnhancer_Enhance(t *testing.T) {
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	require.NoError(t, rfc2865.SessionTimeout_Set(response, 3600))
+	require.NoError(t, rfc2865.FilterID_SetString(response, "default"))
+
+	cache := stubProfileCache{1: {ID: 1, ReplyAttrs: `[
+		{"attribute":"Class","value":"gold"},
+		{"attribute":"Session-Timeout","op":"=","value":"60"},
+		{"attribute":"Framed-Route","op":"+=","value":"10.1.0.0/24 0.0.0.0 1"},
+		{"attribute":"Mikrotik-Address-List","value":"profile-list"}
+	]`}}
+	authCtx := &auth.AuthContext{
+		Response: response,
+		User: &domain.RadiusUser{Username: "testuser", ProfileId: 1, ReplyAttrs: `[
+			{"attribute":"Filter-Id","op":":=","value":"vip"},
+			{"attribute":"Mikrotik-Address-List","value":"user-list"},
+			{"attribute":"No-Such-Attr-X","value":"ignored"}
+		]`},
+		Metadata: map[string]interface{}{"profile_cache": cache},
+	}
+
+	enhancer := &CustomAttrsAcceptEnhancer{dict: func() *dictionary.Dictionary { return dict }}
+	require.NoError(t, enhancer.Enhance(context.Background(), authCtx))
+
+	assert.Equal(t, "gold", rfc2865.Class_GetString(response))
+	assert.Equal(t, rfc2865.SessionTimeout(3600), rfc2865.SessionTimeout_Get(response))
+	filters, err := rfc2865.FilterID_GetStrings(response)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip"}, filters)
+	assert.Equal(t, "10.1.0.0/24 0.0.0.0 1", rfc2865.FramedRoute_GetString(response))
+	assert.Equal(t, "user-list", mikrotik.MikrotikAddressList_GetString(response))
+}
+
+func TestCustomAttrsAcceptEnhancer_NoItems(t *testing.T) {
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	enhancer := &CustomAttrsAcceptEnhancer{dict: func() *dictionary.Dictionary { return nil }}
+	authCtx := &auth.AuthContext{
+		Response: response,
+		User:     &domain.RadiusUser{Username: "testuser", ReplyAttrs: `[{"attribute":"Class","value":"x"}]`},
+	}
+	require.NoError(t, enhancer.Enhance(context.Background(), authCtx))
+	assert.Empty(t, response.Attributes)
+	require.NoError(t, enhancer.Enhance(context.Background(), nil))
+}
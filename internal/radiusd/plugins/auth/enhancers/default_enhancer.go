@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/pkg/common"
 	"layeh.com/radius/rfc2865"
@@ -48,6 +49,13 @@ func (e *DefaultAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthC
 		timeout = 0
 	}
 
+	// End the session at the profile access window boundary
+	if end := accessWindowEnd(authCtx.User, profileCache, time.Now()); !end.IsZero() {
+		if remain := int64(time.Until(end).Seconds()); remain < timeout {
+			timeout = max(remain, 0)
+		}
+	}
+
 	interim := getIntConfig(authCtx, app.ConfigRadiusAcctInterimInterval, 120)
 
 	_ = rfc2865.SessionTimeout_Set(response, rfc2865.SessionTimeout(timeout))           //nolint:errcheck,gosec // G115: timeout is validated
@@ -86,6 +94,21 @@ func (e *DefaultAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthC
 	return nil
 }
 
+// accessWindowEnd returns when the current access window of the user's profile
+// closes, or a zero time when the profile has no window limit.
+func accessWindowEnd(user *domain.RadiusUser, profileCache interface{}, now time.Time) time.Time {
+	profile := lookupProfile(user, profileCache)
+	if profile == nil || profile.AccessWindows == "" {
+		return time.Time{}
+	}
+	windows, err := profile.AccessWindowList()
+	if err != nil {
+		return time.Time{}
+	}
+	_, end := domain.WindowsAllow(windows, now)
+	return end
+}
+
 func getIntConfig(authCtx *auth.AuthContext, name string, def int64) int64 {
 	// Get config manager from metadata
 	if authCtx.Metadata != nil {
@@ -99,3 +122,14 @@ func getIntConfig(authCtx *auth.AuthContext, name string, def int64) int64 {
 	}
 	return def
 }
+
+func getStringConfig(authCtx *auth.AuthContext, name string, def string) string {
+	if authCtx.Metadata != nil {
+		if cfgMgr, ok := authCtx.Metadata["config_mgr"].(*app.ConfigManager); ok {
+			if val := cfgMgr.GetString("radius", name); val != "" {
+				return val
+			}
+		}
+	}
+	return def
+}
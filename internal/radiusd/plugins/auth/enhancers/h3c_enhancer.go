@@ -2,11 +2,9 @@ package enhancers
 
 import (
 	"context"
-	"math"
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
-	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/h3c"
 )
 
 type H3CAcceptEnhancer struct{}
@@ -27,27 +25,8 @@ func (e *H3CAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthConte
 		return nil
 	}
 
-	user := authCtx.User
-	resp := authCtx.Response
+	// Bandwidth rates, including any active rate band
+	SetVendorRate(authCtx.Response, vendors.CodeH3C, resolveRate(authCtx))
 
-	// Get profile cache from metadata
-	var profileCache interface{}
-	if authCtx.Metadata != nil {
-		profileCache = authCtx.Metadata["profile_cache"]
-	}
-
-	// Use getter methods for bandwidth rates
-	upRate := user.GetUpRate(profileCache)
-	downRate := user.GetDownRate(profileCache)
-
-	up := clampInt64(int64(upRate)*1024, math.MaxInt32)
-	down := clampInt64(int64(downRate)*1024, math.MaxInt32)
-	upPeak := clampInt64(up*4, math.MaxInt32)
-	downPeak := clampInt64(down*4, math.MaxInt32)
-
-	_ = h3c.H3CInputAverageRate_Set(resp, h3c.H3CInputAverageRate(up))     //nolint:errcheck,gosec // G115: clamped to MaxInt32
-	_ = h3c.H3CInputPeakRate_Set(resp, h3c.H3CInputPeakRate(upPeak))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
-	_ = h3c.H3COutputAverageRate_Set(resp, h3c.H3COutputAverageRate(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
-	_ = h3c.H3COutputPeakRate_Set(resp, h3c.H3COutputPeakRate(downPeak))   //nolint:errcheck,gosec // G115: clamped to MaxInt32
 	return nil
 }
@@ -0,0 +1,49 @@
+package enhancers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"go.uber.org/zap"
+)
+
+// HTTPHookEnhancer applies the reply attributes returned by the external
+// authorization endpoint. It is registered after the custom attributes so the
+// endpoint has the last word.
+type HTTPHookEnhancer struct {
+	client *httphook.Client
+	dict   func() *dictionary.Dictionary
+}
+
+func NewHTTPHookEnhancer(client *httphook.Client) *HTTPHookEnhancer {
+	return &HTTPHookEnhancer{client: client, dict: dictionary.Default}
+}
+
+func (e *HTTPHookEnhancer) Name() string {
+	return "accept-http-hook"
+}
+
+func (e *HTTPHookEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || e.client == nil {
+		return nil
+	}
+	items := e.client.TakePendingReply(authCtx.Response)
+	if len(items) == 0 {
+		return nil
+	}
+
+	var username string
+	if authCtx.User != nil {
+		username = authCtx.User.Username
+	}
+	dict := e.dict()
+	if dict == nil {
+		zap.L().Warn("http hook reply attributes skipped, RADIUS dictionary not loaded",
+			zap.String("username", username))
+		return nil
+	}
+	applyReplyItems(dict, authCtx.Response, items, username)
+	return nil
+}
@@ -0,0 +1,47 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/share"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestHTTPHookEnhancer_Name(t *testing.T) {
+	assert.Equal(t, "accept-http-hook", NewHTTPHookEnhancer(nil).Name())
+}
+
+func TestHTTPHookEnhancer_Enhance(t *testing.T) {
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	require.NoError(t, err)
+
+	client := httphook.NewClient(nil)
+	enhancer := &HTTPHookEnhancer{client: client, dict: func() *dictionary.Dictionary { return dict }}
+
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	require.NoError(t, rfc2865.SessionTimeout_Set(response, 3600))
+	client.SetPendingReply(response, []domain.AttributeItem{
+		{Attribute: "Session-Timeout", Op: ":=", Value: "600"},
+		{Attribute: "Filter-Id", Op: "=", Value: "hook"},
+	})
+	authCtx := &auth.AuthContext{Response: response, User: &domain.RadiusUser{Username: "alice"}}
+	require.NoError(t, enhancer.Enhance(context.Background(), authCtx))
+
+	assert.Equal(t, rfc2865.SessionTimeout(600), rfc2865.SessionTimeout_Get(response))
+	assert.Equal(t, "hook", rfc2865.FilterID_GetString(response))
+	assert.Nil(t, client.TakePendingReply(response))
+
+	// Responses without pending attributes are left alone
+	other := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	require.NoError(t, enhancer.Enhance(context.Background(), &auth.AuthContext{Response: other}))
+	assert.Empty(t, other.Attributes)
+	require.NoError(t, enhancer.Enhance(context.Background(), nil))
+}
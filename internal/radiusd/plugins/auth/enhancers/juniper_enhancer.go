@@ -0,0 +1,73 @@
+package enhancers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/erx"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"layeh.com/radius"
+)
+
+type JuniperAcceptEnhancer struct{}
+
+func NewJuniperAcceptEnhancer() *JuniperAcceptEnhancer {
+	return &JuniperAcceptEnhancer{}
+}
+
+func (e *JuniperAcceptEnhancer) Name() string {
+	return "accept-juniper"
+}
+
+func (e *JuniperAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || authCtx.User == nil {
+		return nil
+	}
+	if !matchVendor(authCtx, vendors.CodeJuniper) {
+		return nil
+	}
+
+	user := authCtx.User
+	resp := authCtx.Response
+	profileCache := profileCacheOf(authCtx)
+	juniperRateAttrs(resp, resolveRate(authCtx),
+		getStringConfig(authCtx, "JuniperRateService", "rate-limit"),
+		getStringConfig(authCtx, "JuniperPolicyFormat", ""))
+
+	// Routing instance from the profile domain
+	if domain := user.GetDomain(profileCache); common.IsNotEmptyAndNA(domain) {
+		_ = erx.ERXVirtualRouterName_SetString(resp, domain) //nolint:errcheck
+	}
+
+	// DHCPv6 prefix delegation pool
+	if pool := user.GetIPv6PrefixPool(profileCache); common.IsNotEmptyAndNA(pool) {
+		_ = erx.ERXIPv6DelegatedPoolName_SetString(resp, pool) //nolint:errcheck
+	}
+
+	return nil
+}
+
+// juniperRateAttrs adds the bandwidth rates, including any active rate band.
+// When both directions are limited they are sent as a parameterized dynamic
+// service: ERX-Service-Activate:1 = "<service>(<up bps>,<down bps>)". The
+// service has no value meaning unlimited and a zero rate would block
+// traffic, so a rate limited in one direction only is sent solely as the
+// named policy of that direction and needs the policy format configured.
+func juniperRateAttrs(resp *radius.Packet, rate RateLimit, service, policyFormat string) {
+	if common.IsNotEmptyAndNA(service) && rate.UpRate > 0 && rate.DownRate > 0 {
+		value := fmt.Sprintf("%s(%d,%d)", service, int64(rate.UpRate)*1024, int64(rate.DownRate)*1024)
+		_ = erx.ERXServiceActivate_AddString(resp, 1, value) //nolint:errcheck
+	}
+
+	// Named firewall policies, e.g. "RATE_{rate}K"
+	if policyFormat != "" {
+		if rate.UpRate > 0 {
+			_ = erx.ERXIngressPolicyName_SetString(resp, ratePolicyName(policyFormat, rate.UpRate)) //nolint:errcheck
+		}
+		if rate.DownRate > 0 {
+			_ = erx.ERXEgressPolicyName_SetString(resp, ratePolicyName(policyFormat, rate.DownRate)) //nolint:errcheck
+		}
+	}
+}
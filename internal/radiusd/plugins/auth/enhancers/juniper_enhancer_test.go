@@ -0,0 +1,123 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/erx"
+	"layeh.com/radius"
+)
+
+func TestJuniperAcceptEnhancer_Name(t *testing.T) {
+	enhancer := NewJuniperAcceptEnhancer()
+	assert.Equal(t, "accept-juniper", enhancer.Name())
+}
+
+func TestJuniperAcceptEnhancer_Enhance_VendorMatch(t *testing.T) {
+	enhancer := NewJuniperAcceptEnhancer()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		vendorCode    string
+		shouldEnhance bool
+	}{
+		{
+			name:          "juniper vendor",
+			vendorCode:    vendors.CodeJuniper,
+			shouldEnhance: true,
+		},
+		{
+			name:          "other vendor",
+			vendorCode:    vendors.CodeCisco,
+			shouldEnhance: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+			authCtx := &auth.AuthContext{
+				Response: response,
+				User:     &domain.RadiusUser{Username: "testuser", UpRate: 1024, DownRate: 2048},
+				Nas:      &domain.NetNas{VendorCode: tt.vendorCode},
+			}
+
+			require.NoError(t, enhancer.Enhance(ctx, authCtx))
+
+			tag, service := erx.ERXServiceActivate_GetString(response)
+			if tt.shouldEnhance {
+				assert.Equal(t, byte(1), tag)
+				assert.Equal(t, "rate-limit(1048576,2097152)", service)
+			} else {
+				assert.Empty(t, service)
+			}
+		})
+	}
+}
+
+func TestJuniperAcceptEnhancer_Enhance_ProfileAttributes(t *testing.T) {
+	profile := &domain.RadiusProfile{ID: 7, UpRate: 512, DownRate: 1024, Domain: "vr-residential", IPv6PrefixPool: "pd-pool"}
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	authCtx := &auth.AuthContext{
+		Response: response,
+		User:     &domain.RadiusUser{Username: "testuser", ProfileId: 7, ProfileLinkMode: domain.ProfileLinkModeDynamic},
+		Nas:      &domain.NetNas{VendorCode: vendors.CodeJuniper},
+		Metadata: map[string]interface{}{"profile_cache": stubProfileCache{7: profile}},
+	}
+
+	require.NoError(t, NewJuniperAcceptEnhancer().Enhance(context.Background(), authCtx))
+
+	_, service := erx.ERXServiceActivate_GetString(response)
+	assert.Equal(t, "rate-limit(524288,1048576)", service)
+	assert.Equal(t, "vr-residential", erx.ERXVirtualRouterName_GetString(response))
+	assert.Equal(t, "pd-pool", erx.ERXIPv6DelegatedPoolName_GetString(response))
+	assert.Empty(t, erx.ERXIngressPolicyName_GetString(response))
+}
+
+func TestJuniperAcceptEnhancer_Enhance_UnlimitedRate(t *testing.T) {
+	for _, user := range []*domain.RadiusUser{
+		{Username: "unlimited"},
+		{Username: "unlimited-down", UpRate: 1024},
+	} {
+		response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+		authCtx := &auth.AuthContext{
+			Response: response,
+			User:     user,
+			Nas:      &domain.NetNas{VendorCode: vendors.CodeJuniper},
+		}
+
+		require.NoError(t, NewJuniperAcceptEnhancer().Enhance(context.Background(), authCtx))
+
+		_, service := erx.ERXServiceActivate_GetString(response)
+		assert.Empty(t, service, user.Username)
+	}
+}
+
+func TestJuniperRateAttrs_OneDirection(t *testing.T) {
+	for _, tt := range []struct {
+		rate            RateLimit
+		ingress, egress string
+	}{
+		{RateLimit{UpRate: 1024}, "RATE_1024K", ""},
+		{RateLimit{DownRate: 2048}, "", "RATE_2048K"},
+	} {
+		// Limited in one direction only: no service, the policy of that direction
+		response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+		juniperRateAttrs(response, tt.rate, "rate-limit", "RATE_{rate}K")
+		_, service := erx.ERXServiceActivate_GetString(response)
+		assert.Empty(t, service)
+		assert.Equal(t, tt.ingress, erx.ERXIngressPolicyName_GetString(response))
+		assert.Equal(t, tt.egress, erx.ERXEgressPolicyName_GetString(response))
+
+		// Without a policy format the rate is not enforced
+		response = radius.New(radius.CodeAccessAccept, []byte("secret"))
+		juniperRateAttrs(response, tt.rate, "rate-limit", "")
+		assert.Empty(t, response.Attributes)
+	}
+}
@@ -0,0 +1,131 @@
+package enhancers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/h3c"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/huawei"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ikuai"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/zte"
+	"layeh.com/radius"
+)
+
+// RateLimit is the effective session bandwidth in Kbps
+type RateLimit struct {
+	UpRate   int
+	DownRate int
+}
+
+// ResolveRate returns the user's rates, replaced by the profile rate band
+// active at now. A band rate of 0 keeps the regular rate for that direction.
+func ResolveRate(user *domain.RadiusUser, profileCache interface{}, now time.Time) RateLimit {
+	rate := RateLimit{
+		UpRate:   user.GetUpRate(profileCache),
+		DownRate: user.GetDownRate(profileCache),
+	}
+	if band := ActiveRateBand(user, profileCache, now); band != nil {
+		if band.UpRate > 0 {
+			rate.UpRate = band.UpRate
+		}
+		if band.DownRate > 0 {
+			rate.DownRate = band.DownRate
+		}
+	}
+	return rate
+}
+
+// ActiveRateBand returns the rate band of the user's profile active at now, or nil
+func ActiveRateBand(user *domain.RadiusUser, profileCache interface{}, now time.Time) *domain.RateBand {
+	profile := lookupProfile(user, profileCache)
+	if profile == nil || profile.RateSchedule == "" {
+		return nil
+	}
+	bands, err := profile.RateBandList()
+	if err != nil {
+		return nil
+	}
+	return domain.ActiveRateBand(bands, now)
+}
+
+// SetVendorRate writes the vendor specific rate limit attributes into packet.
+// It is shared by the Access-Accept enhancers and the CoA rate scheduler and
+// returns false when the vendor has no rate attributes.
+func SetVendorRate(packet *radius.Packet, vendorCode string, rate RateLimit) bool {
+	switch vendorCode {
+	case vendors.CodeHuawei:
+		up := clampInt64(int64(rate.UpRate)*1024, math.MaxInt32)
+		down := clampInt64(int64(rate.DownRate)*1024, math.MaxInt32)
+		upPeak := clampInt64(up*4, math.MaxInt32)
+		downPeak := clampInt64(down*4, math.MaxInt32)
+		_ = huawei.HuaweiInputAverageRate_Set(packet, huawei.HuaweiInputAverageRate(up))     //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = huawei.HuaweiInputPeakRate_Set(packet, huawei.HuaweiInputPeakRate(upPeak))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = huawei.HuaweiOutputAverageRate_Set(packet, huawei.HuaweiOutputAverageRate(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = huawei.HuaweiOutputPeakRate_Set(packet, huawei.HuaweiOutputPeakRate(downPeak))   //nolint:errcheck,gosec // G115: clamped to MaxInt32
+	case vendors.CodeH3C:
+		up := clampInt64(int64(rate.UpRate)*1024, math.MaxInt32)
+		down := clampInt64(int64(rate.DownRate)*1024, math.MaxInt32)
+		upPeak := clampInt64(up*4, math.MaxInt32)
+		downPeak := clampInt64(down*4, math.MaxInt32)
+		_ = h3c.H3CInputAverageRate_Set(packet, h3c.H3CInputAverageRate(up))     //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = h3c.H3CInputPeakRate_Set(packet, h3c.H3CInputPeakRate(upPeak))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = h3c.H3COutputAverageRate_Set(packet, h3c.H3COutputAverageRate(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = h3c.H3COutputPeakRate_Set(packet, h3c.H3COutputPeakRate(downPeak))   //nolint:errcheck,gosec // G115: clamped to MaxInt32
+	case vendors.CodeZTE:
+		up := clampInt64(int64(rate.UpRate)*1024, math.MaxInt32)
+		down := clampInt64(int64(rate.DownRate)*1024, math.MaxInt32)
+		_ = zte.ZTERateCtrlSCRUp_Set(packet, zte.ZTERateCtrlSCRUp(up))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = zte.ZTERateCtrlSCRDown_Set(packet, zte.ZTERateCtrlSCRDown(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
+	case vendors.CodeIkuai:
+		up := clampInt64(int64(rate.UpRate)*1024*8, math.MaxInt32)
+		down := clampInt64(int64(rate.DownRate)*1024*8, math.MaxInt32)
+		_ = ikuai.RPUpstreamSpeedLimit_Set(packet, ikuai.RPUpstreamSpeedLimit(up))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
+		_ = ikuai.RPDownstreamSpeedLimit_Set(packet, ikuai.RPDownstreamSpeedLimit(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
+	case vendors.CodeCisco:
+		setCiscoRateLimit(packet, rate)
+	case vendors.CodeMikrotik:
+		_ = mikrotik.MikrotikRateLimit_SetString(packet, fmt.Sprintf("%dk/%dk", rate.UpRate, rate.DownRate)) //nolint:errcheck
+	default:
+		return false
+	}
+	return true
+}
+
+// ratePolicyName expands the {rate} placeholder with the rate in Kbps
+func ratePolicyName(format string, kbps int) string {
+	return strings.ReplaceAll(format, "{rate}", strconv.Itoa(kbps))
+}
+
+// resolveRate returns the effective rate for the user in authCtx
+func resolveRate(authCtx *auth.AuthContext) RateLimit {
+	return ResolveRate(authCtx.User, profileCacheOf(authCtx), time.Now())
+}
+
+func profileCacheOf(authCtx *auth.AuthContext) interface{} {
+	if authCtx.Metadata == nil {
+		return nil
+	}
+	return authCtx.Metadata["profile_cache"]
+}
+
+func lookupProfile(user *domain.RadiusUser, profileCache interface{}) *domain.RadiusProfile {
+	if user == nil || user.ProfileId == 0 || profileCache == nil {
+		return nil
+	}
+	getter, ok := profileCache.(domain.ProfileCacheGetter)
+	if !ok {
+		return nil
+	}
+	profile, err := getter.Get(user.ProfileId)
+	if err != nil {
+		return nil
+	}
+	return profile
+}
@@ -0,0 +1,110 @@
+package enhancers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/h3c"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/huawei"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ikuai"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/zte"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+type stubProfileCache map[int64]*domain.RadiusProfile
+
+func (s stubProfileCache) Get(profileID int64) (*domain.RadiusProfile, error) {
+	if p, ok := s[profileID]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("profile %d not found", profileID)
+}
+
+func TestResolveRate(t *testing.T) {
+	cache := stubProfileCache{
+		1: {ID: 1, RateSchedule: `[{"days":"*","start":"00:00","end":"06:00","up_rate":8192,"down_rate":0}]`},
+	}
+	user := &domain.RadiusUser{ProfileId: 1, UpRate: 1024, DownRate: 2048}
+
+	night := time.Date(2025, 1, 6, 2, 0, 0, 0, time.Local)
+	day := time.Date(2025, 1, 6, 12, 0, 0, 0, time.Local)
+
+	assert.Equal(t, RateLimit{UpRate: 8192, DownRate: 2048}, ResolveRate(user, cache, night))
+	assert.Equal(t, RateLimit{UpRate: 1024, DownRate: 2048}, ResolveRate(user, cache, day))
+	assert.Equal(t, RateLimit{UpRate: 1024, DownRate: 2048}, ResolveRate(user, nil, night))
+}
+
+func TestSetVendorRate(t *testing.T) {
+	rate := RateLimit{UpRate: 1024, DownRate: 2048}
+
+	tests := []struct {
+		vendor string
+		check  func(t *testing.T, p *radius.Packet)
+	}{
+		{vendors.CodeHuawei, func(t *testing.T, p *radius.Packet) {
+			assert.Equal(t, huawei.HuaweiInputAverageRate(1024*1024), huawei.HuaweiInputAverageRate_Get(p))
+			assert.Equal(t, huawei.HuaweiOutputPeakRate(2048*1024*4), huawei.HuaweiOutputPeakRate_Get(p))
+		}},
+		{vendors.CodeH3C, func(t *testing.T, p *radius.Packet) {
+			assert.Equal(t, h3c.H3COutputAverageRate(2048*1024), h3c.H3COutputAverageRate_Get(p))
+		}},
+		{vendors.CodeZTE, func(t *testing.T, p *radius.Packet) {
+			assert.Equal(t, zte.ZTERateCtrlSCRUp(1024*1024), zte.ZTERateCtrlSCRUp_Get(p))
+		}},
+		{vendors.CodeIkuai, func(t *testing.T, p *radius.Packet) {
+			assert.Equal(t, ikuai.RPDownstreamSpeedLimit(2048*1024*8), ikuai.RPDownstreamSpeedLimit_Get(p))
+		}},
+		{vendors.CodeMikrotik, func(t *testing.T, p *radius.Packet) {
+			assert.Equal(t, "1024k/2048k", mikrotik.MikrotikRateLimit_GetString(p))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vendor, func(t *testing.T) {
+			p := radius.New(radius.CodeAccessAccept, []byte("secret"))
+			require.True(t, SetVendorRate(p, tt.vendor, rate))
+			tt.check(t, p)
+		})
+	}
+
+	p := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	assert.False(t, SetVendorRate(p, vendors.CodeStandard, rate))
+	assert.Empty(t, p.Attributes)
+}
+
+func TestDefaultAcceptEnhancer_SessionTimeoutAtWindowEnd(t *testing.T) {
+	now := time.Now()
+	end := now.Add(30 * time.Minute)
+	windows := fmt.Sprintf(`[{"days":"*","start":"%s","end":"%s"}]`,
+		now.Add(-time.Hour).Format("15:04"), end.Format("15:04"))
+	if end.Day() != now.Day() || now.Add(-time.Hour).Day() != now.Day() {
+		t.Skip("window would cross midnight")
+	}
+
+	cache := stubProfileCache{1: {ID: 1, AccessWindows: windows}}
+	user := &domain.RadiusUser{ProfileId: 1, ExpireTime: now.AddDate(0, 1, 0)}
+	resp := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	authCtx := &auth.AuthContext{
+		User:     user,
+		Response: resp,
+		Metadata: map[string]interface{}{"profile_cache": cache},
+	}
+
+	require.NoError(t, NewDefaultAcceptEnhancer().Enhance(context.Background(), authCtx))
+	timeout := int(rfc2865.SessionTimeout_Get(resp))
+	assert.LessOrEqual(t, timeout, 30*60)
+	assert.Greater(t, timeout, 28*60)
+}
+
+func TestRatePolicyName(t *testing.T) {
+	assert.Equal(t, "RATE_10240K", ratePolicyName("RATE_{rate}K", 10240))
+	assert.Equal(t, "GOLD", ratePolicyName("GOLD", 10240))
+}
@@ -0,0 +1,42 @@
+package enhancers
+
+import (
+	"context"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ruckus"
+)
+
+type RuckusAcceptEnhancer struct{}
+
+func NewRuckusAcceptEnhancer() *RuckusAcceptEnhancer {
+	return &RuckusAcceptEnhancer{}
+}
+
+func (e *RuckusAcceptEnhancer) Name() string {
+	return "accept-ruckus"
+}
+
+func (e *RuckusAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthContext) error {
+	if authCtx == nil || authCtx.Response == nil || authCtx.User == nil {
+		return nil
+	}
+	if !matchVendor(authCtx, vendors.CodeRuckus) {
+		return nil
+	}
+
+	resp := authCtx.Response
+
+	// Ruckus maps the role to a user group and takes the VLAN from the
+	// standard tunnel attributes
+	role, vlan := wlanPolicy(authCtx)
+	if role != "" {
+		_ = ruckus.RuckusUserGroups_SetString(resp, role) //nolint:errcheck
+	}
+	if vlan > 0 {
+		setTunnelVlan(resp, vlan)
+	}
+
+	return nil
+}
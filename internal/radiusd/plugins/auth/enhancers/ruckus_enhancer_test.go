@@ -0,0 +1,42 @@
+package enhancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ruckus"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2868"
+)
+
+func TestRuckusAcceptEnhancer_Name(t *testing.T) {
+	enhancer := NewRuckusAcceptEnhancer()
+	assert.Equal(t, "accept-ruckus", enhancer.Name())
+}
+
+func TestRuckusAcceptEnhancer_Enhance(t *testing.T) {
+	response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+	authCtx := &auth.AuthContext{
+		Response: response,
+		User:     &domain.RadiusUser{Username: "testuser", ProfileId: 1},
+		Nas:      &domain.NetNas{VendorCode: vendors.CodeRuckus},
+		Metadata: map[string]interface{}{
+			"profile_cache": stubProfileCache{1: {ID: 1, RoleName: "guest", DynamicVlan: 300}},
+		},
+	}
+
+	require.NoError(t, NewRuckusAcceptEnhancer().Enhance(context.Background(), authCtx))
+
+	assert.Equal(t, "guest", ruckus.RuckusUserGroups_GetString(response))
+	_, tunnelType := rfc2868.TunnelType_Get(response)
+	assert.Equal(t, rfc2868.TunnelType(13), tunnelType)
+	_, mediumType := rfc2868.TunnelMediumType_Get(response)
+	assert.Equal(t, rfc2868.TunnelMediumType_Value_IEEE802, mediumType)
+	_, groupID := rfc2868.TunnelPrivateGroupID_GetString(response)
+	assert.Equal(t, "300", groupID)
+}
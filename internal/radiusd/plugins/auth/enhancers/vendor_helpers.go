@@ -1,7 +1,12 @@
 package enhancers
 
 import (
+	"strconv"
+
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2868"
+	"layeh.com/radius/rfc3580"
 )
 
 func matchVendor(ctx *auth.AuthContext, vendorCode string) bool {
@@ -17,3 +22,19 @@ func clampInt64(val int64, max int64) int64 {
 	}
 	return val
 }
+
+// wlanPolicy returns the WLAN role name and dynamic VLAN of the user's profile
+func wlanPolicy(authCtx *auth.AuthContext) (string, int) {
+	profile := lookupProfile(authCtx.User, profileCacheOf(authCtx))
+	if profile == nil {
+		return "", 0
+	}
+	return profile.RoleName, profile.DynamicVlan
+}
+
+// setTunnelVlan adds the RFC 3580 dynamic VLAN assignment attributes
+func setTunnelVlan(packet *radius.Packet, vlan int) {
+	_ = rfc2868.TunnelType_Set(packet, 0, rfc3580.TunnelType_Value_VLAN)                //nolint:errcheck
+	_ = rfc2868.TunnelMediumType_Set(packet, 0, rfc2868.TunnelMediumType_Value_IEEE802) //nolint:errcheck
+	_ = rfc2868.TunnelPrivateGroupID_SetString(packet, 0, strconv.Itoa(vlan))           //nolint:errcheck
+}
@@ -2,11 +2,9 @@ package enhancers
 
 import (
 	"context"
-	"math"
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
-	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/zte"
 )
 
 type ZTEAcceptEnhancer struct{}
@@ -27,23 +25,8 @@ func (e *ZTEAcceptEnhancer) Enhance(ctx context.Context, authCtx *auth.AuthConte
 		return nil
 	}
 
-	user := authCtx.User
-	resp := authCtx.Response
+	// Bandwidth rates, including any active rate band
+	SetVendorRate(authCtx.Response, vendors.CodeZTE, resolveRate(authCtx))
 
-	// Get profile cache from metadata
-	var profileCache interface{}
-	if authCtx.Metadata != nil {
-		profileCache = authCtx.Metadata["profile_cache"]
-	}
-
-	// Use getter methods for bandwidth rates
-	upRate := user.GetUpRate(profileCache)
-	downRate := user.GetDownRate(profileCache)
-
-	up := clampInt64(int64(upRate)*1024, math.MaxInt32)
-	down := clampInt64(int64(downRate)*1024, math.MaxInt32)
-
-	_ = zte.ZTERateCtrlSCRUp_Set(resp, zte.ZTERateCtrlSCRUp(up))       //nolint:errcheck,gosec // G115: clamped to MaxInt32
-	_ = zte.ZTERateCtrlSCRDown_Set(resp, zte.ZTERateCtrlSCRDown(down)) //nolint:errcheck,gosec // G115: clamped to MaxInt32
 	return nil
 }
@@ -42,6 +42,15 @@ type PolicyChecker interface {
 	Order() int
 }
 
+// MacAuthPolicyChecker is implemented by the profile checkers that also run
+// for MAC authentication, which skips all other checkers
+type MacAuthPolicyChecker interface {
+	PolicyChecker
+
+	// AppliesToMacAuth reports whether the check runs for MAC authentication
+	AppliesToMacAuth() bool
+}
+
 // ResponseEnhancer defines the response enhancement interface
 type ResponseEnhancer interface {
 	// Name returns the enhancer name
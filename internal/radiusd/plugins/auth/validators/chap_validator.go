@@ -8,6 +8,7 @@ import (
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius/rfc2865"
 )
 
@@ -24,6 +25,12 @@ func (v *CHAPValidator) CanHandle(authCtx *auth.AuthContext) bool {
 }
 
 func (v *CHAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContext, password string) error {
+	// CHAP hashes the cleartext password with the challenge
+	plain, ok := passwd.Cleartext(password)
+	if !ok {
+		return errors.NewPasswordSchemeError(v.Name(), string(passwd.Identify(password)))
+	}
+
 	chapPassword := rfc2865.CHAPPassword_Get(authCtx.Request.Packet)
 	if len(chapPassword) != 17 {
 		return errors.NewAuthError("radus_reject_passwd_error",
@@ -38,7 +45,7 @@ func (v *CHAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContext,
 
 	w := md5.New()
 	w.Write([]byte{chapPassword[0]})
-	w.Write([]byte(password))
+	w.Write([]byte(plain))
 	w.Write(chapChallenge)
 	md5r := w.Sum(nil)
 
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 )
@@ -174,3 +175,23 @@ func TestCHAPValidator_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCHAPValidator_Validate_PasswordScheme(t *testing.T) {
+	validator := &CHAPValidator{}
+	ctx := context.Background()
+
+	stored, err := passwd.Hash(passwd.SchemeBcrypt, "testpass123")
+	require.NoError(t, err)
+
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.CHAPPassword_Add(packet, make([]byte, 17))  //nolint:errcheck
+	_ = rfc2865.CHAPChallenge_Add(packet, make([]byte, 16)) //nolint:errcheck
+	authCtx := &auth.AuthContext{
+		Request: &radius.Request{Packet: packet},
+		User:    &domain.RadiusUser{Username: "testuser", Password: stored},
+	}
+
+	err = validator.Validate(ctx, authCtx, stored)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chap authentication is not supported by the bcrypt password")
+}
@@ -0,0 +1,77 @@
+package validators
+
+import (
+	"context"
+	"errors"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/microsoft"
+	"layeh.com/radius/rfc2865"
+)
+
+// MetadataLDAPEntry is the metadata key of the directory entry of a
+// successful bind
+const MetadataLDAPEntry = "ldap_entry"
+
+// LDAPAuthenticator verifies credentials against a directory, implemented by *ldapauth.Directory
+type LDAPAuthenticator interface {
+	Authenticate(ctx context.Context, username, password string) (*ldapauth.Entry, error)
+}
+
+// LDAPValidator checks the password of LDAP shadow users against the
+// directory. Only PAP carries a password the directory can verify.
+type LDAPValidator struct {
+	directory LDAPAuthenticator
+}
+
+// NewLDAPValidator creates an LDAPValidator
+func NewLDAPValidator(directory LDAPAuthenticator) *LDAPValidator {
+	return &LDAPValidator{directory: directory}
+}
+
+func (v *LDAPValidator) Name() string {
+	return "ldap"
+}
+
+func (v *LDAPValidator) CanHandle(authCtx *auth.AuthContext) bool {
+	return authCtx.User != nil && authCtx.User.AuthSource == domain.AuthSourceLDAP
+}
+
+func (v *LDAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContext, _ string) error {
+	requestPassword := rfc2865.UserPassword_GetString(authCtx.Request.Packet)
+	if requestPassword == "" {
+		return radiuserrors.NewPasswordSchemeError(requestMethod(authCtx), domain.AuthSourceLDAP)
+	}
+	if auth.IsDryRun(authCtx) {
+		auth.AddNote(authCtx, "LDAP bind not attempted in simulation, the password is not checked")
+		return nil
+	}
+
+	entry, err := v.directory.Authenticate(ctx, authCtx.User.Username, requestPassword)
+	switch {
+	case errors.Is(err, ldapauth.ErrInvalidCredentials), errors.Is(err, ldapauth.ErrUserNotFound):
+		return radiuserrors.NewPasswordMismatchError()
+	case err != nil:
+		return radiuserrors.NewLDAPError(err)
+	}
+
+	if authCtx.Metadata != nil {
+		authCtx.Metadata[MetadataLDAPEntry] = entry
+	}
+	return nil
+}
+
+// requestMethod names the non-PAP method of a request for reject messages
+func requestMethod(authCtx *auth.AuthContext) string {
+	switch {
+	case rfc2865.CHAPPassword_Get(authCtx.Request.Packet) != nil:
+		return "chap"
+	case microsoft.MSCHAP2Response_Get(authCtx.Request.Packet) != nil:
+		return "mschap"
+	default:
+		return "unknown"
+	}
+}
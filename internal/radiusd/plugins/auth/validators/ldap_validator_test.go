@@ -0,0 +1,87 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth/ldaptest"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+type ldapTestConfig map[string]string
+
+func (m ldapTestConfig) GetString(category, name string) string { return m[category+"."+name] }
+func (m ldapTestConfig) GetBool(category, name string) bool     { return m[category+"."+name] == "true" }
+func (m ldapTestConfig) GetInt64(category, name string) int64   { return 0 }
+
+func TestLDAPValidator(t *testing.T) {
+	srv := ldaptest.NewServer(ldaptest.Entry{
+		DN:       "uid=alice,ou=people,dc=example,dc=com",
+		Password: "alice-secret",
+		Attrs:    map[string][]string{"uid": {"alice"}},
+	})
+	t.Cleanup(srv.Close)
+
+	validator := NewLDAPValidator(ldapauth.NewDirectory(ldapTestConfig{
+		"ldap.Enabled": "true",
+		"ldap.URL":     srv.URL,
+		"ldap.BaseDN":  "dc=example,dc=com",
+	}))
+	assert.Equal(t, "ldap", validator.Name())
+
+	newAuthCtx := func(user *domain.RadiusUser, password string) *auth.AuthContext {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		if password != "" {
+			_ = rfc2865.UserPassword_SetString(packet, password) //nolint:errcheck
+		}
+		return &auth.AuthContext{
+			Request:  &radius.Request{Packet: packet},
+			User:     user,
+			Metadata: map[string]interface{}{},
+		}
+	}
+	ldapUser := &domain.RadiusUser{Username: "alice", AuthSource: domain.AuthSourceLDAP}
+
+	assert.True(t, validator.CanHandle(newAuthCtx(ldapUser, "alice-secret")))
+	assert.False(t, validator.CanHandle(newAuthCtx(&domain.RadiusUser{Username: "local"}, "secret")))
+
+	authCtx := newAuthCtx(ldapUser, "alice-secret")
+	require.NoError(t, validator.Validate(context.Background(), authCtx, ""))
+	assert.NotNil(t, authCtx.Metadata["ldap_entry"])
+
+	err := validator.Validate(context.Background(), newAuthCtx(ldapUser, "wrong"), "")
+	authErr, ok := radiuserrors.GetAuthError(err)
+	require.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectPasswdError, authErr.MetricsType)
+
+	// CHAP cannot be verified by the directory
+	chapCtx := newAuthCtx(ldapUser, "")
+	_ = rfc2865.CHAPPassword_Set(chapCtx.Request.Packet, make([]byte, 17)) //nolint:errcheck
+	err = validator.Validate(context.Background(), chapCtx, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chap authentication is not supported")
+
+	// A simulated request does not bind
+	binds := len(srv.Binds()) + len(srv.FailedBinds())
+	dryRunCtx := newAuthCtx(ldapUser, "wrong")
+	dryRunCtx.Metadata[auth.MetadataDryRun] = true
+	require.NoError(t, validator.Validate(context.Background(), dryRunCtx, ""))
+	assert.Nil(t, dryRunCtx.Metadata["ldap_entry"])
+	assert.Equal(t, []string{"LDAP bind not attempted in simulation, the password is not checked"}, auth.TakeNotes(dryRunCtx))
+	assert.Equal(t, binds, len(srv.Binds())+len(srv.FailedBinds()))
+
+	// An unreachable directory is reported as an ldap error
+	srv.Close()
+	err = validator.Validate(context.Background(), newAuthCtx(ldapUser, "alice-secret"), "")
+	authErr, ok = radiuserrors.GetAuthError(err)
+	require.True(t, ok)
+	assert.Equal(t, app.MetricsRadiusRejectLdapError, authErr.MetricsType)
+}
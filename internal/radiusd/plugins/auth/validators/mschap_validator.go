@@ -5,10 +5,10 @@ import (
 	"context"
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/mschap"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/microsoft"
-	"layeh.com/radius/rfc2759"
-	"layeh.com/radius/rfc3079"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 )
 
 // MSCHAPValidator handles MSCHAP password validation (non-EAP)
@@ -25,6 +25,12 @@ func (v *MSCHAPValidator) CanHandle(authCtx *auth.AuthContext) bool {
 }
 
 func (v *MSCHAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContext, password string) error {
+	// MS-CHAPv2 works on the NT hash of the password
+	ntHash, ok := passwd.NTHash(password)
+	if !ok {
+		return errors.NewPasswordSchemeError(v.Name(), string(passwd.Identify(password)))
+	}
+
 	challenge := microsoft.MSCHAPChallenge_Get(authCtx.Request.Packet)
 	response := microsoft.MSCHAP2Response_Get(authCtx.Request.Packet)
 
@@ -37,54 +43,42 @@ func (v *MSCHAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContex
 	peerChallenge := response[2:18]
 	peerResponse := response[26:50]
 
-	return v.validateMSCHAPv2(authCtx, password, challenge, ident, peerChallenge, peerResponse)
+	return v.validateMSCHAPv2(authCtx, ntHash, challenge, ident, peerChallenge, peerResponse)
 }
 
 func (v *MSCHAPValidator) validateMSCHAPv2(
 	authCtx *auth.AuthContext,
-	password string,
+	ntHash []byte,
 	challenge []byte,
 	ident byte,
 	peerChallenge,
 	peerResponse []byte,
 ) error {
-	username := authCtx.User.Username
-	byteUser := []byte(username)
-	bytePwd := []byte(password)
-
-	ntResponse, err := rfc2759.GenerateNTResponse(challenge, peerChallenge, byteUser, bytePwd)
-	if err != nil {
-		return errors.NewAuthError("radus_reject_passwd_error",
-			"user mschap cannot generate ntResponse")
-	}
+	byteUser := []byte(authCtx.User.Username)
 
+	ntResponse := mschap.NTResponse(challenge, peerChallenge, byteUser, ntHash)
 	if !bytes.Equal(ntResponse, peerResponse) {
 		return errors.NewPasswordMismatchError()
 	}
 
 	// Generate the encryption key
-	recvKey, err := rfc3079.MakeKey(ntResponse, bytePwd, false)
+	recvKey, err := mschap.MakeKey(ntResponse, ntHash, false)
 	if err != nil {
 		return errors.NewAuthError("radus_reject_passwd_error",
 			"user mschap cannot make recvKey")
 	}
 
-	sendKey, err := rfc3079.MakeKey(ntResponse, bytePwd, true)
+	sendKey, err := mschap.MakeKey(ntResponse, ntHash, true)
 	if err != nil {
 		return errors.NewAuthError("radus_reject_passwd_error",
 			"user mschap cannot make sendKey")
 	}
 
-	authenticatorResponse, err := rfc2759.GenerateAuthenticatorResponse(challenge, peerChallenge, ntResponse, byteUser, bytePwd)
-	if err != nil {
-		return errors.NewAuthError("radus_reject_passwd_error",
-			"user mschap cannot generate authenticator response")
-	}
+	authenticatorResponse := mschap.AuthenticatorResponse(challenge, peerChallenge, ntResponse, byteUser, ntHash)
 
 	success := make([]byte, 43)
 	success[0] = ident
 	copy(success[1:], authenticatorResponse)
-
 	// Add the response attribute
 	_ = microsoft.MSCHAP2Success_Add(authCtx.Response, success)                                                           //nolint:errcheck
 	_ = microsoft.MSMPPERecvKey_Add(authCtx.Response, recvKey)                                                            //nolint:errcheck
@@ -9,6 +9,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/microsoft"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 )
 
@@ -166,3 +167,50 @@ func TestMSCHAPValidator_Validate_PasswordMismatch(t *testing.T) {
 	err := validator.Validate(ctx, authCtx, "testpass")
 	require.Error(t, err)
 }
+
+func TestMSCHAPValidator_Validate_PasswordSchemes(t *testing.T) {
+	validator := &MSCHAPValidator{}
+	ctx := context.Background()
+
+	// RFC 2759, 9.2 example
+	authChallenge := []byte{0x5B, 0x5D, 0x7C, 0x7D, 0x7B, 0x3F, 0x2F, 0x3E, 0x3C, 0x2C, 0x60, 0x21, 0x32, 0x26, 0x26, 0x28}
+	peerChallenge := []byte{0x21, 0x40, 0x23, 0x24, 0x25, 0x5E, 0x26, 0x2A, 0x28, 0x29, 0x5F, 0x2B, 0x3A, 0x33, 0x7C, 0x7E}
+	ntResponse := []byte{
+		0x82, 0x30, 0x9E, 0xCD, 0x8D, 0x70, 0x8B, 0x5E, 0xA0, 0x8F, 0xAA, 0x39,
+		0x81, 0xCD, 0x83, 0x54, 0x42, 0x33, 0x11, 0x4A, 0x3D, 0x85, 0xD6, 0xDF,
+	}
+	mschapResponse := make([]byte, 50)
+	mschapResponse[0] = 1
+	copy(mschapResponse[2:18], peerChallenge)
+	copy(mschapResponse[26:50], ntResponse)
+
+	validate := func(stored string) (*radius.Packet, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		response := radius.New(radius.CodeAccessAccept, []byte("secret"))
+		_ = microsoft.MSCHAPChallenge_Add(packet, authChallenge)  //nolint:errcheck
+		_ = microsoft.MSCHAP2Response_Add(packet, mschapResponse) //nolint:errcheck
+		authCtx := &auth.AuthContext{
+			Request:  &radius.Request{Packet: packet},
+			Response: response,
+			User:     &domain.RadiusUser{Username: "User", Password: stored},
+		}
+		return response, validator.Validate(ctx, authCtx, stored)
+	}
+
+	cleartextResp, err := validate("clientPass")
+	require.NoError(t, err)
+	assert.Equal(t, "S=407A5589115FD0D6209F510FE9C04566932CDA56", string(microsoft.MSCHAP2Success_Get(cleartextResp)[1:]))
+
+	ntHash, err := passwd.Hash(passwd.SchemeNTHash, "clientPass")
+	require.NoError(t, err)
+	ntResp, err := validate(ntHash)
+	require.NoError(t, err)
+	assert.Equal(t, microsoft.MSCHAP2Success_Get(cleartextResp), microsoft.MSCHAP2Success_Get(ntResp))
+	assert.Equal(t, microsoft.MSMPPESendKey_Get(cleartextResp), microsoft.MSMPPESendKey_Get(ntResp))
+
+	sha512, err := passwd.Hash(passwd.SchemeSHA512Crypt, "clientPass")
+	require.NoError(t, err)
+	_, err = validate(sha512)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sha512-crypt")
+}
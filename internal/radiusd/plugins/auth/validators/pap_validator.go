@@ -6,6 +6,7 @@ import (
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius/rfc2865"
 )
 
@@ -24,7 +25,8 @@ func (v *PAPValidator) CanHandle(authCtx *auth.AuthContext) bool {
 func (v *PAPValidator) Validate(ctx context.Context, authCtx *auth.AuthContext, password string) error {
 	requestPassword := rfc2865.UserPassword_GetString(authCtx.Request.Packet)
 
-	if strings.TrimSpace(requestPassword) != password {
+	// PAP can verify every password scheme
+	if !passwd.Verify(password, strings.TrimSpace(requestPassword)) {
 		return errors.NewPasswordMismatchError()
 	}
 
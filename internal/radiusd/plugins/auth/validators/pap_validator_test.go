@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 )
@@ -116,3 +117,33 @@ func TestPAPValidator_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPAPValidator_Validate_HashedPasswords(t *testing.T) {
+	validator := &PAPValidator{}
+	ctx := context.Background()
+
+	for _, scheme := range passwd.Schemes {
+		t.Run(string(scheme), func(t *testing.T) {
+			stored, err := passwd.Hash(scheme, "testpass123")
+			require.NoError(t, err)
+
+			for _, tc := range []struct {
+				requestPassword string
+				expectError     bool
+			}{
+				{"testpass123", false},
+				{"wrongpass", true},
+			} {
+				packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+				_ = rfc2865.UserPassword_SetString(packet, tc.requestPassword) //nolint:errcheck
+				authCtx := &auth.AuthContext{
+					Request: &radius.Request{Packet: packet},
+					User:    &domain.RadiusUser{Username: "testuser", Password: stored},
+				}
+
+				err := validator.Validate(ctx, authCtx, stored)
+				assert.Equal(t, tc.expectError, err != nil, tc.requestPassword)
+			}
+		})
+	}
+}
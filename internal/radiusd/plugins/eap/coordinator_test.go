@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 	"layeh.com/radius/rfc2869"
@@ -82,6 +83,15 @@ func (m *mockPasswordProvider) GetPassword(user *domain.RadiusUser, isMacAuth bo
 	return user.Password, nil
 }
 
+func (m *mockPasswordProvider) GetNTHash(user *domain.RadiusUser, isMacAuth bool) ([]byte, error) {
+	password, err := m.GetPassword(user, isMacAuth)
+	if err != nil {
+		return nil, err
+	}
+	hash, _ := passwd.NTHash(password)
+	return hash, nil
+}
+
 // mockEAPHandler simulates an EAP handler for testing
 type mockEAPHandler struct {
 	name              string
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2869"
 )
@@ -422,3 +423,43 @@ func TestEAPConstants(t *testing.T) {
 func TestPasswordProviderInterface(t *testing.T) {
 	var _ PasswordProvider = (*DefaultPasswordProvider)(nil)
 }
+
+func TestDefaultPasswordProvider_PasswordSchemes(t *testing.T) {
+	provider := NewDefaultPasswordProvider()
+
+	ntHash, err := passwd.Hash(passwd.SchemeNTHash, "testpass")
+	require.NoError(t, err)
+	bcryptHash, err := passwd.Hash(passwd.SchemeBcrypt, "testpass")
+	require.NoError(t, err)
+	expectedNT, _ := passwd.NTHash("testpass")
+
+	// nt-hash users can use MSCHAPv2 but not EAP-MD5
+	user := &domain.RadiusUser{Username: "testuser", Password: ntHash}
+	_, err = provider.GetPassword(user, false)
+	assert.ErrorIs(t, err, ErrPasswordScheme)
+	hash, err := provider.GetNTHash(user, false)
+	require.NoError(t, err)
+	assert.Equal(t, expectedNT, hash)
+
+	// bcrypt users can use neither
+	user.Password = bcryptHash
+	_, err = provider.GetPassword(user, false)
+	assert.ErrorIs(t, err, ErrPasswordScheme)
+	_, err = provider.GetNTHash(user, false)
+	assert.ErrorIs(t, err, ErrPasswordScheme)
+	assert.Contains(t, err.Error(), "bcrypt")
+
+	// cleartext users can use both
+	user.Password = "testpass"
+	hash, err = provider.GetNTHash(user, false)
+	require.NoError(t, err)
+	assert.Equal(t, expectedNT, hash)
+
+	// LDAP users can use neither, whatever the local password
+	user.AuthSource = domain.AuthSourceLDAP
+	_, err = provider.GetPassword(user, false)
+	assert.ErrorIs(t, err, ErrPasswordScheme)
+	_, err = provider.GetNTHash(user, false)
+	assert.ErrorIs(t, err, ErrPasswordScheme)
+	assert.Contains(t, err.Error(), "ldap")
+}
@@ -9,4 +9,5 @@ var (
 	ErrPasswordMismatch     = errors.New("password mismatch")
 	ErrUnsupportedEAPType   = errors.New("unsupported EAP type")
 	ErrAuthenticationFailed = errors.New("authentication failed")
+	ErrPasswordScheme       = errors.New("password scheme not supported by the EAP method")
 )
@@ -1,11 +1,18 @@
 package handlers
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap/statemanager"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
 )
 
 // Mock state manager for testing
@@ -139,22 +146,22 @@ func TestMD5Handler_verifyMD5Response_EmptyResponse(t *testing.T) {
 // Tests for OTPHandler
 
 func TestNewOTPHandler(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 	assert.NotNil(t, h)
 }
 
 func TestOTPHandler_Name(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 	assert.Equal(t, "eap-otp", h.Name())
 }
 
 func TestOTPHandler_EAPType(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 	assert.Equal(t, uint8(eap.TypeOTP), h.EAPType())
 }
 
 func TestOTPHandler_CanHandle(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 
 	tests := []struct {
 		name     string
@@ -197,7 +204,7 @@ func TestOTPHandler_CanHandle(t *testing.T) {
 }
 
 func TestOTPHandler_buildChallengeRequest(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 
 	challenge := []byte("Please enter a one-time password")
 	identifier := uint8(2)
@@ -218,6 +225,87 @@ func TestOTPHandler_buildChallengeRequest(t *testing.T) {
 	assert.Equal(t, challenge, result[5:], "Challenge should be included")
 }
 
+func TestOTPHandler_HandleResponse(t *testing.T) {
+	h := NewOTPHandler(nil)
+	stateManager := statemanager.NewMemoryStateManager()
+	writer := &mockResponseWriter{}
+	user := &domain.RadiusUser{Username: "alice", TotpSecret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}
+
+	newCtx := func(msg *eap.EAPMessage, stateID string) *eap.EAPContext {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, "alice") //nolint:errcheck
+		if stateID != "" {
+			_ = rfc2865.State_SetString(packet, stateID) //nolint:errcheck
+		}
+		return &eap.EAPContext{
+			Context:        context.Background(),
+			Request:        &radius.Request{Packet: packet},
+			ResponseWriter: writer,
+			EAPMessage:     msg,
+			StateManager:   stateManager,
+			User:           user,
+			Secret:         "secret",
+		}
+	}
+
+	handled, err := h.HandleIdentity(newCtx(&eap.EAPMessage{Code: eap.CodeResponse, Identifier: 1, Type: eap.TypeIdentity}, ""))
+	require.NoError(t, err)
+	require.True(t, handled)
+	stateID := rfc2865.State_GetString(writer.response)
+	require.NotEmpty(t, stateID)
+
+	answer := func(code string) (bool, error) {
+		return h.HandleResponse(newCtx(&eap.EAPMessage{Code: eap.CodeResponse, Identifier: 2, Type: eap.TypeOTP, Data: []byte(code)}, stateID))
+	}
+
+	// The old fixed code is no longer accepted
+	_, err = answer("123456")
+	assert.ErrorIs(t, err, eap.ErrPasswordMismatch)
+
+	code, err := totp.Code(user.TotpSecret, time.Now())
+	require.NoError(t, err)
+	handled, err = answer(code)
+	require.NoError(t, err)
+	assert.True(t, handled)
+
+	// A code is accepted only once
+	_, err = answer(code)
+	assert.ErrorIs(t, err, eap.ErrPasswordMismatch)
+
+	// Users without a secret are refused
+	user.TotpSecret = ""
+	_, err = answer(code)
+	assert.ErrorIs(t, err, eap.ErrPasswordMismatch)
+}
+
+func TestOTPHandler_SharedVerifier(t *testing.T) {
+	verifier := totp.NewVerifier()
+	h := NewOTPHandler(verifier)
+	stateManager := statemanager.NewMemoryStateManager()
+	user := &domain.RadiusUser{Username: "alice", TotpSecret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}
+
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.UserName_SetString(packet, "alice") //nolint:errcheck
+	require.NoError(t, stateManager.SetState("state-1", &eap.EAPState{Username: "alice", StateID: "state-1", Method: EAPMethodOTP}))
+	_ = rfc2865.State_SetString(packet, "state-1") //nolint:errcheck
+
+	// A code already accepted on another path is refused over EAP-OTP
+	code, err := totp.Code(user.TotpSecret, time.Now())
+	require.NoError(t, err)
+	require.True(t, verifier.Verify("alice", user.TotpSecret, code))
+
+	_, err = h.HandleResponse(&eap.EAPContext{
+		Context:        context.Background(),
+		Request:        &radius.Request{Packet: packet},
+		ResponseWriter: &mockResponseWriter{},
+		EAPMessage:     &eap.EAPMessage{Code: eap.CodeResponse, Identifier: 2, Type: eap.TypeOTP, Data: []byte(code)},
+		StateManager:   stateManager,
+		User:           user,
+		Secret:         "secret",
+	})
+	assert.ErrorIs(t, err, eap.ErrPasswordMismatch)
+}
+
 // Tests for MSCHAPv2Handler - only tests not covered in mschapv2_handler_test.go
 
 // Note: Most MSCHAPv2 tests are in mschapv2_handler_test.go
@@ -280,7 +368,7 @@ func TestMD5Handler_buildChallengeRequest_VariousSizes(t *testing.T) {
 }
 
 func TestOTPHandler_buildChallengeRequest_VariousSizes(t *testing.T) {
-	h := NewOTPHandler()
+	h := NewOTPHandler(nil)
 
 	tests := []struct {
 		name      string
@@ -5,13 +5,12 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/talkincode/toughradius/v9/internal/radiusd/mschap"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/microsoft"
 	"github.com/talkincode/toughradius/v9/pkg/common"
 	"layeh.com/radius"
-	"layeh.com/radius/rfc2759"
 	"layeh.com/radius/rfc2865"
-	"layeh.com/radius/rfc3079"
 )
 
 const (
@@ -117,8 +116,8 @@ func (h *MSCHAPv2Handler) HandleResponse(ctx *eap.EAPContext) (bool, error) {
 		return false, fmt.Errorf("failed to parse MSCHAPv2 response: %w", err)
 	}
 
-	// getPassword
-	password, err := ctx.PwdProvider.GetPassword(ctx.User, ctx.IsMacAuth)
+	// MSCHAPv2 only needs the NT hash of the password
+	ntHash, err := ctx.PwdProvider.GetNTHash(ctx.User, ctx.IsMacAuth)
 	if err != nil {
 		return false, err
 	}
@@ -126,7 +125,7 @@ func (h *MSCHAPv2Handler) HandleResponse(ctx *eap.EAPContext) (bool, error) {
 	// Validate MSCHAPv2 Response
 	success, err := h.verifyResponse(
 		ctx.User.Username,
-		password,
+		ntHash,
 		state.Challenge,
 		msResp.PeerChallenge,
 		msResp.NTResponse,
@@ -250,7 +249,7 @@ func (h *MSCHAPv2Handler) parseResponse(data []byte) (*MSCHAPv2ResponseData, err
 // verifyResponse validates the MSCHAPv2 response and generates encryption keys
 func (h *MSCHAPv2Handler) verifyResponse(
 	username string,
-	password string,
+	ntHash []byte,
 	authChallenge []byte,
 	peerChallenge []byte,
 	ntResponse []byte,
@@ -258,18 +257,9 @@ func (h *MSCHAPv2Handler) verifyResponse(
 	msIdentifier uint8,
 ) (bool, error) {
 	byteUser := []byte(username)
-	bytePwd := []byte(password)
 
 	// Using RFC 2759 Generate NT-Response
-	expectedNTResponse, err := rfc2759.GenerateNTResponse(
-		authChallenge,
-		peerChallenge,
-		byteUser,
-		bytePwd,
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to generate NT-Response: %w", err)
-	}
+	expectedNTResponse := mschap.NTResponse(authChallenge, peerChallenge, byteUser, ntHash)
 
 	// Validate NT-Response
 	if !bytes.Equal(expectedNTResponse, ntResponse) {
@@ -277,27 +267,18 @@ func (h *MSCHAPv2Handler) verifyResponse(
 	}
 
 	// Generate MPPE keys
-	recvKey, err := rfc3079.MakeKey(expectedNTResponse, bytePwd, false)
+	recvKey, err := mschap.MakeKey(expectedNTResponse, ntHash, false)
 	if err != nil {
 		return false, fmt.Errorf("failed to generate recv key: %w", err)
 	}
 
-	sendKey, err := rfc3079.MakeKey(expectedNTResponse, bytePwd, true)
+	sendKey, err := mschap.MakeKey(expectedNTResponse, ntHash, true)
 	if err != nil {
 		return false, fmt.Errorf("failed to generate send key: %w", err)
 	}
 
 	// Generate Authenticator Response (RFC 2759)
-	authenticatorResponse, err := rfc2759.GenerateAuthenticatorResponse(
-		authChallenge,
-		peerChallenge,
-		expectedNTResponse,
-		byteUser,
-		bytePwd,
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to generate authenticator response: %w", err)
-	}
+	authenticatorResponse := mschap.AuthenticatorResponse(authChallenge, peerChallenge, expectedNTResponse, byteUser, ntHash)
 
 	// Construct the MSCHAPv2-Success attribute value
 	// format: Ident(1) + Authenticator-Response(42)
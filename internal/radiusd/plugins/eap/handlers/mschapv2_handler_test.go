@@ -9,6 +9,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap/statemanager"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 	"layeh.com/radius/rfc2869"
@@ -36,6 +37,12 @@ func (m *mockPasswordProvider) GetPassword(user *domain.RadiusUser, isMacAuth bo
 	return user.Password, nil
 }
 
+func (m *mockPasswordProvider) GetNTHash(user *domain.RadiusUser, isMacAuth bool) ([]byte, error) {
+	password, _ := m.GetPassword(user, isMacAuth)
+	hash, _ := passwd.NTHash(password)
+	return hash, nil
+}
+
 func TestMSCHAPv2Handler_Name(t *testing.T) {
 	handler := NewMSCHAPv2Handler()
 	assert.Equal(t, EAPMethodMSCHAPv2, handler.Name())
@@ -282,9 +289,10 @@ func TestMSCHAPv2Handler_verifyResponse(t *testing.T) {
 	packet := radius.New(radius.CodeAccessAccept, []byte("secret"))
 
 	// This should fail because ntResponse is empty
+	ntHash, _ := passwd.NTHash(password)
 	success, err := handler.verifyResponse(
 		username,
-		password,
+		ntHash,
 		authChallenge,
 		peerChallenge,
 		ntResponse,
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"strings"
+
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 )
@@ -12,12 +15,20 @@ const (
 	EAPMethodOTP        = "eap-otp"
 )
 
-// OTPHandler EAP-OTP authenticationhandler
-type OTPHandler struct{}
+// OTPHandler EAP-OTP authenticationhandler, verifying TOTP codes against the
+// secret enrolled for the user
+type OTPHandler struct {
+	verifier *totp.Verifier
+}
 
-// NewOTPHandler Create EAP-OTP handler
-func NewOTPHandler() *OTPHandler {
-	return &OTPHandler{}
+// NewOTPHandler Create EAP-OTP handler. The verifier should be the one used
+// by the other TOTP paths so a code cannot be replayed across them; a new
+// verifier is created when nil.
+func NewOTPHandler(verifier *totp.Verifier) *OTPHandler {
+	if verifier == nil {
+		verifier = totp.NewVerifier()
+	}
+	return &OTPHandler{verifier: verifier}
 }
 
 // Name Returnshandlernames
@@ -88,15 +99,19 @@ func (h *OTPHandler) HandleResponse(ctx *eap.EAPContext) (bool, error) {
 		return false, err
 	}
 
-	// Get the OTP password (simplified here; a real implementation should call an OTP validation service)
-	// TODO: Integrate with a real OTP validation logic
-	expectedOTP := "123456" // Sample fixed value; in reality retrieve from the validation service
+	// Users without an enrolled secret cannot answer the challenge
+	if ctx.User == nil || ctx.User.TotpSecret == "" {
+		return false, eap.ErrPasswordMismatch
+	}
+	if state.Username != ctx.User.Username {
+		return false, eap.ErrStateNotFound
+	}
 
 	// Extract the user's entered OTP from the EAP data
-	userOTP := string(ctx.EAPMessage.Data)
+	userOTP := strings.TrimSpace(string(ctx.EAPMessage.Data))
 
-	// Validate OTP
-	if userOTP != expectedOTP {
+	// Validate OTP, a code is accepted only once
+	if !h.verifier.Verify(ctx.User.Username, ctx.User.TotpSecret, userOTP) {
 		return false, eap.ErrPasswordMismatch
 	}
 
@@ -96,6 +96,11 @@ type EAPStateManager interface {
 
 // PasswordProvider defines how to retrieve passwords
 type PasswordProvider interface {
-	// GetPassword retrieves the user's password (plain or encrypted)
+	// GetPassword retrieves the user's cleartext password, failing with
+	// ErrPasswordScheme when the password is stored hashed
 	GetPassword(user *domain.RadiusUser, isMacAuth bool) (string, error)
+
+	// GetNTHash retrieves the NT hash of the user's password, failing with
+	// ErrPasswordScheme when the stored scheme cannot provide it
+	GetNTHash(user *domain.RadiusUser, isMacAuth bool) ([]byte, error)
 }
@@ -1,7 +1,10 @@
 package eap
 
 import (
+	"fmt"
+
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 )
 
 // DefaultPasswordProvider is the default password provider implementation
@@ -14,8 +17,34 @@ func NewDefaultPasswordProvider() *DefaultPasswordProvider {
 
 // GetPassword returns the user's password
 // For MAC authentication, return the MAC address as the password
-// For regular users, return the plaintext password
+// For regular users, return the cleartext password
 func (p *DefaultPasswordProvider) GetPassword(user *domain.RadiusUser, isMacAuth bool) (string, error) {
+	stored, err := p.storedPassword(user, isMacAuth)
+	if err != nil {
+		return "", err
+	}
+	plain, ok := passwd.Cleartext(stored)
+	if !ok {
+		return "", fmt.Errorf("%w: a cleartext password is required, user has %s", ErrPasswordScheme, passwd.Identify(stored))
+	}
+	return plain, nil
+}
+
+// GetNTHash returns the NT hash of the user's password, which is available
+// for cleartext and nt-hash passwords
+func (p *DefaultPasswordProvider) GetNTHash(user *domain.RadiusUser, isMacAuth bool) ([]byte, error) {
+	stored, err := p.storedPassword(user, isMacAuth)
+	if err != nil {
+		return nil, err
+	}
+	hash, ok := passwd.NTHash(stored)
+	if !ok {
+		return nil, fmt.Errorf("%w: a cleartext or nt-hash password is required, user has %s", ErrPasswordScheme, passwd.Identify(stored))
+	}
+	return hash, nil
+}
+
+func (p *DefaultPasswordProvider) storedPassword(user *domain.RadiusUser, isMacAuth bool) (string, error) {
 	if isMacAuth {
 		// Use the MAC address as the password (remove separators)
 		if user.MacAddr != "" {
@@ -23,7 +52,9 @@ func (p *DefaultPasswordProvider) GetPassword(user *domain.RadiusUser, isMacAuth
 		}
 		return user.Username, nil
 	}
-
-	// Regular authentication returns the plaintext password
+	// The password of LDAP users lives in the directory and can only be checked with PAP
+	if user.AuthSource == domain.AuthSourceLDAP {
+		return "", fmt.Errorf("%w: ldap users only support PAP", ErrPasswordScheme)
+	}
 	return user.Password, nil
 }
@@ -2,6 +2,8 @@ package plugins
 
 import (
 	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/httphook"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/accounting/handlers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth/checkers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth/enhancers"
@@ -12,12 +14,20 @@ import (
 	eaphandlers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap/handlers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/repository"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
 )
 
 // InitPlugins initializes all plugins
-// sessionRepo and accountingRepo must be supplied externally to support dependency injection for plugins
-func InitPlugins(appCtx app.ConfigManagerProvider, sessionRepo repository.SessionRepository, accountingRepo repository.AccountingRepository) {
+// sessionRepo, accountingRepo, deviceRepo and disconnector must be supplied externally to support dependency injection for plugins
+// otpVerifier is the TOTP verifier shared with the PAP path so a code cannot be replayed over EAP-OTP
+func InitPlugins(appCtx app.ConfigManagerProvider, sessionRepo repository.SessionRepository, accountingRepo repository.AccountingRepository, deviceRepo repository.DeviceRepository, disconnector checkers.SessionDisconnector, otpVerifier *totp.Verifier) {
 	// Register password validators (stateless plugins)
+	// LDAP shadow users are checked against the directory before the local validators
+	var ldapConfig ldapauth.ConfigGetter
+	if appCtx != nil && appCtx.ConfigMgr() != nil {
+		ldapConfig = appCtx.ConfigMgr()
+	}
+	registry.RegisterPasswordValidator(validators.NewLDAPValidator(ldapauth.NewDirectory(ldapConfig)))
 	registry.RegisterPasswordValidator(&validators.PAPValidator{})
 	registry.RegisterPasswordValidator(&validators.CHAPValidator{})
 	registry.RegisterPasswordValidator(&validators.MSCHAPValidator{})
@@ -25,13 +35,32 @@ func InitPlugins(appCtx app.ConfigManagerProvider, sessionRepo repository.Sessio
 	// Register profile checkers (mostly stateless)
 	registry.RegisterPolicyChecker(&checkers.StatusChecker{})
 	registry.RegisterPolicyChecker(&checkers.ExpireChecker{})
-	registry.RegisterPolicyChecker(&checkers.MacBindChecker{})
+	registry.RegisterPolicyChecker(&checkers.TimeWindowChecker{})
+	registry.RegisterPolicyChecker(checkers.NewMacBindChecker(deviceRepo))
 	registry.RegisterPolicyChecker(&checkers.VlanBindChecker{})
+	registry.RegisterPolicyChecker(&checkers.LineBindChecker{})
+	registry.RegisterPolicyChecker(checkers.NewCheckItemsChecker())
 
 	// Checkers that require dependency injection
+	var nodeConfig interface{ GetBool(string, string) bool }
+	var onlineConfig interface{ GetInt64(string, string) int64 }
+	if appCtx != nil && appCtx.ConfigMgr() != nil {
+		nodeConfig = appCtx.ConfigMgr()
+		onlineConfig = appCtx.ConfigMgr()
+	}
+	registry.RegisterPolicyChecker(checkers.NewNodeBindChecker(nodeConfig))
 	if sessionRepo != nil {
-		registry.RegisterPolicyChecker(checkers.NewOnlineCountChecker(sessionRepo))
+		registry.RegisterPolicyChecker(checkers.NewOnlineCountChecker(sessionRepo, disconnector, onlineConfig))
+	}
+
+	// The external HTTP hook is consulted after the local checks; the same
+	// client carries its reply attributes to the enhancer and posts accounting
+	var hookConfig httphook.ConfigGetter
+	if appCtx != nil && appCtx.ConfigMgr() != nil {
+		hookConfig = appCtx.ConfigMgr()
 	}
+	hookClient := httphook.NewClient(hookConfig)
+	registry.RegisterPolicyChecker(checkers.NewHTTPHookChecker(hookClient))
 
 	// Register response enhancers
 	registry.RegisterResponseEnhancer(enhancers.NewDefaultAcceptEnhancer())
@@ -40,6 +69,14 @@ func InitPlugins(appCtx app.ConfigManagerProvider, sessionRepo repository.Sessio
 	registry.RegisterResponseEnhancer(enhancers.NewZTEAcceptEnhancer())
 	registry.RegisterResponseEnhancer(enhancers.NewMikrotikAcceptEnhancer())
 	registry.RegisterResponseEnhancer(enhancers.NewIkuaiAcceptEnhancer())
+	registry.RegisterResponseEnhancer(enhancers.NewCiscoAcceptEnhancer())
+	registry.RegisterResponseEnhancer(enhancers.NewJuniperAcceptEnhancer())
+	registry.RegisterResponseEnhancer(enhancers.NewArubaAcceptEnhancer())
+	registry.RegisterResponseEnhancer(enhancers.NewRuckusAcceptEnhancer())
+	// Custom reply attributes run last so they can override vendor attributes,
+	// the hook attributes are applied after the local ones
+	registry.RegisterResponseEnhancer(enhancers.NewCustomAttrsAcceptEnhancer())
+	registry.RegisterResponseEnhancer(enhancers.NewHTTPHookEnhancer(hookClient))
 
 	// Register authentication guards
 	var cfgGetter interface{ GetInt64(string, string) int64 }
@@ -54,11 +91,13 @@ func InitPlugins(appCtx app.ConfigManagerProvider, sessionRepo repository.Sessio
 		registry.RegisterAccountingHandler(handlers.NewUpdateHandler(sessionRepo))
 		registry.RegisterAccountingHandler(handlers.NewStopHandler(sessionRepo, accountingRepo))
 		registry.RegisterAccountingHandler(handlers.NewNasStateHandler(sessionRepo))
+		// Runs after the session handlers above
+		registry.RegisterAccountingHandler(handlers.NewHTTPHookHandler(hookClient))
 	}
 
 	// Register EAP handlers
 	registry.RegisterEAPHandler(eaphandlers.NewMD5Handler())
-	registry.RegisterEAPHandler(eaphandlers.NewOTPHandler())
+	registry.RegisterEAPHandler(eaphandlers.NewOTPHandler(otpVerifier))
 	registry.RegisterEAPHandler(eaphandlers.NewMSCHAPv2Handler())
 
 	// Vendor parsers under vendor/parsers register themselves via init()
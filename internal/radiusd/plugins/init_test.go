@@ -12,7 +12,7 @@ func TestInitPlugins_WithNilDependencies(t *testing.T) {
 	defer registry.ResetForTest()
 
 	assert.NotPanics(t, func() {
-		InitPlugins(nil, nil, nil)
+		InitPlugins(nil, nil, nil, nil, nil, nil)
 	})
 
 	validators := registry.GetPasswordValidators()
@@ -35,10 +35,10 @@ func TestInitPlugins_PasswordValidators(t *testing.T) {
 	registry.ResetForTest()
 	defer registry.ResetForTest()
 
-	InitPlugins(nil, nil, nil)
+	InitPlugins(nil, nil, nil, nil, nil, nil)
 
-	// Actual names returned by Name() method: "pap", "chap", "mschap"
-	expectedValidators := []string{"pap", "chap", "mschap"}
+	// Actual names returned by Name() method: "ldap", "pap", "chap", "mschap"
+	expectedValidators := []string{"ldap", "pap", "chap", "mschap"}
 	validators := registry.GetPasswordValidators()
 
 	validatorNames := make(map[string]bool)
@@ -55,7 +55,7 @@ func TestInitPlugins_PolicyCheckers(t *testing.T) {
 	registry.ResetForTest()
 	defer registry.ResetForTest()
 
-	InitPlugins(nil, nil, nil)
+	InitPlugins(nil, nil, nil, nil, nil, nil)
 
 	checkers := registry.GetPolicyCheckers()
 	assert.GreaterOrEqual(t, len(checkers), 4)
@@ -65,8 +65,8 @@ func TestInitPlugins_PolicyCheckers(t *testing.T) {
 		checkerNames[c.Name()] = true
 	}
 
-	// Actual names returned by Name() method: "status", "expire", "mac_bind", "vlan_bind"
-	expectedCheckers := []string{"status", "expire", "mac_bind", "vlan_bind"}
+	// Actual names returned by Name() method: "status", "expire", "node_bind", "mac_bind", "vlan_bind"
+	expectedCheckers := []string{"status", "expire", "node_bind", "mac_bind", "vlan_bind"}
 	for _, name := range expectedCheckers {
 		assert.True(t, checkerNames[name], "checker %s should be registered", name)
 	}
@@ -76,7 +76,7 @@ func TestInitPlugins_ResponseEnhancers(t *testing.T) {
 	registry.ResetForTest()
 	defer registry.ResetForTest()
 
-	InitPlugins(nil, nil, nil)
+	InitPlugins(nil, nil, nil, nil, nil, nil)
 
 	enhancers := registry.GetResponseEnhancers()
 	assert.GreaterOrEqual(t, len(enhancers), 5)
@@ -86,7 +86,7 @@ func TestInitPlugins_EAPHandlers(t *testing.T) {
 	registry.ResetForTest()
 	defer registry.ResetForTest()
 
-	InitPlugins(nil, nil, nil)
+	InitPlugins(nil, nil, nil, nil, nil, nil)
 
 	eapHandlers := registry.GetAllEAPHandlers()
 
@@ -99,7 +99,7 @@ func TestInitPlugins_NoAccountingHandlersWithNilRepos(t *testing.T) {
 	registry.ResetForTest()
 	defer registry.ResetForTest()
 
-	InitPlugins(nil, nil, nil)
+	InitPlugins(nil, nil, nil, nil, nil, nil)
 
 	handlers := registry.GetAccountingHandlers()
 	assert.Empty(t, handlers)
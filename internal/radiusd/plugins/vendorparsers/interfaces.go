@@ -9,6 +9,13 @@ type VendorRequest struct {
 	MacAddr string
 	Vlanid1 int64
 	Vlanid2 int64
+	Ssid    string
+	ApGroup string
+	HostIP  string // Client address reported by the NAS, e.g. Mikrotik-Host-IP
+	Realm   string
+	// Access line identifiers, RFC 4679 Agent-Circuit-Id / Agent-Remote-Id
+	CircuitId string
+	RemoteId  string
 }
 
 // VendorParser defines the vendor attribute parser interface
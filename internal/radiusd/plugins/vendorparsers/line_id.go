@@ -0,0 +1,29 @@
+package vendorparsers
+
+import (
+	"encoding/hex"
+	"unicode"
+
+	"layeh.com/radius"
+	"layeh.com/radius/rfc4679"
+)
+
+// ParseAgentLineIds reads the RFC 4679 Agent-Circuit-Id and Agent-Remote-Id
+// attributes that access nodes insert for PPPoE and IPoE subscribers.
+func ParseAgentLineIds(p *radius.Packet) (circuitId, remoteId string) {
+	return FormatLineId(rfc4679.ADSLAgentCircuitID_Get(p)), FormatLineId(rfc4679.ADSLAgentRemoteID_Get(p))
+}
+
+// FormatLineId renders a line identifier. Printable values are kept as text,
+// binary values such as raw Option 82 suboptions are hex encoded.
+func FormatLineId(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	for _, c := range b {
+		if c > unicode.MaxASCII || !unicode.IsPrint(rune(c)) {
+			return hex.EncodeToString(b)
+		}
+	}
+	return string(b)
+}
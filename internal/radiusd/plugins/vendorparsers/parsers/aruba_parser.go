@@ -0,0 +1,36 @@
+package parsers
+
+import (
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/aruba"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// ArubaParser parses Aruba controller and Instant AP attributes
+type ArubaParser struct{}
+
+func (p *ArubaParser) VendorCode() string {
+	return vendors.CodeAruba
+}
+
+func (p *ArubaParser) VendorName() string {
+	return "Aruba"
+}
+
+func (p *ArubaParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Parse MAC addresses; Aruba sends bare 12-digit or delimited strings
+	macval := rfc2865.CallingStationID_GetString(r.Packet)
+	if macval != "" {
+		vr.MacAddr = normalizeDottedMac(macval)
+	}
+
+	// WLAN and AP group the client associated with
+	vr.Ssid = aruba.ArubaEssidName_GetString(r.Packet)
+	vr.ApGroup = aruba.ArubaAPGroup_GetString(r.Packet)
+
+	return vr, nil
+}
@@ -0,0 +1,80 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/aruba"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestArubaParser_VendorCode(t *testing.T) {
+	parser := &ArubaParser{}
+	assert.Equal(t, vendors.CodeAruba, parser.VendorCode())
+}
+
+func TestArubaParser_VendorName(t *testing.T) {
+	parser := &ArubaParser{}
+	assert.Equal(t, "Aruba", parser.VendorName())
+}
+
+func TestArubaParser_Parse(t *testing.T) {
+	parser := &ArubaParser{}
+
+	tests := []struct {
+		name            string
+		callingStation  string
+		essid           string
+		apGroup         string
+		expectedMac     string
+		expectedSsid    string
+		expectedApGroup string
+	}{
+		{
+			name:            "bare mac with essid and ap group",
+			callingStation:  "001122334455",
+			essid:           "campus-staff",
+			apGroup:         "library",
+			expectedMac:     "00:11:22:33:44:55",
+			expectedSsid:    "campus-staff",
+			expectedApGroup: "library",
+		},
+		{
+			name:           "dashed mac without wlan attributes",
+			callingStation: "AA-BB-CC-DD-EE-FF",
+			expectedMac:    "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:         "no calling station",
+			essid:        "campus-guest",
+			expectedSsid: "campus-guest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			if tt.callingStation != "" {
+				_ = rfc2865.CallingStationID_SetString(packet, tt.callingStation) //nolint:errcheck
+			}
+			if tt.essid != "" {
+				_ = aruba.ArubaEssidName_SetString(packet, tt.essid) //nolint:errcheck
+			}
+			if tt.apGroup != "" {
+				_ = aruba.ArubaAPGroup_SetString(packet, tt.apGroup) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			require.NotNil(t, vr)
+
+			assert.Equal(t, tt.expectedMac, vr.MacAddr)
+			assert.Equal(t, tt.expectedSsid, vr.Ssid)
+			assert.Equal(t, tt.expectedApGroup, vr.ApGroup)
+			assert.Equal(t, int64(0), vr.Vlanid1)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/cisco"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+var (
+	// Gi0/0/1.100:100-200, 0/0/1/100:100-200 or 0/0/1/100.200
+	ciscoVlanRegexp1 = regexp.MustCompile(`\d+/\d+/\d+(?:\.\d+)?:(\d+)(?:-(\d+))?`)
+	ciscoVlanRegexp2 = regexp.MustCompile(`^\d+/\d+/\d+/(\d+)(?:\.(\d+))?$`)
+	// Subinterface number without an explicit VLAN section, e.g. Gi0/0/1.100
+	ciscoVlanRegexp3 = regexp.MustCompile(`[A-Za-z]+\d+/\d+/\d+\.(\d+)$`)
+)
+
+// CiscoParser parses Cisco ISG / ASR subscriber attributes
+type CiscoParser struct{}
+
+func (p *CiscoParser) VendorCode() string {
+	return vendors.CodeCisco
+}
+
+func (p *CiscoParser) VendorName() string {
+	return "Cisco"
+}
+
+func (p *CiscoParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Parse MAC addresses; ISG sends Cisco-AVPair "client-mac-address=aabb.ccdd.eeff"
+	if avpairs, err := cisco.CiscoAVPair_GetStrings(r.Packet); err == nil {
+		for _, avpair := range avpairs {
+			if mac, ok := ciscoAVPairValue(avpair, "client-mac-address"); ok {
+				vr.MacAddr = normalizeDottedMac(mac)
+				break
+			}
+		}
+	}
+	if vr.MacAddr == "" {
+		// Fallback: use the standard CallingStationID
+		macval := rfc2865.CallingStationID_GetString(r.Packet)
+		if macval != "" {
+			vr.MacAddr = normalizeDottedMac(macval)
+		}
+	}
+
+	// Parse VLANs from Cisco-NAS-Port, falling back to NAS-Port-Id
+	nasport := cisco.CiscoNASPort_GetString(r.Packet)
+	if nasport == "" {
+		nasport = rfc2869.NASPortID_GetString(r.Packet)
+	}
+	vr.Vlanid1, vr.Vlanid2 = parseCiscoVlanIds(nasport)
+
+	return vr, nil
+}
+
+// ciscoAVPairValue returns the value of a Cisco-AVPair "[protocol:]key=value"
+// entry when its key matches. The protocol prefix is optional.
+func ciscoAVPairValue(avpair, key string) (string, bool) {
+	name, value, ok := strings.Cut(avpair, "=")
+	if !ok {
+		return "", false
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	if !strings.EqualFold(strings.TrimSpace(name), key) {
+		return "", false
+	}
+	return strings.TrimSpace(value), true
+}
+
+// normalizeDottedMac converts dotted (aabb.ccdd.eeff), dashed or bare MAC
+// addresses to the colon separated form.
+func normalizeDottedMac(mac string) string {
+	hex := strings.NewReplacer(".", "", "-", "", ":", "").Replace(mac)
+	if len(hex) != 12 {
+		return strings.ReplaceAll(mac, "-", ":")
+	}
+	return hex[0:2] + ":" + hex[2:4] + ":" + hex[4:6] + ":" + hex[6:8] + ":" + hex[8:10] + ":" + hex[10:12]
+}
+
+// parseCiscoVlanIds extracts the outer and inner VLAN from a Cisco port string
+func parseCiscoVlanIds(nasport string) (int64, int64) {
+	attrs := ciscoVlanRegexp1.FindStringSubmatch(nasport)
+	if attrs == nil {
+		attrs = ciscoVlanRegexp2.FindStringSubmatch(nasport)
+	}
+	if attrs == nil {
+		attrs = ciscoVlanRegexp3.FindStringSubmatch(nasport)
+	}
+	if attrs == nil {
+		return 0, 0
+	}
+	vlanid1, _ := strconv.ParseInt(attrs[1], 10, 64) //nolint:errcheck
+	var vlanid2 int64
+	if len(attrs) > 2 && attrs[2] != "" {
+		vlanid2, _ = strconv.ParseInt(attrs[2], 10, 64) //nolint:errcheck
+	}
+	return vlanid1, vlanid2
+}
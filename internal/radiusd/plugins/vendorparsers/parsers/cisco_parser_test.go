@@ -0,0 +1,116 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/cisco"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+func TestCiscoParser_VendorCode(t *testing.T) {
+	parser := &CiscoParser{}
+	assert.Equal(t, vendors.CodeCisco, parser.VendorCode())
+}
+
+func TestCiscoParser_VendorName(t *testing.T) {
+	parser := &CiscoParser{}
+	assert.Equal(t, "Cisco", parser.VendorName())
+}
+
+func TestCiscoParser_Parse(t *testing.T) {
+	parser := &CiscoParser{}
+
+	tests := []struct {
+		name           string
+		avpairs        []string
+		callingStation string
+		ciscoNasPort   string
+		nasPortID      string
+		expectedMac    string
+		expectedVlan1  int64
+		expectedVlan2  int64
+	}{
+		{
+			name:          "client-mac-address avpair",
+			avpairs:       []string{"connect-progress=LAN Ses Up", "client-mac-address=0011.2233.4455"},
+			expectedMac:   "00:11:22:33:44:55",
+			expectedVlan1: 0,
+			expectedVlan2: 0,
+		},
+		{
+			name:           "avpair preferred over calling station",
+			avpairs:        []string{"subscriber:client-mac-address=aabb.ccdd.eeff"},
+			callingStation: "00-11-22-33-44-55",
+			expectedMac:    "aa:bb:cc:dd:ee:ff",
+		},
+		{
+			name:           "calling station fallback",
+			callingStation: "0011.2233.4455",
+			expectedMac:    "00:11:22:33:44:55",
+		},
+		{
+			name:          "qinq nas port",
+			ciscoNasPort:  "0/0/1/100:100-200",
+			expectedVlan1: 100,
+			expectedVlan2: 200,
+		},
+		{
+			name:          "subinterface with vlan section",
+			ciscoNasPort:  "Gi0/0/1.300:300",
+			expectedVlan1: 300,
+			expectedVlan2: 0,
+		},
+		{
+			name:          "slot subslot port vlan",
+			ciscoNasPort:  "0/0/2/410.20",
+			expectedVlan1: 410,
+			expectedVlan2: 20,
+		},
+		{
+			name:          "subinterface only",
+			ciscoNasPort:  "GigabitEthernet0/0/1.120",
+			expectedVlan1: 120,
+		},
+		{
+			name:          "nas-port-id fallback",
+			nasPortID:     "0/0/3/55:55-66",
+			expectedVlan1: 55,
+			expectedVlan2: 66,
+		},
+		{
+			name:         "unrecognised port",
+			ciscoNasPort: "Virtual-Access2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			for _, avpair := range tt.avpairs {
+				_ = cisco.CiscoAVPair_AddString(packet, avpair) //nolint:errcheck
+			}
+			if tt.callingStation != "" {
+				_ = rfc2865.CallingStationID_SetString(packet, tt.callingStation) //nolint:errcheck
+			}
+			if tt.ciscoNasPort != "" {
+				_ = cisco.CiscoNASPort_SetString(packet, tt.ciscoNasPort) //nolint:errcheck
+			}
+			if tt.nasPortID != "" {
+				_ = rfc2869.NASPortID_SetString(packet, tt.nasPortID) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			require.NotNil(t, vr)
+
+			assert.Equal(t, tt.expectedMac, vr.MacAddr)
+			assert.Equal(t, tt.expectedVlan1, vr.Vlanid1)
+			assert.Equal(t, tt.expectedVlan2, vr.Vlanid2)
+		})
+	}
+}
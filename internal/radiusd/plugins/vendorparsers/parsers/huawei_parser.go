@@ -5,6 +5,7 @@ import (
 
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/huawei"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
 )
@@ -34,5 +35,42 @@ func (p *HuaweiParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, e
 	vr.Vlanid1 = 0
 	vr.Vlanid2 = 0
 
+	// IPoE users carry the relayed DHCP options in HW-DHCP-Option,
+	// PPPoE users the DSL Forum attributes
+	vr.CircuitId, vr.RemoteId = parseOption82(huawei.HuaweiDHCPOption_Get(r.Packet))
+	if vr.CircuitId == "" && vr.RemoteId == "" {
+		vr.CircuitId, vr.RemoteId = vendorparsers.ParseAgentLineIds(r.Packet)
+	}
+
 	return vr, nil
 }
+
+// parseOption82 extracts the circuit and remote ID suboptions of the DHCP
+// relay agent information option (82) from a list of DHCP options.
+func parseOption82(options []byte) (circuitId, remoteId string) {
+	for len(options) >= 2 {
+		code, size := options[0], int(options[1])
+		if len(options) < 2+size {
+			return
+		}
+		if code == 82 {
+			subs := options[2 : 2+size]
+			for len(subs) >= 2 {
+				subCode, subSize := subs[0], int(subs[1])
+				if len(subs) < 2+subSize {
+					break
+				}
+				switch subCode {
+				case 1:
+					circuitId = vendorparsers.FormatLineId(subs[2 : 2+subSize])
+				case 2:
+					remoteId = vendorparsers.FormatLineId(subs[2 : 2+subSize])
+				}
+				subs = subs[2+subSize:]
+			}
+			return
+		}
+		options = options[2+size:]
+	}
+	return
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/huawei"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc4679"
 )
 
 func TestHuaweiParser_VendorCode(t *testing.T) {
@@ -102,3 +104,64 @@ func TestHuaweiParser_Parse_NoAttributes(t *testing.T) {
 	assert.Equal(t, int64(0), vr.Vlanid1)
 	assert.Equal(t, int64(0), vr.Vlanid2)
 }
+
+func TestHuaweiParser_Parse_LineIds(t *testing.T) {
+	parser := &HuaweiParser{}
+
+	tests := []struct {
+		name            string
+		dhcpOption      []byte
+		circuitId       string
+		remoteId        string
+		expectedCircuit string
+		expectedRemote  string
+	}{
+		{
+			name: "ipoe option 82",
+			// Option 53 (DHCPREQUEST) followed by option 82 with circuit and remote ID
+			dhcpOption: append([]byte{53, 1, 3, 82, 28, 1, 16},
+				append([]byte("OLT1 0/1/0/3:100"), append([]byte{2, 8}, []byte("HW000123")...)...)...),
+			expectedCircuit: "OLT1 0/1/0/3:100",
+			expectedRemote:  "HW000123",
+		},
+		{
+			name:            "binary remote id",
+			dhcpOption:      []byte{82, 10, 2, 8, 0x00, 0x06, 0xe0, 0xfc, 0x12, 0x34, 0x56, 0x78},
+			expectedCircuit: "",
+			expectedRemote:  "0006e0fc12345678",
+		},
+		{
+			name:            "truncated option",
+			dhcpOption:      []byte{82, 20, 1, 4, 'a'},
+			expectedCircuit: "",
+			expectedRemote:  "",
+		},
+		{
+			name:            "pppoe dsl forum attributes",
+			circuitId:       "0a0b0c0d eth 0/1/0/7:835",
+			remoteId:        "subscriber-42",
+			expectedCircuit: "0a0b0c0d eth 0/1/0/7:835",
+			expectedRemote:  "subscriber-42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			if tt.dhcpOption != nil {
+				_ = huawei.HuaweiDHCPOption_Add(packet, tt.dhcpOption) //nolint:errcheck
+			}
+			if tt.circuitId != "" {
+				_ = rfc4679.ADSLAgentCircuitID_AddString(packet, tt.circuitId) //nolint:errcheck
+			}
+			if tt.remoteId != "" {
+				_ = rfc4679.ADSLAgentRemoteID_AddString(packet, tt.remoteId) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCircuit, vr.CircuitId)
+			assert.Equal(t, tt.expectedRemote, vr.RemoteId)
+		})
+	}
+}
@@ -34,4 +34,46 @@ func init() {
 		Description: "ZTE RADIUS attributes",
 		Parser:      &ZTEParser{},
 	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodeCisco,
+		Name:        "Cisco",
+		Description: "Cisco ISG / ASR RADIUS attributes",
+		Parser:      &CiscoParser{},
+	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodeJuniper,
+		Name:        "Juniper",
+		Description: "Juniper MX / ERX RADIUS attributes",
+		Parser:      &JuniperParser{},
+	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodeAruba,
+		Name:        "Aruba",
+		Description: "Aruba WLAN RADIUS attributes",
+		Parser:      &ArubaParser{},
+	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodeRuckus,
+		Name:        "Ruckus",
+		Description: "Ruckus WLAN RADIUS attributes",
+		Parser:      &RuckusParser{},
+	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodeMikrotik,
+		Name:        "Mikrotik",
+		Description: "MikroTik RouterOS RADIUS attributes",
+		Parser:      &MikrotikParser{},
+	})
+
+	_ = vendors.Register(&vendors.VendorInfo{ //nolint:errcheck
+		Code:        vendors.CodePfSense,
+		Name:        "pfSense",
+		Description: "pfSense captive portal and PPPoE RADIUS attributes",
+		Parser:      &PfSenseParser{},
+	})
 }
@@ -0,0 +1,67 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/erx"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+var (
+	// ge-1/0/0.1073741823:100-200 or xe-0/0/1:300
+	juniperVlanRegexp1 = regexp.MustCompile(`[a-z]+-\d+/\d+/\d+(?:\.\d+)?:(\d+)(?:-(\d+))?`)
+	// Logical unit without a VLAN section, e.g. ge-1/0/0.100
+	juniperVlanRegexp2 = regexp.MustCompile(`[a-z]+-\d+/\d+/\d+\.(\d+)$`)
+)
+
+// JuniperParser parses Juniper MX / ERX subscriber attributes
+type JuniperParser struct{}
+
+func (p *JuniperParser) VendorCode() string {
+	return vendors.CodeJuniper
+}
+
+func (p *JuniperParser) VendorName() string {
+	return "Juniper"
+}
+
+func (p *JuniperParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Parse MAC addresses; DHCP subscribers carry ERX-Dhcp-Mac-Addr "0011.2233.4455"
+	macval := erx.ERXDhcpMacAddr_GetString(r.Packet)
+	if macval == "" {
+		// Fallback: use the standard CallingStationID
+		macval = rfc2865.CallingStationID_GetString(r.Packet)
+	}
+	if macval != "" {
+		vr.MacAddr = normalizeDottedMac(macval)
+	}
+
+	// Parse VLANs from NAS-Port-Id
+	vr.Vlanid1, vr.Vlanid2 = parseJuniperVlanIds(rfc2869.NASPortID_GetString(r.Packet))
+
+	return vr, nil
+}
+
+// parseJuniperVlanIds extracts the outer and inner VLAN from a Juniper NAS-Port-Id
+func parseJuniperVlanIds(nasportid string) (int64, int64) {
+	attrs := juniperVlanRegexp1.FindStringSubmatch(nasportid)
+	if attrs == nil {
+		attrs = juniperVlanRegexp2.FindStringSubmatch(nasportid)
+	}
+	if attrs == nil {
+		return 0, 0
+	}
+	vlanid1, _ := strconv.ParseInt(attrs[1], 10, 64) //nolint:errcheck
+	var vlanid2 int64
+	if len(attrs) > 2 && attrs[2] != "" {
+		vlanid2, _ = strconv.ParseInt(attrs[2], 10, 64) //nolint:errcheck
+	}
+	return vlanid1, vlanid2
+}
@@ -0,0 +1,97 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/erx"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+func TestJuniperParser_VendorCode(t *testing.T) {
+	parser := &JuniperParser{}
+	assert.Equal(t, vendors.CodeJuniper, parser.VendorCode())
+}
+
+func TestJuniperParser_VendorName(t *testing.T) {
+	parser := &JuniperParser{}
+	assert.Equal(t, "Juniper", parser.VendorName())
+}
+
+func TestJuniperParser_Parse(t *testing.T) {
+	parser := &JuniperParser{}
+
+	tests := []struct {
+		name           string
+		dhcpMac        string
+		callingStation string
+		nasPortID      string
+		expectedMac    string
+		expectedVlan1  int64
+		expectedVlan2  int64
+	}{
+		{
+			name:        "dhcp mac addr",
+			dhcpMac:     "0011.2233.4455",
+			expectedMac: "00:11:22:33:44:55",
+		},
+		{
+			name:           "dhcp mac preferred over calling station",
+			dhcpMac:        "aabb.ccdd.eeff",
+			callingStation: "00-11-22-33-44-55",
+			expectedMac:    "aa:bb:cc:dd:ee:ff",
+		},
+		{
+			name:           "calling station fallback",
+			callingStation: "00-11-22-33-44-55",
+			expectedMac:    "00:11:22:33:44:55",
+		},
+		{
+			name:          "stacked vlan",
+			nasPortID:     "ge-1/0/0.1073741823:100-200",
+			expectedVlan1: 100,
+			expectedVlan2: 200,
+		},
+		{
+			name:          "single vlan",
+			nasPortID:     "xe-0/0/1:300",
+			expectedVlan1: 300,
+		},
+		{
+			name:          "logical unit",
+			nasPortID:     "ge-2/1/3.120",
+			expectedVlan1: 120,
+		},
+		{
+			name:      "demux interface",
+			nasPortID: "demux0.3221225473",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			if tt.dhcpMac != "" {
+				_ = erx.ERXDhcpMacAddr_SetString(packet, tt.dhcpMac) //nolint:errcheck
+			}
+			if tt.callingStation != "" {
+				_ = rfc2865.CallingStationID_SetString(packet, tt.callingStation) //nolint:errcheck
+			}
+			if tt.nasPortID != "" {
+				_ = rfc2869.NASPortID_SetString(packet, tt.nasPortID) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			require.NotNil(t, vr)
+
+			assert.Equal(t, tt.expectedMac, vr.MacAddr)
+			assert.Equal(t, tt.expectedVlan1, vr.Vlanid1)
+			assert.Equal(t, tt.expectedVlan2, vr.Vlanid2)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+var (
+	// Interface with 802.1Q sub-interface numbers, e.g. ether1.100 or ether1.100.200
+	ifaceVlanRegexp1 = regexp.MustCompile(`^[A-Za-z][\w-]*\.(\d+)(?:\.(\d+))?$`)
+	// VLAN interface named after its tag, e.g. vlan100, vlan-100 or igb0_vlan100
+	ifaceVlanRegexp2 = regexp.MustCompile(`(?i)vlan[-_]?(\d+)$`)
+	macHexRegexp     = regexp.MustCompile(`^[0-9A-Fa-f]{12}$`)
+)
+
+// MikrotikParser parses MikroTik RouterOS hotspot / PPPoE attributes
+type MikrotikParser struct{}
+
+func (p *MikrotikParser) VendorCode() string {
+	return vendors.CodeMikrotik
+}
+
+func (p *MikrotikParser) VendorName() string {
+	return "Mikrotik"
+}
+
+func (p *MikrotikParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Hotspot and PPPoE send the client MAC; L2TP/PPTP send the peer IP instead
+	vr.MacAddr = parseStationMac(rfc2865.CallingStationID_GetString(r.Packet))
+
+	// VLAN from the receiving interface name
+	vr.Vlanid1, vr.Vlanid2 = parseIfaceVlanIds(rfc2869.NASPortID_GetString(r.Packet))
+
+	// Hotspot client address before NAT and the login realm
+	if ip := mikrotik.MikrotikHostIP_Get(r.Packet); ip != nil {
+		vr.HostIP = ip.String()
+	}
+	vr.Realm = mikrotik.MikrotikRealm_GetString(r.Packet)
+
+	return vr, nil
+}
+
+// parseStationMac normalizes a Calling-Station-Id holding a MAC address in
+// colon, dash, dotted or bare form, or a DHCP client id with the hardware
+// type prefix (1:aa:bb:cc:dd:ee:ff). Values that are not MAC addresses, such
+// as the peer IP of tunnel logins, yield an empty string.
+func parseStationMac(value string) string {
+	value = strings.TrimSpace(value)
+	if parts := strings.Split(value, ":"); len(parts) == 7 && (parts[0] == "1" || parts[0] == "01") {
+		value = strings.Join(parts[1:], ":")
+	}
+	hex := strings.NewReplacer(".", "", "-", "", ":", "").Replace(value)
+	if !macHexRegexp.MatchString(hex) {
+		return ""
+	}
+	return normalizeDottedMac(hex)
+}
+
+// parseIfaceVlanIds extracts the outer and inner VLAN from an interface name
+func parseIfaceVlanIds(iface string) (int64, int64) {
+	iface = strings.TrimSpace(iface)
+	attrs := ifaceVlanRegexp1.FindStringSubmatch(iface)
+	if attrs == nil {
+		attrs = ifaceVlanRegexp2.FindStringSubmatch(iface)
+	}
+	if attrs == nil {
+		return 0, 0
+	}
+	vlanid1 := parseVlanTag(attrs[1])
+	var vlanid2 int64
+	if len(attrs) > 2 && attrs[2] != "" {
+		vlanid2 = parseVlanTag(attrs[2])
+	}
+	if vlanid1 == 0 {
+		return 0, 0
+	}
+	return vlanid1, vlanid2
+}
+
+// parseVlanTag returns the VLAN id, or 0 when outside 1-4094
+func parseVlanTag(s string) int64 {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 1 || n > 4094 {
+		return 0
+	}
+	return n
+}
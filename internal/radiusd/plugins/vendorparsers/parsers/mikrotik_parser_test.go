@@ -0,0 +1,117 @@
+package parsers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+func TestMikrotikParser_VendorCode(t *testing.T) {
+	parser := &MikrotikParser{}
+	assert.Equal(t, vendors.CodeMikrotik, parser.VendorCode())
+	assert.Equal(t, "Mikrotik", parser.VendorName())
+}
+
+func TestMikrotikParser_Parse(t *testing.T) {
+	parser := &MikrotikParser{}
+
+	// Attribute values taken from RouterOS 6.49 / 7.x Access-Request captures
+	tests := []struct {
+		name           string
+		callingStation string
+		nasPortID      string
+		hostIP         net.IP
+		realm          string
+		expectedMac    string
+		expectedVlan1  int64
+		expectedVlan2  int64
+		expectedHostIP string
+		expectedRealm  string
+	}{
+		{
+			name:           "hotspot login on vlan sub-interface",
+			callingStation: "4C:5E:0C:12:34:56",
+			nasPortID:      "ether2.100",
+			hostIP:         net.IPv4(10, 5, 50, 254),
+			expectedMac:    "4C:5E:0C:12:34:56",
+			expectedVlan1:  100,
+			expectedHostIP: "10.5.50.254",
+		},
+		{
+			name:           "hotspot login with realm on bridge",
+			callingStation: "B8:69:F4:AA:BB:CC",
+			nasPortID:      "bridge-hotspot",
+			hostIP:         net.IPv4(192, 168, 88, 20),
+			realm:          "campus",
+			expectedMac:    "B8:69:F4:AA:BB:CC",
+			expectedHostIP: "192.168.88.20",
+			expectedRealm:  "campus",
+		},
+		{
+			name:           "pppoe on qinq interface",
+			callingStation: "E4:8D:8C:01:02:03",
+			nasPortID:      "sfp1.300.45",
+			expectedMac:    "E4:8D:8C:01:02:03",
+			expectedVlan1:  300,
+			expectedVlan2:  45,
+		},
+		{
+			name:           "pppoe on named vlan interface",
+			callingStation: "E4:8D:8C:01:02:04",
+			nasPortID:      "vlan210",
+			expectedMac:    "E4:8D:8C:01:02:04",
+			expectedVlan1:  210,
+		},
+		{
+			name:           "dhcp client id with hardware type",
+			callingStation: "1:dc:a6:32:00:11:22",
+			nasPortID:      "vlan-20",
+			expectedMac:    "dc:a6:32:00:11:22",
+			expectedVlan1:  20,
+		},
+		{
+			name:           "l2tp peer address is not a mac",
+			callingStation: "203.0.113.7",
+			nasPortID:      "<l2tp-alice>",
+		},
+		{
+			name:           "out of range vlan is ignored",
+			callingStation: "4C:5E:0C:12:34:57",
+			nasPortID:      "ether1.5000",
+			expectedMac:    "4C:5E:0C:12:34:57",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			_ = rfc2865.CallingStationID_SetString(packet, tt.callingStation) //nolint:errcheck
+			if tt.nasPortID != "" {
+				_ = rfc2869.NASPortID_SetString(packet, tt.nasPortID) //nolint:errcheck
+			}
+			if tt.hostIP != nil {
+				_ = mikrotik.MikrotikHostIP_Set(packet, tt.hostIP) //nolint:errcheck
+			}
+			if tt.realm != "" {
+				_ = mikrotik.MikrotikRealm_SetString(packet, tt.realm) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			require.NotNil(t, vr)
+
+			assert.Equal(t, tt.expectedMac, vr.MacAddr)
+			assert.Equal(t, tt.expectedVlan1, vr.Vlanid1)
+			assert.Equal(t, tt.expectedVlan2, vr.Vlanid2)
+			assert.Equal(t, tt.expectedHostIP, vr.HostIP)
+			assert.Equal(t, tt.expectedRealm, vr.Realm)
+		})
+	}
+}
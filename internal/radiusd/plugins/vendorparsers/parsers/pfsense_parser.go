@@ -0,0 +1,37 @@
+package parsers
+
+import (
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+// PfSenseParser parses pfSense captive portal and PPPoE server attributes
+type PfSenseParser struct{}
+
+func (p *PfSenseParser) VendorCode() string {
+	return vendors.CodePfSense
+}
+
+func (p *PfSenseParser) VendorName() string {
+	return "pfSense"
+}
+
+func (p *PfSenseParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Captive portal sends aa:bb:cc:dd:ee:ff, the mpd PPPoE server a bare hex MAC
+	vr.MacAddr = parseStationMac(rfc2865.CallingStationID_GetString(r.Packet))
+
+	// VLAN from the portal interface, e.g. igb1.100 or igb1_vlan100
+	vr.Vlanid1, vr.Vlanid2 = parseIfaceVlanIds(rfc2869.NASPortID_GetString(r.Packet))
+
+	// Captive portal sends the client address as Framed-IP-Address
+	if ip := rfc2865.FramedIPAddress_Get(r.Packet); ip != nil {
+		vr.HostIP = ip.String()
+	}
+
+	return vr, nil
+}
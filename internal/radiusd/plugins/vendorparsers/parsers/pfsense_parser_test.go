@@ -0,0 +1,87 @@
+package parsers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+)
+
+func TestPfSenseParser_VendorCode(t *testing.T) {
+	parser := &PfSenseParser{}
+	assert.Equal(t, vendors.CodePfSense, parser.VendorCode())
+	assert.Equal(t, "pfSense", parser.VendorName())
+}
+
+func TestPfSenseParser_Parse(t *testing.T) {
+	parser := &PfSenseParser{}
+
+	// Attribute values taken from pfSense 2.7 captive portal and mpd5 PPPoE captures
+	tests := []struct {
+		name           string
+		callingStation string
+		nasPortID      string
+		framedIP       net.IP
+		expectedMac    string
+		expectedVlan1  int64
+		expectedHostIP string
+	}{
+		{
+			name:           "captive portal on vlan interface",
+			callingStation: "a4:83:e7:11:22:33",
+			nasPortID:      "igb1.100",
+			framedIP:       net.IPv4(172, 16, 100, 23),
+			expectedMac:    "a4:83:e7:11:22:33",
+			expectedVlan1:  100,
+			expectedHostIP: "172.16.100.23",
+		},
+		{
+			name:           "captive portal on lan",
+			callingStation: "a4:83:e7:11:22:34",
+			nasPortID:      "em1",
+			framedIP:       net.IPv4(192, 168, 1, 50),
+			expectedMac:    "a4:83:e7:11:22:34",
+			expectedHostIP: "192.168.1.50",
+		},
+		{
+			name:           "mpd pppoe bare mac",
+			callingStation: "0050569a0b1c",
+			nasPortID:      "igb0_vlan35",
+			expectedMac:    "00:50:56:9a:0b:1c",
+			expectedVlan1:  35,
+		},
+		{
+			name:           "missing calling station",
+			callingStation: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+			if tt.callingStation != "" {
+				_ = rfc2865.CallingStationID_SetString(packet, tt.callingStation) //nolint:errcheck
+			}
+			if tt.nasPortID != "" {
+				_ = rfc2869.NASPortID_SetString(packet, tt.nasPortID) //nolint:errcheck
+			}
+			if tt.framedIP != nil {
+				_ = rfc2865.FramedIPAddress_Set(packet, tt.framedIP) //nolint:errcheck
+			}
+
+			vr, err := parser.Parse(&radius.Request{Packet: packet})
+			require.NoError(t, err)
+			require.NotNil(t, vr)
+
+			assert.Equal(t, tt.expectedMac, vr.MacAddr)
+			assert.Equal(t, tt.expectedVlan1, vr.Vlanid1)
+			assert.Equal(t, int64(0), vr.Vlanid2)
+			assert.Equal(t, tt.expectedHostIP, vr.HostIP)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package parsers
+
+import (
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ruckus"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// RuckusParser parses Ruckus SmartZone and ZoneDirector attributes
+type RuckusParser struct{}
+
+func (p *RuckusParser) VendorCode() string {
+	return vendors.CodeRuckus
+}
+
+func (p *RuckusParser) VendorName() string {
+	return "Ruckus"
+}
+
+func (p *RuckusParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, error) {
+	vr := &vendorparsers.VendorRequest{}
+
+	// Parse MAC addresses
+	macval := rfc2865.CallingStationID_GetString(r.Packet)
+	if macval != "" {
+		vr.MacAddr = normalizeDottedMac(macval)
+	}
+
+	// WLAN the client associated with; the SmartZone zone is the closest
+	// equivalent of an AP group
+	vr.Ssid = ruckus.RuckusSSID_GetString(r.Packet)
+	vr.ApGroup = ruckus.RuckusZoneName_GetString(r.Packet)
+
+	return vr, nil
+}
@@ -0,0 +1,34 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/ruckus"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestRuckusParser_VendorCode(t *testing.T) {
+	parser := &RuckusParser{}
+	assert.Equal(t, vendors.CodeRuckus, parser.VendorCode())
+}
+
+func TestRuckusParser_Parse(t *testing.T) {
+	parser := &RuckusParser{}
+
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.CallingStationID_SetString(packet, "00-11-22-33-44-55") //nolint:errcheck
+	_ = ruckus.RuckusSSID_SetString(packet, "campus-staff")             //nolint:errcheck
+	_ = ruckus.RuckusZoneName_SetString(packet, "north-zone")           //nolint:errcheck
+
+	vr, err := parser.Parse(&radius.Request{Packet: packet})
+	require.NoError(t, err)
+	require.NotNil(t, vr)
+
+	assert.Equal(t, "00:11:22:33:44:55", vr.MacAddr)
+	assert.Equal(t, "campus-staff", vr.Ssid)
+	assert.Equal(t, "north-zone", vr.ApGroup)
+}
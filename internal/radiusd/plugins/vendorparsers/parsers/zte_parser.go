@@ -49,5 +49,10 @@ func (p *ZTEParser) Parse(r *radius.Request) (*vendorparsers.VendorRequest, erro
 		vr.Vlanid2 = 0
 	}
 
+	// ZTE has no vendor attribute for the access line, its BRAS relays the
+	// PPPoE intermediate agent and DHCP Option 82 tags in the DSL Forum
+	// attributes
+	vr.CircuitId, vr.RemoteId = vendorparsers.ParseAgentLineIds(r.Packet)
+
 	return vr, nil
 }
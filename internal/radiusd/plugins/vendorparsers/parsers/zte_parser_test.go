@@ -6,8 +6,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/zte"
 	"layeh.com/radius"
 	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2869"
+	"layeh.com/radius/rfc4679"
 )
 
 func TestZTEParser_VendorCode(t *testing.T) {
@@ -129,3 +132,31 @@ func TestZTEParser_Parse_NoAttributes(t *testing.T) {
 	assert.Equal(t, int64(0), vr.Vlanid1)
 	assert.Equal(t, int64(0), vr.Vlanid2)
 }
+
+func TestZTEParser_Parse_LineIds(t *testing.T) {
+	parser := &ZTEParser{}
+
+	// PPPoE Access-Request of a ZTE M6000 BRAS behind an OLT running the
+	// PPPoE intermediate agent
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.UserName_SetString(packet, "subscriber-42")                                           //nolint:errcheck
+	_ = rfc2865.CallingStationID_SetString(packet, "001122334455")                                    //nolint:errcheck
+	_ = rfc2869.NASPortID_SetString(packet, "gei-0/2/0/1.100:100#200")                                //nolint:errcheck
+	_ = zte.ZTEAccessDomain_SetString(packet, "isp")                                                  //nolint:errcheck
+	_ = rfc4679.ADSLAgentCircuitID_AddString(packet, "ZTE-OLT1 xpon 0/1/0/3:100")                     //nolint:errcheck
+	_ = rfc4679.ADSLAgentRemoteID_Add(packet, []byte{0x5a, 0x54, 0x45, 0x47, 0x00, 0x01, 0xe2, 0x40}) //nolint:errcheck
+
+	vr, err := parser.Parse(&radius.Request{Packet: packet})
+	require.NoError(t, err)
+	assert.Equal(t, "00:11:22:33:44:55", vr.MacAddr)
+	assert.Equal(t, "ZTE-OLT1 xpon 0/1/0/3:100", vr.CircuitId)
+	assert.Equal(t, "5a5445470001e240", vr.RemoteId)
+
+	// Without the DSL Forum attributes there is no line
+	packet = radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.CallingStationID_SetString(packet, "001122334455") //nolint:errcheck
+	vr, err = parser.Parse(&radius.Request{Packet: packet})
+	require.NoError(t, err)
+	assert.Empty(t, vr.CircuitId)
+	assert.Empty(t, vr.RemoteId)
+}
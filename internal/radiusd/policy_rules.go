@@ -0,0 +1,142 @@
+package radiusd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// StagePolicy evaluates the operator defined authorization rules. It runs
+// right after the user is loaded so a rule can reject before any password or
+// EAP exchange and override what the later stages use.
+const StagePolicy = "policy"
+
+func (s *AuthService) stagePolicy(ctx *AuthPipelineContext) error {
+	if ctx.User == nil {
+		return nil
+	}
+	ctx.storedUser = ctx.User
+	appCtx := s.AppContext()
+	if appCtx == nil || appCtx.PolicyCache() == nil {
+		return nil
+	}
+	rules, err := appCtx.PolicyCache().Rules()
+	if err != nil {
+		return fmt.Errorf("load policy rules: %w", err)
+	}
+	if rules.Len() == 0 {
+		return nil
+	}
+
+	decision := rules.Evaluate(PolicyRequestFor(ctx.Request.Packet, ctx.NAS, ctx.VendorRequest, ctx.User, time.Now()))
+	if len(decision.Matched) > 0 {
+		ctx.Note("matched rules: %s", strings.Join(decision.Matched, ", "))
+		zap.L().Debug("policy rules matched",
+			zap.String("namespace", "radius"),
+			zap.String("username", ctx.Username),
+			zap.Strings("rules", decision.Matched),
+		)
+	}
+	if decision.Reject {
+		return radiuserrors.NewPolicyRejectError(decision.RejectMessage)
+	}
+	if decision.EapMethod != "" {
+		ctx.EAPMethod = s.resolveEapMethod(decision.EapMethod)
+		ctx.Note("eap method set to %s", ctx.EAPMethod)
+	}
+	if decision.ProfileId == 0 && len(decision.ReplyAttrs) == 0 {
+		return nil
+	}
+
+	// The loaded user is shared with the user cache, work on a copy
+	user := *ctx.User
+	if decision.ProfileId != 0 && decision.ProfileId != user.ProfileId {
+		profile, err := appCtx.ProfileCache().Get(decision.ProfileId)
+		if err != nil {
+			return fmt.Errorf("policy profile %d: %w", decision.ProfileId, err)
+		}
+		applyPolicyProfile(&user, profile)
+		ctx.Note("profile %s applied", profile.Name)
+	}
+	if len(decision.ReplyAttrs) > 0 {
+		items, err := domain.ParseReplyItems(user.ReplyAttrs)
+		if err != nil {
+			zap.L().Warn("invalid custom reply attributes replaced by policy attributes",
+				zap.String("username", user.Username),
+				zap.Error(err))
+			items = nil
+		}
+		raw, err := json.Marshal(append(items, decision.ReplyAttrs...))
+		if err != nil {
+			return err
+		}
+		user.ReplyAttrs = string(raw)
+		ctx.Note("%d reply attributes added", len(decision.ReplyAttrs))
+	}
+	ctx.User = &user
+	return nil
+}
+
+// applyPolicyProfile authorizes user with profile, as if the user had been
+// created from it
+func applyPolicyProfile(user *domain.RadiusUser, profile *domain.RadiusProfile) {
+	user.ProfileId = profile.ID
+	user.ActiveNum = profile.ActiveNum
+	user.UpRate = profile.UpRate
+	user.DownRate = profile.DownRate
+	user.AddrPool = profile.AddrPool
+	user.Domain = profile.Domain
+	user.IPv6PrefixPool = profile.IPv6PrefixPool
+}
+
+// PolicyRequestFor collects the attributes the policy rule conditions match on
+func PolicyRequestFor(packet *radius.Packet, nas *domain.NetNas, vendorReq *VendorRequest, user *domain.RadiusUser, now time.Time) domain.PolicyRequest {
+	req := domain.PolicyRequest{Time: now}
+	if nas != nil {
+		req.NasId = nas.ID
+		req.NodeId = nas.NodeId
+	}
+	if user != nil {
+		req.ProfileId = user.ProfileId
+	}
+	var username string
+	if packet != nil {
+		username = rfc2865.UserName_GetString(packet)
+		req.CalledStationId = rfc2865.CalledStationID_GetString(packet)
+		req.NasPortType = int(rfc2865.NASPortType_Get(packet))
+	}
+	if vendorReq != nil {
+		req.Ssid = vendorReq.Ssid
+		req.Realm = vendorReq.Realm
+	}
+	if req.Ssid == "" {
+		req.Ssid = ssidFromCalledStation(req.CalledStationId)
+	}
+	if req.Realm == "" {
+		if idx := strings.LastIndex(username, "@"); idx >= 0 {
+			req.Realm = username[idx+1:]
+		}
+	}
+	return req
+}
+
+// ssidFromCalledStation extracts the SSID access points append to the MAC
+// address in Called-Station-Id, e.g. "AA-BB-CC-DD-EE-FF:Campus"
+func ssidFromCalledStation(calledStation string) string {
+	idx := strings.LastIndex(calledStation, ":")
+	if idx < 0 {
+		return ""
+	}
+	if _, err := net.ParseMAC(calledStation[:idx]); err != nil {
+		return ""
+	}
+	return calledStation[idx+1:]
+}
@@ -0,0 +1,107 @@
+package radiusd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestStagePolicy(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	authService := NewAuthService(radiusService)
+
+	stages := authService.authPipeline.Stages()
+	require.Greater(t, len(stages), 6)
+	assert.Equal(t, StageLoadUser, stages[5].Name())
+	assert.Equal(t, StagePolicy, stages[6].Name())
+
+	gold := &domain.RadiusProfile{Name: "gold", Status: "enabled", UpRate: 20480, DownRate: 40960, ActiveNum: 3, AddrPool: "gold-pool"}
+	require.NoError(t, appCtx.DB().Create(gold).Error)
+	rules := []domain.PolicyRule{
+		{Name: "closed ssid", Priority: 1, Status: "enabled", Action: domain.PolicyActionReject, Ssid: "closed", RejectMessage: "ssid closed"},
+		{Name: "staff ssid", Priority: 2, Status: "enabled", Action: domain.PolicyActionAccept, Ssid: "staff",
+			SetProfileId: gold.ID, EapMethod: "eap-mschapv2", ReplyAttrs: `[{"attribute":"Class","value":"staff"}]`},
+	}
+	require.NoError(t, appCtx.DB().Create(&rules).Error)
+	appCtx.PolicyCache().Invalidate()
+
+	runStage := func(calledStation string) (*AuthPipelineContext, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, "alice")              //nolint:errcheck
+		_ = rfc2865.CalledStationID_SetString(packet, calledStation) //nolint:errcheck
+		ctx := NewAuthPipelineContext(authService, nil, &radius.Request{Packet: packet})
+		ctx.Username = "alice"
+		ctx.EAPMethod = "eap-md5"
+		ctx.NAS = &domain.NetNas{ID: 1}
+		ctx.User = &domain.RadiusUser{Username: "alice", ProfileId: 99, UpRate: 1024, ReplyAttrs: `[{"attribute":"Filter-Id","value":"user"}]`}
+		return ctx, authService.stagePolicy(ctx)
+	}
+
+	_, err := runStage("00-11-22-33-44-55:closed")
+	authErr, ok := radiuserrors.GetAuthError(err)
+	require.True(t, ok, "expected auth error, got %v", err)
+	assert.Equal(t, app.MetricsRadiusRejectPolicy, authErr.MetricsType)
+	assert.Equal(t, "ssid closed", authErr.Message)
+
+	ctx, err := runStage("00-11-22-33-44-55:staff")
+	require.NoError(t, err)
+	assert.Equal(t, "eap-mschapv2", ctx.EAPMethod)
+	assert.Equal(t, gold.ID, ctx.User.ProfileId)
+	assert.Equal(t, 20480, ctx.User.UpRate)
+	assert.Equal(t, "gold-pool", ctx.User.AddrPool)
+	items, err := domain.ParseReplyItems(ctx.User.ReplyAttrs)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "Filter-Id", items[0].Attribute)
+	assert.Equal(t, "Class", items[1].Attribute)
+
+	// Requests no rule matches are left untouched
+	ctx, err = runStage("00-11-22-33-44-55:other")
+	require.NoError(t, err)
+	assert.Equal(t, "eap-md5", ctx.EAPMethod)
+	assert.Equal(t, int64(99), ctx.User.ProfileId)
+}
+
+func TestPolicyRequestFor(t *testing.T) {
+	now := time.Now()
+	packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+	_ = rfc2865.UserName_SetString(packet, "bob@campus.example")                 //nolint:errcheck
+	_ = rfc2865.CalledStationID_SetString(packet, "AA-BB-CC-DD-EE-FF:eduroam")   //nolint:errcheck
+	_ = rfc2865.NASPortType_Set(packet, rfc2865.NASPortType_Value_Wireless80211) //nolint:errcheck
+
+	req := PolicyRequestFor(packet, &domain.NetNas{ID: 3, NodeId: 9}, &VendorRequest{}, &domain.RadiusUser{ProfileId: 5}, now)
+	assert.Equal(t, domain.PolicyRequest{
+		NasId:           3,
+		NodeId:          9,
+		CalledStationId: "AA-BB-CC-DD-EE-FF:eduroam",
+		Ssid:            "eduroam",
+		NasPortType:     19,
+		ProfileId:       5,
+		Realm:           "campus.example",
+		Time:            now,
+	}, req)
+
+	// Vendor attributes take precedence
+	req = PolicyRequestFor(packet, nil, &VendorRequest{Ssid: "staff", Realm: "corp"}, nil, now)
+	assert.Equal(t, "staff", req.Ssid)
+	assert.Equal(t, "corp", req.Realm)
+}
+
+func TestSsidFromCalledStation(t *testing.T) {
+	assert.Equal(t, "Campus", ssidFromCalledStation("AA-BB-CC-DD-EE-FF:Campus"))
+	assert.Equal(t, "Campus", ssidFromCalledStation("aa:bb:cc:dd:ee:ff:Campus"))
+	assert.Empty(t, ssidFromCalledStation("AA-BB-CC-DD-EE-FF"))
+	assert.Empty(t, ssidFromCalledStation("aa:bb:cc:dd:ee:ff"))
+	assert.Empty(t, ssidFromCalledStation("10.0.0.1:1812"))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/talkincode/toughradius/v9/config"
 	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/authlog"
 	cachepkg "github.com/talkincode/toughradius/v9/internal/radiusd/cache"
 	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
@@ -25,6 +26,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/huawei"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"layeh.com/radius"
@@ -46,9 +48,15 @@ const (
 )
 
 type VendorRequest struct {
-	MacAddr string
-	Vlanid1 int64
-	Vlanid2 int64
+	MacAddr   string
+	Vlanid1   int64
+	Vlanid2   int64
+	Ssid      string
+	ApGroup   string
+	HostIP    string
+	Realm     string
+	CircuitId string
+	RemoteId  string
 }
 
 type AuthRateUser struct {
@@ -79,6 +87,16 @@ type RadiusService struct {
 	SessionRepo    repository.SessionRepository
 	AccountingRepo repository.AccountingRepository
 	NasRepo        repository.NasRepository
+	DeviceRepo     repository.DeviceRepository
+
+	// AuthLog persists the accepted and rejected logins
+	AuthLog *authlog.Writer
+
+	// TOTPVerifier is shared by every path accepting TOTP codes, so a code
+	// used once is refused by all of them
+	TOTPVerifier *totp.Verifier
+
+	unsubscribe func()
 }
 
 func NewRadiusService(appCtx app.AppContext) *RadiusService {
@@ -91,6 +109,10 @@ func NewRadiusService(appCtx app.AppContext) *RadiusService {
 
 	// Initialize all repositories using injected context
 	db := appCtx.DB()
+	var authLogConfig authlog.ConfigGetter
+	if cfg := appCtx.ConfigMgr(); cfg != nil {
+		authLogConfig = cfg
+	}
 	s := &RadiusService{
 		appCtx:        appCtx,
 		AuthRateCache: make(map[string]AuthRateUser),
@@ -104,6 +126,14 @@ func NewRadiusService(appCtx app.AppContext) *RadiusService {
 		SessionRepo:    repogorm.NewGormSessionRepository(db),
 		AccountingRepo: repogorm.NewGormAccountingRepository(db),
 		NasRepo:        repogorm.NewGormNasRepository(db),
+		DeviceRepo:     repogorm.NewGormDeviceRepository(db),
+		AuthLog:        authlog.NewWriter(db, authLogConfig),
+		TOTPVerifier:   totp.NewVerifier(),
+	}
+
+	// Evict the cached users and NAS devices changed through the admin API
+	if bus := appCtx.ChangeBus(); bus != nil {
+		s.unsubscribe = bus.Subscribe(s.evictCaches)
 	}
 
 	// Note: Plugin initialization is done externally after service creation
@@ -138,7 +168,7 @@ func (s *RadiusService) GetNas(ip, identifier string) (nas *domain.NetNas, err e
 // GetValidUser retrieves a valid user and performs initial checks
 // Deprecated: Use UserRepo methods with plugin-based validation instead
 func (s *RadiusService) GetValidUser(usernameOrMac string, macauth bool) (user *domain.RadiusUser, err error) {
-	cacheKey := fmt.Sprintf("%t|%s", macauth, usernameOrMac)
+	cacheKey := userCacheKey(usernameOrMac, macauth)
 	if cached, ok := s.userCache.Get(cacheKey); ok {
 		return cached, nil
 	}
@@ -161,6 +191,9 @@ func (s *RadiusService) GetValidUser(usernameOrMac string, macauth bool) (user *
 	if user.Status == common.DISABLED {
 		return nil, radiuserrors.NewUserDisabledError()
 	}
+	if user.Status == domain.UserStatusSuspended {
+		return nil, radiuserrors.NewUserSuspendedError()
+	}
 
 	if user.ExpireTime.Before(time.Now()) {
 		return nil, radiuserrors.NewUserExpiredError()
@@ -169,6 +202,36 @@ func (s *RadiusService) GetValidUser(usernameOrMac string, macauth bool) (user *
 	return user, nil
 }
 
+// userCacheKey returns the user cache key of a username, or of a MAC
+// address in its normalized form so changes can evict it
+func userCacheKey(usernameOrMac string, macauth bool) string {
+	if macauth {
+		if mac := domain.NormalizeMac(usernameOrMac); mac != "" {
+			usernameOrMac = mac
+		}
+	}
+	return fmt.Sprintf("%t|%s", macauth, usernameOrMac)
+}
+
+// evictCaches drops the cached users and NAS devices named by a change
+// event. NAS devices are cached by address and identifier pair, so any NAS
+// change clears them all.
+func (s *RadiusService) evictCaches(event app.ChangeEvent) {
+	switch event.Kind {
+	case app.ChangeUser:
+		if len(event.Keys) == 0 {
+			s.userCache.Clear()
+			return
+		}
+		for _, key := range event.Keys {
+			s.userCache.Delete(userCacheKey(key, false))
+			s.userCache.Delete(userCacheKey(key, true))
+		}
+	case app.ChangeNas:
+		s.nasCache.Clear()
+	}
+}
+
 // GetUserForAcct fetches the user without validating expiration or status
 // Deprecated: Use UserRepo.GetByUsername instead
 func (s *RadiusService) GetUserForAcct(username string) (user *domain.RadiusUser, err error) {
@@ -261,6 +324,8 @@ func GetNetRadiusOnlineFromRequest(r *radius.Request, vr *VendorRequest, nas *do
 		NasPort:             0,
 		NasClass:            common.NA,
 		NasPortId:           common.IfEmptyStr(rfc2869.NASPortID_GetString(r.Packet), common.NA),
+		CircuitId:           vr.CircuitId,
+		RemoteId:            vr.RemoteId,
 		NasPortType:         0,
 		ServiceType:         0,
 		AcctSessionId:       rfc2866.AcctSessionID_GetString(r.Packet),
@@ -391,6 +456,10 @@ func (s *RadiusService) BatchClearRadiusOnlineByNas(nasip, nasid string) {
 func (s *RadiusService) Release() {
 	s.TaskPool.Running()
 	_ = s.TaskPool.ReleaseTimeout(time.Second * 5)
+	s.AuthLog.Close()
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+	}
 }
 
 // ErrSecretEmpty indicates an empty RADIUS secret
@@ -463,7 +532,11 @@ func (s *AuthService) GetLocalPassword(user *domain.RadiusUser, isMacAuth bool)
 }
 
 func (s *AuthService) UpdateBind(user *domain.RadiusUser, vendorReq *VendorRequest) {
-	if user.MacAddr != vendorReq.MacAddr {
+	// In device binding mode the MAC of the user stays as provisioned, the
+	// device is recorded in the device table instead
+	if user.GetBindMac(s.profileCache()) == domain.MacBindDevices {
+		s.learnDevice(user, vendorReq.MacAddr)
+	} else if user.MacAddr != vendorReq.MacAddr {
 		s.UpdateUserMac(user.Username, vendorReq.MacAddr)
 	}
 	reqvid1 := int(vendorReq.Vlanid1)
@@ -474,6 +547,61 @@ func (s *AuthService) UpdateBind(user *domain.RadiusUser, vendorReq *VendorReque
 	if user.Vlanid2 != reqvid2 {
 		s.UpdateUserVlanid2(user.Username, reqvid2)
 	}
+	// Learn the access line of the first login
+	if user.BindLine == domain.LineBindLearn && !user.HasLineId() && (vendorReq.CircuitId != "" || vendorReq.RemoteId != "") {
+		if err := s.UserRepo.UpdateLineId(context.Background(), user.Username, vendorReq.CircuitId, vendorReq.RemoteId); err != nil {
+			zap.L().Error("update user line id error", zap.Error(err), zap.String("namespace", "radius"))
+		}
+	}
+}
+
+// learnDevice records a login of the user from macAddr, registering an
+// unknown device while the user has fewer than MaxDevices devices
+func (s *AuthService) learnDevice(user *domain.RadiusUser, macAddr string) {
+	mac := domain.NormalizeMac(macAddr)
+	if mac == "" || s.DeviceRepo == nil {
+		return
+	}
+	if common.IsNotEmptyAndNA(user.MacAddr) && domain.NormalizeMac(user.MacAddr) == mac {
+		return
+	}
+
+	ctx := context.Background()
+	device, err := s.DeviceRepo.GetByUserMac(ctx, user.ID, mac)
+	if err == nil {
+		if err := s.DeviceRepo.UpdateLastSeen(ctx, device.ID); err != nil {
+			zap.L().Error("update device last seen error", zap.Error(err), zap.String("namespace", "radius"))
+		}
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		zap.L().Error("query user device error", zap.Error(err), zap.String("namespace", "radius"))
+		return
+	}
+
+	now := time.Now()
+	_, err = s.DeviceRepo.Learn(ctx, &domain.RadiusUserDevice{
+		UserId:    user.ID,
+		Username:  user.Username,
+		MacAddr:   mac,
+		Source:    domain.DeviceSourceLearned,
+		FirstSeen: now,
+		LastSeen:  now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, user.GetMaxDevices(s.profileCache()))
+	if err != nil {
+		zap.L().Error("learn user device error", zap.Error(err), zap.String("namespace", "radius"))
+	}
+}
+
+// profileCache returns the profile cache for resolving dynamic profile
+// settings, nil when running without an application context
+func (s *AuthService) profileCache() interface{} {
+	if appCtx := s.AppContext(); appCtx != nil {
+		return appCtx.ProfileCache()
+	}
+	return nil
 }
 
 // ApplyAcceptEnhancers delivers user profile configuration via plugins
@@ -483,20 +611,52 @@ func (s *AuthService) ApplyAcceptEnhancers(
 	vendorReq *vendorparsers.VendorRequest,
 	radAccept *radius.Packet,
 ) {
+	s.applyAcceptEnhancers(user, nas, vendorReq, radAccept, nil)
+}
+
+// applyAcceptEnhancers runs the response enhancers. A non nil trace marks a
+// dry run and receives the attributes each enhancer added or changed.
+func (s *AuthService) applyAcceptEnhancers(
+	user *domain.RadiusUser,
+	nas *domain.NetNas,
+	vendorReq *vendorparsers.VendorRequest,
+	radAccept *radius.Packet,
+	trace func(name string, added radius.Attributes, err error),
+) {
+	// Enhancers resolve dynamic profile attributes, rate bands and access
+	// windows through the profile cache
+	metadata := map[string]interface{}{}
+	if appCtx := s.AppContext(); appCtx != nil {
+		metadata["config_mgr"] = appCtx.ConfigMgr()
+		metadata["profile_cache"] = appCtx.ProfileCache()
+	}
+	if trace != nil {
+		metadata[auth.MetadataDryRun] = true
+	}
+
 	authCtx := &auth.AuthContext{
 		User:          user,
 		Nas:           nas,
 		VendorRequest: vendorReq,
 		Response:      radAccept,
+		Metadata:      metadata,
 	}
 
 	ctx := context.Background()
 	for _, enhancer := range registry.GetResponseEnhancers() {
-		if err := enhancer.Enhance(ctx, authCtx); err != nil {
+		var before radius.Attributes
+		if trace != nil {
+			before = copyAttributes(radAccept.Attributes)
+		}
+		err := enhancer.Enhance(ctx, authCtx)
+		if err != nil {
 			zap.L().Warn("response enhancer failed",
 				zap.String("enhancer", enhancer.Name()),
 				zap.Error(err))
 		}
+		if trace != nil {
+			trace(enhancer.Name(), addedAttributes(before, radAccept.Attributes), err)
+		}
 	}
 }
 
@@ -51,6 +51,10 @@ func (s *AcctService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 	if s.Config().Radiusd.Debug {
 		zap.S().Debug(FmtRequest(r))
 	}
+	tap := newDebugTap("acct", w, r)
+	if tap != nil {
+		w = tap
+	}
 
 	// NAS Access check
 	raddrstr := r.RemoteAddr.String()
@@ -60,6 +64,7 @@ func (s *AcctService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 	nas, err := s.GetNas(nasrip, identifier)
 	if err != nil {
 		s.logAcctError("nas_lookup", nasrip, "", err)
+		tap.Error(err)
 		return
 	}
 
@@ -75,7 +80,9 @@ func (s *AcctService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 		statusType != rfc2866.AcctStatusType_Value_AccountingOff {
 		username = rfc2865.UserName_GetString(r.Packet)
 		if username == "" {
-			s.logAcctError("validate_username", nasrip, "", radiuserrors.NewAcctUsernameEmptyError())
+			err := radiuserrors.NewAcctUsernameEmptyError()
+			s.logAcctError("validate_username", nasrip, "", err)
+			tap.Error(err)
 			return
 		}
 	}
@@ -96,9 +103,15 @@ func (s *AcctService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 	// async process accounting with back-pressure aware submit
 	task := func() {
 		vendorReqForPlugin := &vendorparserspkg.VendorRequest{
-			MacAddr: vendorReq.MacAddr,
-			Vlanid1: vendorReq.Vlanid1,
-			Vlanid2: vendorReq.Vlanid2,
+			MacAddr:   vendorReq.MacAddr,
+			Vlanid1:   vendorReq.Vlanid1,
+			Vlanid2:   vendorReq.Vlanid2,
+			Ssid:      vendorReq.Ssid,
+			ApGroup:   vendorReq.ApGroup,
+			HostIP:    vendorReq.HostIP,
+			Realm:     vendorReq.Realm,
+			CircuitId: vendorReq.CircuitId,
+			RemoteId:  vendorReq.RemoteId,
 		}
 
 		ctx := context.Background()
@@ -110,6 +123,7 @@ func (s *AcctService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 				zap.Int("status_type", int(statusType)),
 				zap.Error(err),
 			)
+			tap.Error(err)
 		}
 	}
 
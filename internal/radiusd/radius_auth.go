@@ -6,6 +6,8 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/app"
 	"github.com/talkincode/toughradius/v9/internal/domain"
 	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/lockout"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
 	vendorparsers "github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
@@ -17,6 +19,8 @@ import (
 type AuthService struct {
 	*RadiusService
 	eapHelper               *EAPAuthHelper
+	ldapDirectory           *ldapauth.Directory
+	lockouts                *lockout.Manager
 	authPipeline            *AuthPipeline
 	allowedEAPHandlers      map[string]struct{}
 	allowedEAPHandlersOrder []string
@@ -25,6 +29,8 @@ type AuthService struct {
 func NewAuthService(radiusService *RadiusService) *AuthService {
 	authService := &AuthService{
 		RadiusService: radiusService,
+		ldapDirectory: newLDAPDirectory(radiusService.appCtx),
+		lockouts:      newLockoutManager(radiusService.appCtx),
 	}
 	allowed := authService.initAllowedEAPHandlers()
 	authService.eapHelper = NewEAPAuthHelper(radiusService, allowed)
@@ -64,24 +70,37 @@ func (s *AuthService) ServeRADIUS(w radius.ResponseWriter, r *radius.Request) {
 	if s.Config().Radiusd.Debug {
 		zap.S().Info(FmtRequest(r))
 	}
+	tap := newDebugTap("auth", w, r)
+	if tap != nil {
+		w = tap
+	}
+	logWriter := &authLogWriter{ResponseWriter: w}
 
 	s.ensurePipeline()
-	pipelineCtx := NewAuthPipelineContext(s, w, r)
+	pipelineCtx := NewAuthPipelineContext(s, logWriter, r)
 	defer func() {
 		if pipelineCtx != nil && pipelineCtx.RateLimitChecked && pipelineCtx.Username != "" {
 			s.ReleaseAuthRateLimit(pipelineCtx.Username)
 		}
 	}()
 
-	if err := s.authPipeline.Execute(pipelineCtx); err != nil {
+	err := s.authPipeline.Execute(pipelineCtx)
+	if err != nil {
 		// Process error through guards and log appropriately
 		finalErr := s.processAuthError("auth_pipeline", r, pipelineCtx.User, pipelineCtx.NAS,
 			pipelineCtx.VendorRequestForPlugin, pipelineCtx.IsMacAuth,
 			pipelineCtx.Username, pipelineCtx.RemoteIP, err)
 		if finalErr != nil {
-			s.logAndReject(w, r, finalErr)
+			s.logAndReject(logWriter, r, finalErr)
+		}
+		s.recordLockoutFailure(pipelineCtx, err)
+		tap.Error(err)
+		if finalErr != nil {
+			// The auth log records the reason sent to the NAS
+			err = finalErr
 		}
 	}
+	s.recordAuthLog(pipelineCtx, logWriter.response, err)
 }
 
 // Pipeline exposes the underlying auth pipeline for customization.
@@ -30,6 +30,8 @@ func (m *mockAppContext) SaveSettings(settings map[string]interface{}) error { r
 func (m *mockAppContext) Scheduler() *cron.Cron                              { return nil }
 func (m *mockAppContext) ConfigMgr() *app.ConfigManager                      { return nil }
 func (m *mockAppContext) ProfileCache() *app.ProfileCache                    { return nil }
+func (m *mockAppContext) PolicyCache() *app.PolicyCache                      { return nil }
+func (m *mockAppContext) ChangeBus() *app.ChangeBus                          { return nil }
 func (m *mockAppContext) MigrateDB(track bool) error                         { return nil }
 func (m *mockAppContext) InitDb()                                            {}
 func (m *mockAppContext) DropAll()                                           {}
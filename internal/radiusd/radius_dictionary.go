@@ -0,0 +1,32 @@
+package radiusd
+
+import (
+	"github.com/talkincode/toughradius/v9/config"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	"github.com/talkincode/toughradius/v9/share"
+	"go.uber.org/zap"
+)
+
+// LoadDictionary loads the FreeRADIUS dictionaries used to name, decode and
+// encode attributes at runtime. A configured dictionary directory takes
+// precedence; when it is unset or fails to parse the bundled set is used.
+func LoadDictionary(cfg *config.AppConfig) *dictionary.Dictionary {
+	if dir := cfg.GetDictDir(); dir != "" {
+		dict, err := dictionary.LoadDir(dir)
+		if err == nil {
+			dictionary.SetDefault(dict)
+			zap.S().Infof("Loaded RADIUS dictionary from %s, %d vendors", dir, len(dict.Vendors()))
+			return dict
+		}
+		zap.L().Error("load radius dictionary error, using bundled dictionary",
+			zap.String("dir", dir), zap.Error(err), zap.String("namespace", "radius"))
+	}
+
+	dict, err := dictionary.Load(share.Dictionaries, "dictionary")
+	if err != nil {
+		zap.L().Error("load bundled radius dictionary error", zap.Error(err), zap.String("namespace", "radius"))
+		return nil
+	}
+	dictionary.SetDefault(dict)
+	return dict
+}
@@ -0,0 +1,156 @@
+package radiusd
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth/enhancers"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+// RateScheduler sends CoA-Request rate updates to online sessions when a
+// profile rate band starts or ends. It runs on the application scheduler.
+type RateScheduler struct {
+	svc     *RadiusService
+	mu      sync.Mutex
+	lastRun time.Time
+}
+
+// StartRateScheduler registers the rate band job on the application scheduler
+func (s *RadiusService) StartRateScheduler() *RateScheduler {
+	rs := &RateScheduler{svc: s}
+	appCtx := s.AppContext()
+	if appCtx == nil || appCtx.Scheduler() == nil {
+		return rs
+	}
+	_, err := appCtx.Scheduler().AddFunc("@every 1m", func() {
+		rs.Run(time.Now())
+	})
+	if err != nil {
+		zap.S().Errorf("init rate schedule job error %s", err.Error())
+	}
+	return rs
+}
+
+// Run compares the active rate band of every scheduled profile between the
+// previous run and now, and pushes the new rates to affected sessions.
+func (rs *RateScheduler) Run(now time.Time) {
+	defer func() {
+		if err := recover(); err != nil {
+			zap.S().Error(err)
+		}
+	}()
+
+	rs.mu.Lock()
+	prev := rs.lastRun
+	rs.lastRun = now
+	rs.mu.Unlock()
+	if prev.IsZero() {
+		return
+	}
+
+	db := rs.svc.AppContext().DB()
+	var profiles []domain.RadiusProfile
+	if err := db.Where("rate_schedule <> ''").Find(&profiles).Error; err != nil {
+		zap.L().Error("load rate scheduled profiles error", zap.Error(err))
+		return
+	}
+
+	for _, profile := range changedRateProfiles(profiles, prev, now) {
+		var sessions []domain.RadiusOnline
+		err := db.Model(&domain.RadiusOnline{}).
+			Joins("JOIN radius_user ON radius_user.username = radius_online.username").
+			Where("radius_user.profile_id = ?", profile.ID).
+			Find(&sessions).Error
+		if err != nil {
+			zap.L().Error("load online sessions error", zap.Int64("profile_id", profile.ID), zap.Error(err))
+			continue
+		}
+		for i := range sessions {
+			session := sessions[i]
+			if err := rs.svc.TaskPool.Submit(func() {
+				rs.sendRateCoa(session, now)
+			}); err != nil {
+				zap.L().Error("submit rate coa task error", zap.Error(err))
+			}
+		}
+	}
+}
+
+// changedRateProfiles returns the profiles whose active rate band at now
+// differs from the band active at prev
+func changedRateProfiles(profiles []domain.RadiusProfile, prev, now time.Time) []domain.RadiusProfile {
+	var changed []domain.RadiusProfile
+	for _, profile := range profiles {
+		bands, err := profile.RateBandList()
+		if err != nil || len(bands) == 0 {
+			continue
+		}
+		if domain.ActiveRateBand(bands, prev) != domain.ActiveRateBand(bands, now) {
+			changed = append(changed, profile)
+		}
+	}
+	return changed
+}
+
+func (rs *RateScheduler) sendRateCoa(session domain.RadiusOnline, now time.Time) {
+	ctx := context.Background()
+	user, err := rs.svc.UserRepo.GetByUsername(ctx, session.Username)
+	if err != nil {
+		return
+	}
+	nas, err := rs.svc.NasRepo.GetByIPOrIdentifier(ctx, session.NasAddr, session.NasId)
+	if err != nil {
+		return
+	}
+
+	rate := enhancers.ResolveRate(user, rs.svc.AppContext().ProfileCache(), now)
+	packet, ok := buildRateCoaPacket(session, nas, rate)
+	if !ok {
+		return
+	}
+
+	coaPort := nas.CoaPort
+	if coaPort == 0 {
+		coaPort = 3799
+	}
+	coaAddr := net.JoinHostPort(nas.Ipaddr, strconv.Itoa(coaPort))
+	exCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	response, err := radius.Exchange(exCtx, packet, coaAddr)
+	if err != nil {
+		zap.L().Error("radius rate coa error",
+			zap.String("namespace", "radius"),
+			zap.String("username", session.Username),
+			zap.String("nas_addr", coaAddr),
+			zap.Error(err),
+		)
+		return
+	}
+	zap.L().Info("radius rate coa done",
+		zap.String("namespace", "radius"),
+		zap.String("username", session.Username),
+		zap.Int("up_rate", rate.UpRate),
+		zap.Int("down_rate", rate.DownRate),
+		zap.String("response", response.Code.String()),
+	)
+}
+
+// buildRateCoaPacket builds a CoA-Request carrying the vendor rate attributes
+// for the session. It returns false when the NAS vendor has no rate attributes.
+func buildRateCoaPacket(session domain.RadiusOnline, nas *domain.NetNas, rate enhancers.RateLimit) (*radius.Packet, bool) {
+	packet := radius.New(radius.CodeCoARequest, []byte(nas.Secret))
+	if !enhancers.SetVendorRate(packet, nas.VendorCode, rate) {
+		return nil, false
+	}
+	_ = rfc2865.UserName_SetString(packet, session.Username)           //nolint:errcheck
+	_ = rfc2866.AcctSessionID_SetString(packet, session.AcctSessionId) //nolint:errcheck
+	return packet, true
+}
@@ -0,0 +1,62 @@
+package radiusd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth/enhancers"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/vendors/mikrotik"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+func TestChangedRateProfiles(t *testing.T) {
+	night := domain.RadiusProfile{ID: 1, RateSchedule: `[{"days":"*","start":"00:00","end":"06:00","up_rate":10240,"down_rate":20480}]`}
+	invalid := domain.RadiusProfile{ID: 2, RateSchedule: `broken`}
+	profiles := []domain.RadiusProfile{night, invalid}
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 6, hour, minute, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name     string
+		prev     time.Time
+		now      time.Time
+		expected []int64
+	}{
+		{"band starts", at(23, 59), at(0, 0).AddDate(0, 0, 1), []int64{1}},
+		{"band ends", at(5, 59), at(6, 0), []int64{1}},
+		{"inside band", at(3, 0), at(3, 1), nil},
+		{"outside band", at(12, 0), at(12, 1), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int64
+			for _, p := range changedRateProfiles(profiles, tt.prev, tt.now) {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestBuildRateCoaPacket(t *testing.T) {
+	session := domain.RadiusOnline{Username: "alice", AcctSessionId: "sess-1"}
+	rate := enhancers.RateLimit{UpRate: 1024, DownRate: 4096}
+
+	packet, ok := buildRateCoaPacket(session, &domain.NetNas{Secret: "secret", VendorCode: vendors.CodeMikrotik}, rate)
+	require.True(t, ok)
+	assert.Equal(t, radius.CodeCoARequest, packet.Code)
+	assert.Equal(t, "alice", rfc2865.UserName_GetString(packet))
+	assert.Equal(t, "sess-1", rfc2866.AcctSessionID_GetString(packet))
+	assert.Equal(t, "1024k/4096k", mikrotik.MikrotikRateLimit_GetString(packet))
+
+	_, ok = buildRateCoaPacket(session, &domain.NetNas{Secret: "secret", VendorCode: vendors.CodeStandard}, rate)
+	assert.False(t, ok, "vendors without rate attributes are skipped")
+}
@@ -14,6 +14,7 @@ import (
 // Registry holds plugin registrations
 type Registry struct {
 	passwordValidators map[string]auth.PasswordValidator
+	validatorOrder     []string // Validator names in registration order
 	policyCheckers     []auth.PolicyChecker
 	responseEnhancers  []auth.ResponseEnhancer
 	authGuards         []auth.Guard
@@ -39,17 +40,21 @@ func newRegistry() *Registry {
 func RegisterPasswordValidator(validator auth.PasswordValidator) {
 	globalRegistry.mu.Lock()
 	defer globalRegistry.mu.Unlock()
+	if _, exists := globalRegistry.passwordValidators[validator.Name()]; !exists {
+		globalRegistry.validatorOrder = append(globalRegistry.validatorOrder, validator.Name())
+	}
 	globalRegistry.passwordValidators[validator.Name()] = validator
 }
 
-// GetPasswordValidators returns all password validators
+// GetPasswordValidators returns all password validators in registration order,
+// so validators registered first take precedence
 func GetPasswordValidators() []auth.PasswordValidator {
 	globalRegistry.mu.RLock()
 	defer globalRegistry.mu.RUnlock()
 
-	validators := make([]auth.PasswordValidator, 0, len(globalRegistry.passwordValidators))
-	for _, v := range globalRegistry.passwordValidators {
-		validators = append(validators, v)
+	validators := make([]auth.PasswordValidator, 0, len(globalRegistry.validatorOrder))
+	for _, name := range globalRegistry.validatorOrder {
+		validators = append(validators, globalRegistry.passwordValidators[name])
 	}
 	return validators
 }
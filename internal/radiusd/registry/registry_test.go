@@ -121,6 +121,27 @@ func TestGetPasswordValidators(t *testing.T) {
 	}
 }
 
+func TestGetPasswordValidators_RegistrationOrder(t *testing.T) {
+	ResetForTest()
+
+	names := []string{"ldap", "pap", "chap", "mschap"}
+	for _, name := range names {
+		RegisterPasswordValidator(&mockPasswordValidator{name: name})
+	}
+	// Re-registering replaces the validator but keeps its position
+	RegisterPasswordValidator(&mockPasswordValidator{name: "pap"})
+
+	validators := GetPasswordValidators()
+	if len(validators) != len(names) {
+		t.Fatalf("expected %d validators, got %d", len(names), len(validators))
+	}
+	for i, v := range validators {
+		if v.Name() != names[i] {
+			t.Errorf("expected validator %d to be '%s', got '%s'", i, names[i], v.Name())
+		}
+	}
+}
+
 // Tests for policy checkers
 
 func TestRegisterPolicyChecker(t *testing.T) {
@@ -0,0 +1,74 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormDeviceRepository is the GORM implementation of the device repository
+type GormDeviceRepository struct {
+	db *gorm.DB
+}
+
+// NewGormDeviceRepository creates a device repository instance
+func NewGormDeviceRepository(db *gorm.DB) repository.DeviceRepository {
+	return &GormDeviceRepository{db: db}
+}
+
+func (r *GormDeviceRepository) GetByUserMac(ctx context.Context, userId int64, macAddr string) (*domain.RadiusUserDevice, error) {
+	var device domain.RadiusUserDevice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND mac_addr = ?", userId, domain.NormalizeMac(macAddr)).
+		First(&device).Error
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+func (r *GormDeviceRepository) CountByUser(ctx context.Context, userId int64) (int, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.RadiusUserDevice{}).
+		Where("user_id = ?", userId).
+		Count(&count).Error
+	return int(count), err
+}
+
+// Learn locks the user row, so concurrent logins of the user from new devices
+// are counted one after the other
+func (r *GormDeviceRepository) Learn(ctx context.Context, device *domain.RadiusUserDevice, maxDevices int) (bool, error) {
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user domain.RadiusUser
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, device.UserId).Error; err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&domain.RadiusUserDevice{}).Where("user_id = ?", device.UserId).Count(&count).Error; err != nil {
+			return err
+		}
+		if int(count) >= maxDevices {
+			return nil
+		}
+		res := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "mac_addr"}},
+			DoNothing: true,
+		}).Create(device)
+		created = res.RowsAffected > 0
+		return res.Error
+	})
+	return created, err
+}
+
+func (r *GormDeviceRepository) UpdateLastSeen(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.RadiusUserDevice{}).
+		Where("id = ?", id).
+		Update("last_seen", time.Now()).Error
+}
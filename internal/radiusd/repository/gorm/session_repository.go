@@ -92,6 +92,15 @@ func (r *GormSessionRepository) CountByUsername(ctx context.Context, username st
 	return int(count), err
 }
 
+func (r *GormSessionRepository) ListByUsername(ctx context.Context, username string) ([]domain.RadiusOnline, error) {
+	var sessions []domain.RadiusOnline
+	err := r.db.WithContext(ctx).
+		Where("username = ?", username).
+		Order("acct_start_time ASC, id ASC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
 func (r *GormSessionRepository) Exists(ctx context.Context, sessionId string) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).
@@ -2,6 +2,7 @@ package gorm
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/talkincode/toughradius/v9/internal/domain"
@@ -31,12 +32,22 @@ func (r *GormUserRepository) GetByUsername(ctx context.Context, username string)
 func (r *GormUserRepository) GetByMacAddr(ctx context.Context, macAddr string) (*domain.RadiusUser, error) {
 	var user domain.RadiusUser
 	err := r.db.WithContext(ctx).Where("mac_addr = ?", macAddr).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if mac := domain.NormalizeMac(macAddr); mac != "" {
+			devices := r.db.Model(&domain.RadiusUserDevice{}).Select("user_id").Where("mac_addr = ?", mac)
+			err = r.db.WithContext(ctx).Where("id IN (?)", devices).First(&user).Error
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
+func (r *GormUserRepository) Create(ctx context.Context, user *domain.RadiusUser) error {
+	return r.db.WithContext(ctx).Create(user).Error
+}
+
 func (r *GormUserRepository) UpdateMacAddr(ctx context.Context, username, macAddr string) error {
 	return r.db.WithContext(ctx).
 		Model(&domain.RadiusUser{}).
@@ -55,6 +66,17 @@ func (r *GormUserRepository) UpdateVlanId(ctx context.Context, username string,
 		Updates(updates).Error
 }
 
+func (r *GormUserRepository) UpdateLineId(ctx context.Context, username, circuitId, remoteId string) error {
+	updates := map[string]interface{}{
+		"circuit_id": circuitId,
+		"remote_id":  remoteId,
+	}
+	return r.db.WithContext(ctx).
+		Model(&domain.RadiusUser{}).
+		Where("username = ?", username).
+		Updates(updates).Error
+}
+
 func (r *GormUserRepository) UpdateLastOnline(ctx context.Context, username string) error {
 	return r.db.WithContext(ctx).
 		Model(&domain.RadiusUser{}).
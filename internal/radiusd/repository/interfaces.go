@@ -11,15 +11,21 @@ type UserRepository interface {
 	// GetByUsername finds a user by username
 	GetByUsername(ctx context.Context, username string) (*domain.RadiusUser, error)
 
-	// GetByMacAddr finds a user by MAC address
+	// GetByMacAddr finds a user by MAC address, or by a registered device
 	GetByMacAddr(ctx context.Context, macAddr string) (*domain.RadiusUser, error)
 
+	// Create creates a user
+	Create(ctx context.Context, user *domain.RadiusUser) error
+
 	// UpdateMacAddr updates the user's MAC address
 	UpdateMacAddr(ctx context.Context, username, macAddr string) error
 
 	// UpdateVlanId updates the user's VLAN ID
 	UpdateVlanId(ctx context.Context, username string, vlanId1, vlanId2 int) error
 
+	// UpdateLineId updates the user's bound access line
+	UpdateLineId(ctx context.Context, username, circuitId, remoteId string) error
+
 	// UpdateLastOnline updates the last online time
 	UpdateLastOnline(ctx context.Context, username string) error
 
@@ -27,6 +33,22 @@ type UserRepository interface {
 	UpdateField(ctx context.Context, username string, field string, value interface{}) error
 }
 
+// DeviceRepository manages the devices registered to users
+type DeviceRepository interface {
+	// GetByUserMac finds a device of the user by MAC address
+	GetByUserMac(ctx context.Context, userId int64, macAddr string) (*domain.RadiusUserDevice, error)
+
+	// CountByUser counts the devices registered to the user
+	CountByUser(ctx context.Context, userId int64) (int, error)
+
+	// Learn registers a device unless it is registered already or the user
+	// has maxDevices devices, and reports whether it was registered
+	Learn(ctx context.Context, device *domain.RadiusUserDevice, maxDevices int) (bool, error)
+
+	// UpdateLastSeen records a login of the device
+	UpdateLastSeen(ctx context.Context, id int64) error
+}
+
 // SessionRepository manages online sessions
 type SessionRepository interface {
 	// Create Create online session
@@ -44,6 +66,9 @@ type SessionRepository interface {
 	// CountByUsername counts online sessions per user
 	CountByUsername(ctx context.Context, username string) (int, error)
 
+	// ListByUsername lists the online sessions of a user, oldest first
+	ListByUsername(ctx context.Context, username string) ([]domain.RadiusOnline, error)
+
 	// Exists checks whether the session exists
 	Exists(ctx context.Context, sessionId string) (bool, error)
 
@@ -0,0 +1,121 @@
+package radiusd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+// disconnectTimeout bounds a single Disconnect-Request
+const disconnectTimeout = 2 * time.Second
+
+// disconnectBatchTimeout bounds the disconnects of all sessions replaced by
+// an accepted login
+const disconnectBatchTimeout = 10 * time.Second
+
+// disconnectSessions ends the sessions replaced by an accepted login. The
+// reply to the NAS does not wait for them: the Disconnect-Requests are sent
+// in the background, all at once, within disconnectBatchTimeout.
+func (s *RadiusService) disconnectSessions(sessions []domain.RadiusOnline) {
+	if len(sessions) == 0 {
+		return
+	}
+	task := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), disconnectBatchTimeout)
+		defer cancel()
+
+		var wg sync.WaitGroup
+		for i := range sessions {
+			wg.Add(1)
+			go func(session *domain.RadiusOnline) {
+				defer wg.Done()
+				// The session is removed from the online table even when the
+				// NAS does not acknowledge
+				if err := s.DisconnectSession(ctx, session); err != nil {
+					zap.L().Warn("disconnect session failed",
+						zap.String("namespace", "radius"),
+						zap.String("username", session.Username),
+						zap.String("acct_session_id", session.AcctSessionId),
+						zap.String("nas_addr", session.NasAddr),
+						zap.Error(err),
+					)
+				}
+			}(&sessions[i])
+		}
+		wg.Wait()
+	}
+
+	if s.TaskPool == nil {
+		go task()
+		return
+	}
+	if err := s.TaskPool.Submit(task); err != nil {
+		zap.L().Warn("disconnect task pool saturated, running fallback goroutine",
+			zap.String("namespace", "radius"),
+			zap.Error(err),
+		)
+		go task()
+	}
+}
+
+// DisconnectSession sends a Disconnect-Request for session to its NAS and
+// removes the session from the online table. The session is removed even
+// when the NAS cannot be reached or refuses, a session the NAS does not know
+// is a ghost, or when ctx expires first.
+func (s *RadiusService) DisconnectSession(ctx context.Context, session *domain.RadiusOnline) error {
+	defer func() {
+		if err := s.SessionRepo.Delete(context.WithoutCancel(ctx), session.AcctSessionId); err != nil {
+			zap.L().Error("delete disconnected session failed",
+				zap.String("namespace", "radius"),
+				zap.String("acct_session_id", session.AcctSessionId),
+				zap.Error(err),
+			)
+		}
+	}()
+
+	nas, err := s.NasRepo.GetByIP(ctx, session.NasAddr)
+	if err != nil {
+		return fmt.Errorf("nas %s: %w", session.NasAddr, err)
+	}
+
+	packet := radius.New(radius.CodeDisconnectRequest, []byte(nas.Secret))
+	_ = rfc2865.UserName_SetString(packet, session.Username)           //nolint:errcheck
+	_ = rfc2866.AcctSessionID_SetString(packet, session.AcctSessionId) //nolint:errcheck
+	if ip := net.ParseIP(session.FramedIpaddr); ip != nil {
+		_ = rfc2865.FramedIPAddress_Set(packet, ip) //nolint:errcheck
+	}
+
+	port := nas.CoaPort
+	if port <= 0 {
+		port = 3799
+	}
+	addr := net.JoinHostPort(nas.Ipaddr, strconv.Itoa(port))
+
+	exchangeCtx, cancel := context.WithTimeout(ctx, disconnectTimeout)
+	defer cancel()
+	client := &radius.Client{Retry: 500 * time.Millisecond}
+	response, err := client.Exchange(exchangeCtx, packet, addr)
+	if err != nil {
+		return fmt.Errorf("disconnect %s: %w", addr, err)
+	}
+	if response.Code != radius.CodeDisconnectACK {
+		return fmt.Errorf("disconnect %s: nas answered %s", addr, response.Code)
+	}
+
+	zap.L().Info("radius session disconnected",
+		zap.String("namespace", "radius"),
+		zap.String("username", session.Username),
+		zap.String("acct_session_id", session.AcctSessionId),
+		zap.String("nas_addr", addr),
+	)
+	return nil
+}
@@ -0,0 +1,110 @@
+package radiusd
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2866"
+)
+
+func TestDisconnectSession(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+
+	// A NAS acknowledging every Disconnect-Request
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	requests := make(chan string, 1)
+	server := &radius.PacketServer{
+		SecretSource: radius.StaticSecretSource([]byte("nas-secret")),
+		Handler: radius.HandlerFunc(func(w radius.ResponseWriter, r *radius.Request) {
+			requests <- rfc2866.AcctSessionID_GetString(r.Packet)
+			_ = w.Write(r.Response(radius.CodeDisconnectACK)) //nolint:errcheck
+		}),
+	}
+	go func() { _ = server.Serve(conn) }()                       //nolint:errcheck
+	defer func() { _ = server.Shutdown(context.Background()) }() //nolint:errcheck
+
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Ipaddr: "127.0.0.1", Secret: "nas-secret", CoaPort: conn.LocalAddr().(*net.UDPAddr).Port}
+	require.NoError(t, appCtx.DB().Create(nas).Error)
+
+	ctx := context.Background()
+	sessions := []*domain.RadiusOnline{
+		{Username: "alice", NasAddr: "127.0.0.1", AcctSessionId: "s1", AcctStartTime: time.Now()},
+		{Username: "alice", NasAddr: "192.0.2.1", AcctSessionId: "s2", AcctStartTime: time.Now()},
+	}
+	for _, session := range sessions {
+		require.NoError(t, radiusService.SessionRepo.Create(ctx, session))
+	}
+
+	require.NoError(t, radiusService.DisconnectSession(ctx, sessions[0]))
+	select {
+	case id := <-requests:
+		assert.Equal(t, "s1", id)
+	case <-time.After(time.Second):
+		t.Fatal("no Disconnect-Request received")
+	}
+
+	// Sessions of an unknown NAS are removed as ghosts
+	assert.Error(t, radiusService.DisconnectSession(ctx, sessions[1]))
+
+	count, err := radiusService.SessionRepo.CountByUsername(ctx, "alice")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestDisconnectSessionsAfterAccept(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+
+	// A NAS holding its answers until released
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	release := make(chan struct{})
+	server := &radius.PacketServer{
+		SecretSource: radius.StaticSecretSource([]byte("nas-secret")),
+		Handler: radius.HandlerFunc(func(w radius.ResponseWriter, r *radius.Request) {
+			<-release
+			_ = w.Write(r.Response(radius.CodeDisconnectACK)) //nolint:errcheck
+		}),
+	}
+	go func() { _ = server.Serve(conn) }()                       //nolint:errcheck
+	defer func() { _ = server.Shutdown(context.Background()) }() //nolint:errcheck
+
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Ipaddr: "127.0.0.1", Secret: "nas-secret", CoaPort: conn.LocalAddr().(*net.UDPAddr).Port}
+	require.NoError(t, appCtx.DB().Create(nas).Error)
+
+	ctx := context.Background()
+	sessions := []domain.RadiusOnline{
+		{Username: "alice", NasAddr: "127.0.0.1", AcctSessionId: "s1", AcctStartTime: time.Now()},
+		{Username: "alice", NasAddr: "127.0.0.1", AcctSessionId: "s2", AcctStartTime: time.Now()},
+	}
+	for i := range sessions {
+		require.NoError(t, radiusService.SessionRepo.Create(ctx, &sessions[i]))
+	}
+
+	// The accept path does not wait for the NAS
+	radiusService.disconnectSessions(sessions)
+	count, err := radiusService.SessionRepo.CountByUsername(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		count, err := radiusService.SessionRepo.CountByUsername(ctx, "alice")
+		return err == nil && count == 0
+	}, 5*time.Second, 20*time.Millisecond)
+}
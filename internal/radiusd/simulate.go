@@ -0,0 +1,387 @@
+package radiusd
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // CHAP is defined on MD5
+	"crypto/rand"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/radiusd/dictionary"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+// Authentication methods of a simulated request
+const (
+	SimulateMethodPAP  = "pap"
+	SimulateMethodCHAP = "chap"
+	SimulateMethodMAC  = "mac"
+	SimulateMethodEAP  = "eap"
+)
+
+// Decisions of a simulated request
+const (
+	SimulateAccept    = "accept"
+	SimulateReject    = "reject"
+	SimulateChallenge = "challenge"
+	SimulateNone      = "none" // A stage stopped the pipeline without an answer
+)
+
+// Results of a traced stage or plugin
+const (
+	SimulateResultOK     = "ok"
+	SimulateResultReject = "reject"
+	SimulateResultStop   = "stop"
+)
+
+// SimulateRequest describes the Access-Request of a dry run. The NAS is
+// looked up by NasIP and NasIdentifier like a real request. MacAddr and the
+// VLAN IDs override what the vendor parser finds in the request.
+type SimulateRequest struct {
+	Username         string `json:"username"`
+	Password         string `json:"password"`
+	Method           string `json:"method"` // pap (default), chap, mac or eap
+	NasIP            string `json:"nas_ip"`
+	NasIdentifier    string `json:"nas_identifier"`
+	MacAddr          string `json:"mac_addr"`
+	Vlanid1          int64  `json:"vlanid1"`
+	Vlanid2          int64  `json:"vlanid2"`
+	CallingStationID string `json:"calling_station_id"`
+	EapMethod        string `json:"eap_method"` // Implies the eap method
+}
+
+// SimulateStep is the trace of one pipeline stage or plugin
+type SimulateStep struct {
+	Name     string   `json:"name"`
+	Order    int      `json:"order,omitempty"`
+	Result   string   `json:"result"`
+	Error    string   `json:"error,omitempty"`
+	Metric   string   `json:"metric,omitempty"`
+	Notes    []string `json:"notes,omitempty"`
+	Duration float64  `json:"duration_ms,omitempty"`
+}
+
+// SimulateEnhancer lists the Access-Accept attributes a response enhancer
+// added or changed
+type SimulateEnhancer struct {
+	Name       string                      `json:"name"`
+	Attributes []dictionary.AttributeValue `json:"attributes"`
+	Error      string                      `json:"error,omitempty"`
+}
+
+// SimulateResult is the outcome of a dry run with the trace of every stage,
+// the password validator, every policy checker and every response enhancer
+// that ran
+type SimulateResult struct {
+	Decision   string                      `json:"decision"`
+	Stage      string                      `json:"stage,omitempty"` // The stage that decided
+	Reason     string                      `json:"reason,omitempty"`
+	Metric     string                      `json:"metric,omitempty"`
+	EapMethod  string                      `json:"eap_method,omitempty"`
+	Stages     []SimulateStep              `json:"stages"`
+	Password   *SimulateStep               `json:"password,omitempty"`
+	Checkers   []SimulateStep              `json:"checkers"`
+	Enhancers  []SimulateEnhancer          `json:"enhancers"`
+	Attributes []dictionary.AttributeValue `json:"attributes"` // The complete answer
+}
+
+// Simulate runs the auth pipeline for a synthetic request without side
+// effects: no state is stored, no counter or binding is updated, no session
+// is disconnected, no external endpoint is called and the NAS gets no
+// answer. Guards such as the reject delay do not run and EAP exchanges are
+// reduced to a password check.
+func (s *AuthService) Simulate(ctx context.Context, req SimulateRequest) (*SimulateResult, error) {
+	request, err := s.simulateRequest(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &dryRunWriter{}
+	pctx := NewAuthPipelineContext(s, writer, request)
+	pctx.Context = ctx
+	pctx.DryRun = true
+
+	result := &SimulateResult{
+		Decision:  SimulateNone,
+		Stages:    make([]SimulateStep, 0),
+		Checkers:  make([]SimulateStep, 0),
+		Enhancers: make([]SimulateEnhancer, 0),
+	}
+	for _, stage := range s.Pipeline().Stages() {
+		if pctx.IsStopped() {
+			break
+		}
+		start := time.Now()
+		if stage.Name() == StagePluginAuth {
+			err = s.simulatePluginAuth(pctx, result)
+		} else {
+			err = stage.Execute(pctx)
+		}
+		if err == nil {
+			s.applySimulateOverrides(stage.Name(), pctx, &req)
+		}
+
+		step := SimulateStep{
+			Name:     stage.Name(),
+			Result:   SimulateResultOK,
+			Notes:    pctx.takeNotes(),
+			Duration: float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if err != nil {
+			step.Result, step.Error, step.Metric = SimulateResultReject, err.Error(), rejectMetric(err)
+			result.Decision, result.Stage, result.Reason, result.Metric = SimulateReject, stage.Name(), err.Error(), step.Metric
+			result.Stages = append(result.Stages, step)
+			break
+		}
+		if pctx.IsStopped() {
+			step.Result = SimulateResultStop
+			result.Stage = stage.Name()
+		}
+		result.Stages = append(result.Stages, step)
+	}
+
+	// A stage other than plugin_auth answered, e.g. a one-time code challenge
+	if result.Decision == SimulateNone && writer.packet != nil {
+		switch writer.packet.Code {
+		case radius.CodeAccessAccept:
+			result.Decision = SimulateAccept
+		case radius.CodeAccessReject:
+			result.Decision = SimulateReject
+		case radius.CodeAccessChallenge:
+			result.Decision = SimulateChallenge
+		}
+		result.Attributes = describeAttributes(writer.packet.Attributes)
+	}
+	if pctx.IsEAP {
+		result.EapMethod = pctx.EAPMethod
+	}
+	return result, nil
+}
+
+// simulateRequest builds the Access-Request of a dry run, signed with the
+// secret of the NAS when it is known
+func (s *AuthService) simulateRequest(ctx context.Context, req *SimulateRequest) (*radius.Request, error) {
+	nasIP := net.ParseIP(strings.TrimSpace(req.NasIP))
+	if nasIP == nil {
+		return nil, fmt.Errorf("invalid nas ip %q", req.NasIP)
+	}
+
+	req.Method = strings.ToLower(strings.TrimSpace(req.Method))
+	if req.Method == "" {
+		req.Method = SimulateMethodPAP
+		if req.EapMethod != "" {
+			req.Method = SimulateMethodEAP
+		}
+	}
+	if req.Method == SimulateMethodMAC {
+		if req.MacAddr == "" {
+			return nil, fmt.Errorf("mac authentication requires a mac address")
+		}
+		if req.Username == "" {
+			req.Username = req.MacAddr
+		}
+	}
+	if strings.TrimSpace(req.Username) == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+
+	// The nas_lookup stage rejects an unknown NAS, any secret does for it
+	secret := []byte("simulate")
+	if nas, err := s.GetNas(nasIP.String(), req.NasIdentifier); err == nil && nas != nil {
+		secret = []byte(nas.Secret)
+	}
+	packet := radius.New(radius.CodeAccessRequest, secret)
+	_ = rfc2865.UserName_SetString(packet, req.Username) //nolint:errcheck
+	_ = rfc2865.NASIPAddress_Set(packet, nasIP)          //nolint:errcheck
+	if req.NasIdentifier != "" {
+		_ = rfc2865.NASIdentifier_SetString(packet, req.NasIdentifier) //nolint:errcheck
+	}
+	station := req.CallingStationID
+	if station == "" {
+		station = req.MacAddr
+	}
+	if station != "" {
+		_ = rfc2865.CallingStationID_SetString(packet, station) //nolint:errcheck
+	}
+
+	switch req.Method {
+	case SimulateMethodPAP, SimulateMethodEAP:
+		if err := rfc2865.UserPassword_SetString(packet, req.Password); err != nil {
+			return nil, fmt.Errorf("invalid password: %w", err)
+		}
+	case SimulateMethodCHAP:
+		challenge := make([]byte, 16)
+		if _, err := rand.Read(challenge); err != nil {
+			return nil, err
+		}
+		chapID := challenge[0]
+		hash := md5.New() //nolint:gosec
+		hash.Write([]byte{chapID})
+		hash.Write([]byte(req.Password))
+		hash.Write(challenge)
+		_ = rfc2865.CHAPPassword_Set(packet, append([]byte{chapID}, hash.Sum(nil)...)) //nolint:errcheck
+		_ = rfc2865.CHAPChallenge_Set(packet, challenge)                               //nolint:errcheck
+	case SimulateMethodMAC:
+		// The MAC address is the credential
+	default:
+		return nil, fmt.Errorf("unsupported method %q", req.Method)
+	}
+
+	request := &radius.Request{
+		LocalAddr:  &net.UDPAddr{IP: net.IPv4zero, Port: 1812},
+		RemoteAddr: &net.UDPAddr{IP: nasIP, Port: 1812},
+		Packet:     packet,
+	}
+	return request.WithContext(ctx), nil
+}
+
+// applySimulateOverrides applies the parts of the request a synthetic packet
+// cannot carry once the stage producing them ran
+func (s *AuthService) applySimulateOverrides(stage string, pctx *AuthPipelineContext, req *SimulateRequest) {
+	switch stage {
+	case StageRequestMetadata:
+		if req.Method != SimulateMethodEAP {
+			return
+		}
+		pctx.IsEAP = true
+		if req.EapMethod == "" {
+			return
+		}
+		requested := strings.ToLower(strings.TrimSpace(req.EapMethod))
+		pctx.EAPMethod = s.resolveEapMethod(requested)
+		if pctx.EAPMethod != requested {
+			pctx.Note("eap method %s is disabled, %s is offered", requested, pctx.EAPMethod)
+		}
+	case StageVendorParsing:
+		vendorReq := pctx.VendorRequest
+		if vendorReq == nil {
+			return
+		}
+		if req.MacAddr != "" {
+			vendorReq.MacAddr = strings.ReplaceAll(req.MacAddr, "-", ":")
+		}
+		if req.Vlanid1 != 0 {
+			vendorReq.Vlanid1 = req.Vlanid1
+		}
+		if req.Vlanid2 != 0 {
+			vendorReq.Vlanid2 = req.Vlanid2
+		}
+		pctx.IsMacAuth = vendorReq.MacAddr != "" && vendorReq.MacAddr == pctx.Username
+		if plugin := pctx.VendorRequestForPlugin; plugin != nil {
+			plugin.MacAddr, plugin.Vlanid1, plugin.Vlanid2 = vendorReq.MacAddr, vendorReq.Vlanid1, vendorReq.Vlanid2
+		}
+	}
+}
+
+// simulatePluginAuth stands in for the plugin_auth stage: it runs the
+// password validator and policy checkers in dry run mode and traces the
+// attributes every response enhancer adds to the Access-Accept
+func (s *AuthService) simulatePluginAuth(pctx *AuthPipelineContext, result *SimulateResult) error {
+	opts := []AuthPluginOption{
+		DryRun(),
+		TracePlugins(func(trace PluginTrace) {
+			step := SimulateStep{Name: trace.Name, Order: trace.Order, Result: SimulateResultOK, Notes: trace.Notes}
+			if trace.Err != nil {
+				step.Result, step.Error, step.Metric = SimulateResultReject, trace.Err.Error(), rejectMetric(trace.Err)
+			}
+			if trace.Kind == "validator" {
+				result.Password = &step
+				return
+			}
+			result.Checkers = append(result.Checkers, step)
+		}),
+	}
+	switch {
+	case pctx.IsMacAuth:
+		pctx.Note("mac authentication, the password check and the policy checks not applying to it are skipped")
+	case pctx.PasswordVerified:
+		opts = append(opts, SkipPasswordValidation())
+		pctx.Note("password already verified")
+	}
+
+	err := s.AuthenticateUserWithPlugins(pctx.Context, pctx.Request, pctx.Response, pctx.User, pctx.NAS, pctx.VendorRequestForPlugin, pctx.IsMacAuth, opts...)
+	if err != nil {
+		return err
+	}
+
+	s.applyAcceptEnhancers(pctx.User, pctx.NAS, pctx.VendorRequestForPlugin, pctx.Response, func(name string, added radius.Attributes, err error) {
+		enhancer := SimulateEnhancer{Name: name, Attributes: describeAttributes(added)}
+		if err != nil {
+			enhancer.Error = err.Error()
+		}
+		result.Enhancers = append(result.Enhancers, enhancer)
+	})
+	result.Decision = SimulateAccept
+	result.Attributes = describeAttributes(pctx.Response.Attributes)
+	pctx.Stop()
+	return nil
+}
+
+// rejectMetric returns the metrics key counting a reject caused by err
+func rejectMetric(err error) string {
+	if radiusErr, ok := radiuserrors.GetRadiusError(err); ok {
+		return radiusErr.MetricsKey()
+	}
+	return ""
+}
+
+// describeAttributes decodes attributes by name, with the runtime dictionary
+// when one is loaded
+func describeAttributes(attrs radius.Attributes) []dictionary.AttributeValue {
+	dict := dictionary.Default()
+	values := make([]dictionary.AttributeValue, 0, len(attrs))
+	for _, avp := range attrs {
+		if dict != nil {
+			values = append(values, dict.Decode(avp.Type, avp.Attribute)...)
+			continue
+		}
+		values = append(values, dictionary.AttributeValue{Name: StringType(avp.Type), Value: FormatType(avp.Type, avp.Attribute)})
+	}
+	return values
+}
+
+// copyAttributes copies attrs deeply, enhancers may change values in place
+func copyAttributes(attrs radius.Attributes) radius.Attributes {
+	copied := make(radius.Attributes, 0, len(attrs))
+	for _, avp := range attrs {
+		copied = append(copied, &radius.AVP{Type: avp.Type, Attribute: append(radius.Attribute(nil), avp.Attribute...)})
+	}
+	return copied
+}
+
+// addedAttributes returns the attributes of after missing from before
+func addedAttributes(before, after radius.Attributes) radius.Attributes {
+	seen := make(map[string]int, len(before))
+	for _, avp := range before {
+		seen[attributeKey(avp)]++
+	}
+	var added radius.Attributes
+	for _, avp := range after {
+		key := attributeKey(avp)
+		if seen[key] > 0 {
+			seen[key]--
+			continue
+		}
+		added = append(added, avp)
+	}
+	return added
+}
+
+func attributeKey(avp *radius.AVP) string {
+	return string(append([]byte{byte(avp.Type)}, avp.Attribute...))
+}
+
+// dryRunWriter keeps the answer of a simulated request instead of
+// sending it
+type dryRunWriter struct {
+	packet *radius.Packet
+}
+
+func (w *dryRunWriter) Write(packet *radius.Packet) error {
+	w.packet = packet
+	return nil
+}
@@ -0,0 +1,162 @@
+package radiusd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+)
+
+func TestSimulate(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+	reRegisterVendorParsers()
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
+	authService := NewAuthService(radiusService)
+	ctx := context.Background()
+	db := appCtx.DB()
+
+	nas := &domain.NetNas{ID: common.UUIDint64(), Name: "nas", Identifier: "nas-1", Ipaddr: "10.0.0.1", Secret: "secret", VendorCode: "0", Status: common.ENABLED}
+	require.NoError(t, db.Create(nas).Error)
+	user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "testuser", Password: "password", Status: common.ENABLED, ExpireTime: time.Now().Add(time.Hour)}
+	require.NoError(t, db.Create(user).Error)
+
+	t.Run("accept", func(t *testing.T) {
+		result, err := authService.Simulate(ctx, SimulateRequest{Username: "testuser", Password: "password", NasIP: "10.0.0.1"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateAccept, result.Decision)
+		assert.Equal(t, StagePluginAuth, result.Stage)
+
+		stages := authService.Pipeline().Stages()
+		require.Len(t, result.Stages, len(stages))
+		for i, stage := range stages {
+			assert.Equal(t, stage.Name(), result.Stages[i].Name)
+		}
+		assert.NotEmpty(t, result.Stages[2].Notes, "the rate limit is not touched")
+
+		require.NotNil(t, result.Password)
+		assert.Equal(t, SimulateResultOK, result.Password.Result)
+		require.NotEmpty(t, result.Checkers)
+		assert.Equal(t, "status", result.Checkers[0].Name)
+		require.NotEmpty(t, result.Enhancers)
+
+		var added int
+		for _, enhancer := range result.Enhancers {
+			added += len(enhancer.Attributes)
+		}
+		assert.Equal(t, len(result.Attributes), added)
+		assert.NotEmpty(t, result.Attributes)
+
+		// Nothing is recorded for the user
+		var user domain.RadiusUser
+		require.NoError(t, db.Where("username = ?", "testuser").First(&user).Error)
+		assert.True(t, user.LastOnline.IsZero())
+	})
+
+	t.Run("reject without lockout", func(t *testing.T) {
+		require.NoError(t, appCtx.ConfigMgr().Set("lockout", "Enabled", "true"))
+		require.NoError(t, appCtx.ConfigMgr().Set("lockout", "MaxFailures", "1"))
+		defer func() { _ = appCtx.ConfigMgr().Set("lockout", "Enabled", "false") }() //nolint:errcheck
+
+		for i := 0; i < 3; i++ {
+			result, err := authService.Simulate(ctx, SimulateRequest{Username: "testuser", Password: "wrong", NasIP: "10.0.0.1"})
+			require.NoError(t, err)
+			assert.Equal(t, SimulateReject, result.Decision)
+			assert.Equal(t, StagePluginAuth, result.Stage)
+			assert.Equal(t, app.MetricsRadiusRejectPasswdError, result.Metric)
+			require.NotNil(t, result.Password)
+			assert.Equal(t, SimulateResultReject, result.Password.Result)
+			assert.Empty(t, result.Checkers)
+			assert.Empty(t, result.Attributes)
+		}
+
+		var count int64
+		require.NoError(t, db.Model(&domain.RadiusLockout{}).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		result, err := authService.Simulate(ctx, SimulateRequest{Username: "nobody", Password: "password", NasIP: "10.0.0.1"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateReject, result.Decision)
+		assert.Equal(t, StageLoadUser, result.Stage)
+		assert.Equal(t, app.MetricsRadiusRejectNotExists, result.Metric)
+		assert.Nil(t, result.Password)
+	})
+
+	t.Run("session policy does not disconnect", func(t *testing.T) {
+		profile := &domain.RadiusProfile{ID: common.UUIDint64(), Name: "single", Status: common.ENABLED, ActiveNum: 1, SessionPolicy: domain.SessionPolicyDisconnectOldest}
+		require.NoError(t, db.Create(profile).Error)
+		user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "carol", Password: "password", ProfileId: profile.ID, ActiveNum: 1, Status: common.ENABLED, ExpireTime: time.Now().Add(time.Hour)}
+		require.NoError(t, db.Create(user).Error)
+		session := &domain.RadiusOnline{Username: "carol", NasAddr: "10.0.0.1", AcctSessionId: "carol-1", AcctStartTime: time.Now(), LastUpdate: time.Now()}
+		require.NoError(t, radiusService.SessionRepo.Create(ctx, session))
+
+		result, err := authService.Simulate(ctx, SimulateRequest{Username: "carol", Password: "password", NasIP: "10.0.0.1"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateAccept, result.Decision)
+
+		var notes []string
+		for _, checker := range result.Checkers {
+			if checker.Name == "online_count" {
+				notes = checker.Notes
+			}
+		}
+		require.Len(t, notes, 1)
+		assert.Contains(t, notes[0], "carol-1")
+
+		count, err := radiusService.SessionRepo.CountByUsername(ctx, "carol")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("one-time code challenge", func(t *testing.T) {
+		secret, err := totp.GenerateSecret()
+		require.NoError(t, err)
+		user := &domain.RadiusUser{ID: common.UUIDint64(), Username: "dave", Password: "password", TotpSecret: secret, Status: common.ENABLED, ExpireTime: time.Now().Add(time.Hour)}
+		require.NoError(t, db.Create(user).Error)
+
+		result, err := authService.Simulate(ctx, SimulateRequest{Username: "dave", Password: "password", NasIP: "10.0.0.1"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateChallenge, result.Decision)
+		assert.Equal(t, StageTOTP, result.Stage)
+		assert.Empty(t, result.Checkers)
+
+		// The code still works for the real login after a dry run
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		result, err = authService.Simulate(ctx, SimulateRequest{Username: "dave", Password: "password" + code, NasIP: "10.0.0.1"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateAccept, result.Decision)
+		assert.True(t, authService.TOTPVerifier.Verify("dave", secret, code))
+	})
+
+	t.Run("unknown nas", func(t *testing.T) {
+		result, err := authService.Simulate(ctx, SimulateRequest{Username: "testuser", Password: "password", NasIP: "10.9.9.9"})
+		require.NoError(t, err)
+		assert.Equal(t, SimulateReject, result.Decision)
+		assert.Equal(t, StageNasLookup, result.Stage)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := authService.Simulate(ctx, SimulateRequest{Username: "testuser", NasIP: "nas"})
+		assert.Error(t, err)
+		_, err = authService.Simulate(ctx, SimulateRequest{Username: "testuser", NasIP: "10.0.0.1", Method: "mschap"})
+		assert.Error(t, err)
+		_, err = authService.Simulate(ctx, SimulateRequest{NasIP: "10.0.0.1", Method: SimulateMethodMAC})
+		assert.Error(t, err)
+	})
+}
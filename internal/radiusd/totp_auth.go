@@ -0,0 +1,218 @@
+package radiusd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/ldapauth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/auth/validators"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/eap"
+	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+const (
+	totpChallengeMethod  = "pap-totp"
+	totpChallengePrompt  = "Enter the one-time code from your authenticator app"
+	totpChallengeTimeout = 120 * time.Second
+	totpStateExpires     = "expires"
+)
+
+// stageTOTP adds the second factor to PAP logins of users with an enrolled
+// TOTP secret. The code is either appended to the password in a single
+// request, or sent in a second request answering an Access-Challenge.
+func (s *AuthService) stageTOTP(ctx *AuthPipelineContext) error {
+	if ctx.IsEAP || ctx.IsMacAuth || ctx.User == nil || ctx.User.TotpSecret == "" {
+		return nil
+	}
+
+	password := rfc2865.UserPassword_GetString(ctx.Request.Packet)
+	if password == "" {
+		return radiuserrors.NewOTPError("two-factor authentication requires pap")
+	}
+
+	// Second request: the password attribute carries the code
+	if stateID := rfc2865.State_GetString(ctx.Request.Packet); stateID != "" {
+		return s.answerTOTPChallenge(ctx, stateID, strings.TrimSpace(password))
+	}
+
+	// Password and code in one request
+	if first, code, ok := splitTOTPSuffix(password); ok {
+		err := s.verifyFirstFactor(ctx, first)
+		if err == nil {
+			if !s.verifyTOTPCode(ctx, code) {
+				return radiuserrors.NewOTPError("one-time code mismatch")
+			}
+			ctx.PasswordVerified = true
+			return nil
+		}
+		// Every failed bind counts against the directory lockout, LDAP
+		// users get one per attempt
+		if ctx.User.AuthSource == domain.AuthSourceLDAP {
+			return err
+		}
+	}
+
+	// Password only: ask for the code
+	if err := s.verifyFirstFactor(ctx, password); err != nil {
+		return err
+	}
+	return s.sendTOTPChallenge(ctx)
+}
+
+// verifyTOTPCode checks a one-time code. A dry run does not mark the code
+// as used, so the real login can still use it.
+func (s *AuthService) verifyTOTPCode(ctx *AuthPipelineContext, code string) bool {
+	if ctx.DryRun {
+		_, ok := totp.Validate(ctx.User.TotpSecret, code, time.Now())
+		return ok
+	}
+	return s.TOTPVerifier.Verify(ctx.User.Username, ctx.User.TotpSecret, code)
+}
+
+// answerTOTPChallenge verifies the code sent for a pending challenge
+func (s *AuthService) answerTOTPChallenge(ctx *AuthPipelineContext, stateID, code string) error {
+	states := s.totpStates()
+	if states == nil {
+		return radiuserrors.NewOTPError("one-time code challenge not available")
+	}
+	state, err := states.GetState(stateID)
+	if err != nil || state.Method != totpChallengeMethod || state.Username != ctx.Username {
+		return radiuserrors.NewOTPError("unknown one-time code challenge")
+	}
+	// Every challenge can be answered once
+	_ = states.DeleteState(stateID) //nolint:errcheck
+
+	if expires, _ := state.Data[totpStateExpires].(time.Time); time.Now().After(expires) {
+		return radiuserrors.NewOTPError("one-time code challenge expired")
+	}
+	if !s.TOTPVerifier.Verify(ctx.User.Username, ctx.User.TotpSecret, code) {
+		return radiuserrors.NewOTPError("one-time code mismatch")
+	}
+	ctx.PasswordVerified = true
+	return nil
+}
+
+// sendTOTPChallenge answers a correct password with an Access-Challenge
+// prompting for the code, and stops the pipeline.
+func (s *AuthService) sendTOTPChallenge(ctx *AuthPipelineContext) error {
+	states := s.totpStates()
+	if states == nil {
+		return radiuserrors.NewOTPError("one-time code challenge not available")
+	}
+
+	stateID := common.UUID()
+	state := &eap.EAPState{
+		Username: ctx.Username,
+		StateID:  stateID,
+		Method:   totpChallengeMethod,
+		Data: map[string]interface{}{
+			totpStateExpires: time.Now().Add(totpChallengeTimeout),
+		},
+	}
+	if ctx.DryRun {
+		// Nobody answers the challenge of a simulated request
+		ctx.Note("password correct, the one-time code is asked for in a second request")
+	} else if err := states.SetState(stateID, state); err != nil {
+		return err
+	}
+
+	// Session-Timeout bounds how long the client waits for the code
+	timeout := rfc2865.SessionTimeout(totpChallengeTimeout / time.Second)
+	response := ctx.Request.Response(radius.CodeAccessChallenge)
+	_ = rfc2865.State_SetString(response, stateID)                    //nolint:errcheck
+	_ = rfc2865.ReplyMessage_SetString(response, totpChallengePrompt) //nolint:errcheck
+	_ = rfc2865.SessionTimeout_Set(response, timeout)                 //nolint:errcheck
+
+	if err := ctx.Writer.Write(response); err != nil {
+		_ = states.DeleteState(stateID) //nolint:errcheck
+		return err
+	}
+
+	zap.L().Info("radius auth one-time code challenge",
+		zap.String("namespace", "radius"),
+		zap.String("username", ctx.Username),
+		zap.String("nasip", ctx.RemoteIP),
+	)
+	ctx.Stop()
+	return nil
+}
+
+// verifyFirstFactor checks password with the password validators, as if it
+// was the User-Password of the request. A successful LDAP bind also moves
+// the user to the profile of their current groups, the policy rules are
+// evaluated again for the new profile.
+func (s *AuthService) verifyFirstFactor(ctx *AuthPipelineContext, password string) error {
+	stored, err := s.GetLocalPassword(ctx.User, false)
+	if err != nil {
+		return radiuserrors.WrapError(app.MetricsRadiusRejectPasswdError, err)
+	}
+
+	packet := *ctx.Request.Packet
+	packet.Attributes = append(radius.Attributes(nil), ctx.Request.Packet.Attributes...)
+	if err := rfc2865.UserPassword_SetString(&packet, password); err != nil {
+		return radiuserrors.NewPasswordMismatchError()
+	}
+	request := ctx.Request.WithContext(ctx.Context)
+	request.Packet = &packet
+
+	authCtx := &auth.AuthContext{
+		Request:       request,
+		User:          ctx.User,
+		Nas:           ctx.NAS,
+		VendorRequest: ctx.VendorRequestForPlugin,
+		Metadata:      map[string]interface{}{},
+	}
+	if ctx.DryRun {
+		authCtx.Metadata[auth.MetadataDryRun] = true
+	}
+	err = s.validatePasswordWithPlugins(ctx.Context, authCtx, stored, nil)
+	for _, note := range auth.TakeNotes(authCtx) {
+		ctx.Note("%s", note)
+	}
+	if err != nil {
+		return err
+	}
+	if entry, ok := authCtx.Metadata[validators.MetadataLDAPEntry].(*ldapauth.Entry); ok && !ctx.DryRun {
+		// Compare with the stored profile, not a policy override
+		stored := ctx.loadedUser()
+		user, err := s.syncLDAPProfile(ctx.Context, stored, entry)
+		if err != nil {
+			return err
+		}
+		if user != stored {
+			ctx.User = user
+			return s.stagePolicy(ctx)
+		}
+	}
+	return nil
+}
+
+func (s *AuthService) totpStates() eap.EAPStateManager {
+	if s.eapHelper == nil {
+		return nil
+	}
+	return s.eapHelper.StateManager()
+}
+
+// splitTOTPSuffix splits a password ending in a code into both parts
+func splitTOTPSuffix(password string) (string, string, bool) {
+	if len(password) <= totp.Digits {
+		return "", "", false
+	}
+	cut := len(password) - totp.Digits
+	code := password[cut:]
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return "", "", false
+		}
+	}
+	return password[:cut], code, true
+}
@@ -0,0 +1,136 @@
+package radiusd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talkincode/toughradius/v9/internal/app"
+	"github.com/talkincode/toughradius/v9/internal/domain"
+	radiuserrors "github.com/talkincode/toughradius/v9/internal/radiusd/errors"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins"
+	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
+	"github.com/talkincode/toughradius/v9/pkg/totp"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+type recordingWriter struct {
+	packets []*radius.Packet
+}
+
+func (w *recordingWriter) Write(p *radius.Packet) error {
+	w.packets = append(w.packets, p)
+	return nil
+}
+
+func TestStageTOTP(t *testing.T) {
+	appCtx, _ := setupTestEnv(t)
+	defer appCtx.Release()
+
+	registry.ResetForTest()
+	t.Cleanup(registry.ResetForTest)
+	radiusService := NewRadiusService(appCtx)
+	defer radiusService.Release()
+	plugins.InitPlugins(appCtx, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
+	authService := NewAuthService(radiusService)
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	currentCode := func() string {
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		return code
+	}
+
+	writer := &recordingWriter{}
+	runStage := func(user *domain.RadiusUser, password, state string) (*AuthPipelineContext, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte("secret"))
+		_ = rfc2865.UserName_SetString(packet, user.Username) //nolint:errcheck
+		if password != "" {
+			_ = rfc2865.UserPassword_SetString(packet, password) //nolint:errcheck
+		}
+		if state != "" {
+			_ = rfc2865.State_SetString(packet, state) //nolint:errcheck
+		}
+		ctx := NewAuthPipelineContext(authService, writer, &radius.Request{Packet: packet})
+		ctx.Username = user.Username
+		ctx.User = user
+		return ctx, authService.stageTOTP(ctx)
+	}
+	rejectMetric := func(err error) string {
+		authErr, ok := radiuserrors.GetAuthError(err)
+		require.True(t, ok, "expected auth error, got %v", err)
+		return authErr.MetricsType
+	}
+	challenge := func(user *domain.RadiusUser) string {
+		ctx, err := runStage(user, "secret", "")
+		require.NoError(t, err)
+		assert.True(t, ctx.IsStopped())
+		resp := writer.packets[len(writer.packets)-1]
+		assert.Equal(t, radius.CodeAccessChallenge, resp.Code)
+		assert.Equal(t, totpChallengePrompt, rfc2865.ReplyMessage_GetString(resp))
+		state := rfc2865.State_GetString(resp)
+		require.NotEmpty(t, state)
+		return state
+	}
+
+	alice := &domain.RadiusUser{Username: "alice", Password: "secret", TotpSecret: secret}
+
+	// A wrong password is rejected before any challenge
+	_, err = runStage(alice, "wrong", "")
+	assert.Equal(t, app.MetricsRadiusRejectPasswdError, rejectMetric(err))
+	assert.Empty(t, writer.packets)
+
+	// A wrong code consumes the challenge
+	state := challenge(alice)
+	_, err = runStage(alice, "000000", state)
+	assert.Equal(t, app.MetricsRadiusRejectOtpError, rejectMetric(err))
+	_, err = runStage(alice, currentCode(), state)
+	assert.Equal(t, app.MetricsRadiusRejectOtpError, rejectMetric(err))
+
+	// The challenge belongs to the user it was issued to
+	bob := &domain.RadiusUser{Username: "bob", Password: "secret", TotpSecret: secret}
+	state = challenge(alice)
+	_, err = runStage(bob, currentCode(), state)
+	assert.Equal(t, app.MetricsRadiusRejectOtpError, rejectMetric(err))
+
+	// Two-step login
+	state = challenge(alice)
+	ctx, err := runStage(alice, currentCode(), state)
+	require.NoError(t, err)
+	assert.True(t, ctx.PasswordVerified)
+	assert.False(t, ctx.IsStopped())
+
+	// The code cannot be replayed
+	state = challenge(alice)
+	_, err = runStage(alice, currentCode(), state)
+	assert.Equal(t, app.MetricsRadiusRejectOtpError, rejectMetric(err))
+
+	// Password and code in one request
+	ctx, err = runStage(bob, "secret"+currentCode(), "")
+	require.NoError(t, err)
+	assert.True(t, ctx.PasswordVerified)
+	assert.False(t, ctx.IsStopped())
+
+	// Users without a secret are left to the password validators
+	carol := &domain.RadiusUser{Username: "carol", Password: "secret"}
+	sent := len(writer.packets)
+	ctx, err = runStage(carol, "secret", "")
+	require.NoError(t, err)
+	assert.False(t, ctx.PasswordVerified)
+	assert.Len(t, writer.packets, sent)
+}
+
+func TestSplitTOTPSuffix(t *testing.T) {
+	first, code, ok := splitTOTPSuffix("secret123456")
+	assert.True(t, ok)
+	assert.Equal(t, "secret", first)
+	assert.Equal(t, "123456", code)
+
+	_, _, ok = splitTOTPSuffix("123456")
+	assert.False(t, ok)
+	_, _, ok = splitTOTPSuffix("secret12345a")
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/talkincode/toughradius/v9/internal/radiusd/plugins/vendorparsers"
 	"github.com/talkincode/toughradius/v9/internal/radiusd/registry"
 	"go.uber.org/zap"
 	"layeh.com/radius"
@@ -64,9 +65,22 @@ func (s *RadiusService) ParseVendor(r *radius.Request, vendorCode string) *Vendo
 	}
 
 	// Convert toVendorRequest
-	return &VendorRequest{
-		MacAddr: vendorReq.MacAddr,
-		Vlanid1: vendorReq.Vlanid1,
-		Vlanid2: vendorReq.Vlanid2,
+	vr := &VendorRequest{
+		MacAddr:   vendorReq.MacAddr,
+		Vlanid1:   vendorReq.Vlanid1,
+		Vlanid2:   vendorReq.Vlanid2,
+		Ssid:      vendorReq.Ssid,
+		ApGroup:   vendorReq.ApGroup,
+		HostIP:    vendorReq.HostIP,
+		Realm:     vendorReq.Realm,
+		CircuitId: vendorReq.CircuitId,
+		RemoteId:  vendorReq.RemoteId,
 	}
+
+	// Most BRAS vendors relay the access line in the DSL Forum attributes,
+	// fill them in when the vendor parser has no equivalent of its own
+	if vr.CircuitId == "" && vr.RemoteId == "" {
+		vr.CircuitId, vr.RemoteId = vendorparsers.ParseAgentLineIds(r.Packet)
+	}
+	return vr
 }
@@ -12,7 +12,9 @@ const (
 	CodeF5        = "3375"
 	CodeIkuai     = "10055"
 	CodePfSense   = "13644"
+	CodeAruba     = "14823"
 	CodeMikrotik  = "14988"
+	CodeRuckus    = "25053"
 	CodeH3C       = "25506"
 	CodeHillstone = "28557"
 )
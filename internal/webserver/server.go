@@ -38,6 +38,7 @@ var JwtSkipPrefix = []string{
 	"/realip",
 	apiBasePath + "/auth/login",
 	apiBasePath + "/auth/refresh",
+	apiBasePath + "/self/", // Subscriber self-service, authenticated by the subscriber password
 }
 
 var server *AdminServer
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"runtime"
+	"strings"
 	_ "time/tzdata"
 
 	"github.com/talkincode/toughradius/v9/config"
@@ -14,6 +15,7 @@ import (
 	"github.com/talkincode/toughradius/v9/internal/radiusd"
 	"github.com/talkincode/toughradius/v9/internal/webserver"
 	"github.com/talkincode/toughradius/v9/pkg/common"
+	"github.com/talkincode/toughradius/v9/pkg/passwd"
 	"golang.org/x/sync/errgroup"
 
 	// Import vendor parsers for auto-registration via init()
@@ -37,6 +39,11 @@ var (
 	conffile = flag.String("c", "", "config yaml file")
 	initdb   = flag.Bool("initdb", false, "run initdb")
 	printcfg = flag.Bool("printcfg", false, "print config")
+	migPwd   = flag.String("migrate-passwords", "", "convert cleartext user passwords to a scheme: nt-hash, bcrypt or sha512-crypt")
+	migrate  = flag.String("migrate", "", "run schema migrations: up, down (reverts the latest) or status")
+	doBackup = flag.Bool("backup", false, "take a backup of the database into the backup directory")
+	restore  = flag.String("restore", "", "restore a backup file into the database")
+	tables   = flag.String("restore-tables", "", "comma separated tables to restore, default all")
 )
 
 func PrintVersion() {
@@ -72,6 +79,34 @@ func main() {
 		return
 	}
 
+	if *migrate != "" {
+		if err := app.RunMigrations(_config, *migrate, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *doBackup {
+		if err := app.RunBackup(_config, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *restore != "" {
+		var names []string
+		if *tables != "" {
+			names = strings.Split(*tables, ",")
+			for i := range names {
+				names[i] = strings.TrimSpace(names[i])
+			}
+		}
+		if err := app.RunRestore(_config, *restore, names, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// Create and initialize application context
 	application := app.NewApplication(_config)
 	application.Init(_config)
@@ -80,6 +115,19 @@ func main() {
 		application.InitDb()
 		return
 	}
+
+	if *migPwd != "" {
+		scheme, err := passwd.ParseScheme(*migPwd)
+		if err != nil {
+			log.Fatal(err)
+		}
+		count, err := application.MigratePasswords(scheme)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%d user passwords converted to %s\n", count, scheme)
+		return
+	}
 	defer application.Release()
 
 	// Initialize web server and admin API with dependency injection
@@ -89,12 +137,23 @@ func main() {
 		return webserver.Listen(application)
 	})
 
+	// Load FreeRADIUS dictionaries for attribute names and custom attributes
+	radiusd.LoadDictionary(_config)
+
 	// Initialize RADIUS service with dependency injection
 	radiusService := radiusd.NewRadiusService(application)
 	defer radiusService.Release()
 
 	// Initialize plugin system after RadiusService is created
-	plugins.InitPlugins(application, radiusService.SessionRepo, radiusService.AccountingRepo)
+	plugins.InitPlugins(application, radiusService.SessionRepo, radiusService.AccountingRepo, radiusService.DeviceRepo, radiusService, radiusService.TOTPVerifier)
+
+	// Answer authorization dry runs of the admin API with the live pipeline
+	adminapi.SetAuthSimulator(radiusd.NewAuthService(radiusService))
+	// One-time codes of self-service requests and RADIUS logins are used once
+	adminapi.SetTOTPVerifier(radiusService.TOTPVerifier)
+
+	// Push rate band changes to online sessions via CoA
+	radiusService.StartRateScheduler()
 
 	// Start RADIUS Auth server
 	g.Go(func() error {
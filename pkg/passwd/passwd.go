@@ -0,0 +1,172 @@
+// Package passwd stores subscriber passwords in one of several schemes and
+// verifies them.
+//
+// Stored values are self-describing:
+//
+//	cleartext      secret, or {CLEAR}$6$secret when it looks like a hash
+//	nt-hash        {NT}8846f7eaee8fb117ad06bdd830b7586c
+//	bcrypt         $2a$10$...
+//	sha512-crypt   $6$salt$...
+//
+// Which RADIUS methods can authenticate a user depends on the scheme: CHAP and
+// EAP-MD5 need the cleartext, MS-CHAP and MS-CHAPv2 the NT hash, and bcrypt and
+// SHA-512-crypt only allow PAP (including EAP-TTLS/PAP).
+//
+// A cleartext password that starts like one of the hashes is stored with the
+// {CLEAR} tag, so a password such as "$6$..." is never taken for a hash.
+package passwd
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"layeh.com/radius/rfc2759"
+)
+
+// Scheme identifies how a password is stored.
+type Scheme string
+
+const (
+	SchemeCleartext   Scheme = "cleartext"
+	SchemeNTHash      Scheme = "nt-hash"
+	SchemeBcrypt      Scheme = "bcrypt"
+	SchemeSHA512Crypt Scheme = "sha512-crypt"
+)
+
+const (
+	ntHashPrefix = "{NT}"
+	clearPrefix  = "{CLEAR}"
+)
+
+// Schemes lists every supported scheme.
+var Schemes = []Scheme{SchemeCleartext, SchemeNTHash, SchemeBcrypt, SchemeSHA512Crypt}
+
+// ParseScheme validates a scheme name. An empty name means cleartext.
+func ParseScheme(name string) (Scheme, error) {
+	if strings.TrimSpace(name) == "" {
+		return SchemeCleartext, nil
+	}
+	for _, s := range Schemes {
+		if strings.EqualFold(name, string(s)) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported password scheme %q", name)
+}
+
+// Identify returns the scheme of a stored password.
+func Identify(stored string) Scheme {
+	switch {
+	case strings.HasPrefix(stored, clearPrefix):
+		return SchemeCleartext
+	case len(stored) == len(ntHashPrefix)+32 && strings.EqualFold(stored[:len(ntHashPrefix)], ntHashPrefix) && isHex(stored[len(ntHashPrefix):]):
+		return SchemeNTHash
+	case strings.HasPrefix(stored, "$2a$"), strings.HasPrefix(stored, "$2b$"), strings.HasPrefix(stored, "$2y$"):
+		return SchemeBcrypt
+	case strings.HasPrefix(stored, sha512CryptPrefix):
+		return SchemeSHA512Crypt
+	default:
+		return SchemeCleartext
+	}
+}
+
+// Hash encodes a cleartext password with the given scheme.
+func Hash(scheme Scheme, password string) (string, error) {
+	switch scheme {
+	case SchemeCleartext:
+		if Identify(password) != SchemeCleartext || strings.HasPrefix(password, clearPrefix) {
+			return clearPrefix + password, nil
+		}
+		return password, nil
+	case SchemeNTHash:
+		hash, err := ntHash(password)
+		if err != nil {
+			return "", err
+		}
+		return ntHashPrefix + hex.EncodeToString(hash), nil
+	case SchemeBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	case SchemeSHA512Crypt:
+		salt, err := randomSalt(sha512SaltLen)
+		if err != nil {
+			return "", err
+		}
+		return sha512Crypt([]byte(password), sha512CryptPrefix+salt)
+	default:
+		return "", fmt.Errorf("unsupported password scheme %q", scheme)
+	}
+}
+
+// Verify reports whether password matches the stored value.
+func Verify(stored, password string) bool {
+	switch Identify(stored) {
+	case SchemeNTHash:
+		want, _ := NTHash(stored)
+		got, err := ntHash(password)
+		return err == nil && subtle.ConstantTimeCompare(want, got) == 1
+	case SchemeBcrypt:
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil
+	case SchemeSHA512Crypt:
+		got, err := sha512Crypt([]byte(password), stored)
+		return err == nil && subtle.ConstantTimeCompare([]byte(stored), []byte(got)) == 1
+	default:
+		plain, _ := Cleartext(stored)
+		return subtle.ConstantTimeCompare([]byte(plain), []byte(password)) == 1
+	}
+}
+
+// Cleartext returns the stored password when it is kept in cleartext.
+func Cleartext(stored string) (string, bool) {
+	if Identify(stored) != SchemeCleartext {
+		return "", false
+	}
+	return strings.TrimPrefix(stored, clearPrefix), true
+}
+
+// NTHash returns the NT password hash, MD4 of the UTF-16LE password, of a
+// cleartext or nt-hash stored password.
+func NTHash(stored string) ([]byte, bool) {
+	switch Identify(stored) {
+	case SchemeNTHash:
+		hash, err := hex.DecodeString(stored[len(ntHashPrefix):])
+		return hash, err == nil
+	case SchemeCleartext:
+		plain, _ := Cleartext(stored)
+		hash, err := ntHash(plain)
+		return hash, err == nil
+	default:
+		return nil, false
+	}
+}
+
+func ntHash(password string) ([]byte, error) {
+	ucs2, err := rfc2759.ToUTF16([]byte(password))
+	if err != nil {
+		return nil, err
+	}
+	return rfc2759.NTPasswordHash(ucs2), nil
+}
+
+func randomSalt(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = cryptAlphabet[b&0x3f]
+	}
+	return string(buf), nil
+}
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,128 @@
+package passwd
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSHA512CryptVectors(t *testing.T) {
+	// Test vectors from the SHA-crypt specification
+	tests := []struct {
+		setting  string
+		password string
+		expected string
+	}{
+		{"$6$saltstring", "Hello world!", "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1"},
+		{"$6$rounds=10000$saltstringsaltstring", "Hello world!", "$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v."},
+		{"$6$rounds=5000$toolongsaltstring", "This is just a test", "$6$rounds=5000$toolongsaltstrin$lQ8jolhgVRVhY4b5pZKaysCLi0QBxGoNeKQzQ3glMhwllF7oGDZxUhx1yxdYcz/e1JSbq3y6JMxxl8audkUEm0"},
+		{"$6$rounds=10$roundstoolow", "the minimum number is still observed", "$6$rounds=1000$roundstoolow$kUMsbe306n21p9R.FRkW3IGn.S9NPN0x50YhH1xhLsPuWGsUSklZt58jaTfF4ZEQpyUNGc0dqbpBYYBaHHrsX."},
+	}
+	for _, tt := range tests {
+		got, err := sha512Crypt([]byte(tt.password), tt.setting)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, got)
+		assert.True(t, Verify(tt.expected, tt.password))
+	}
+}
+
+func TestHashAndVerify(t *testing.T) {
+	for _, scheme := range Schemes {
+		t.Run(string(scheme), func(t *testing.T) {
+			stored, err := Hash(scheme, "s3cret!")
+			require.NoError(t, err)
+			assert.Equal(t, scheme, Identify(stored))
+			assert.True(t, Verify(stored, "s3cret!"))
+			assert.False(t, Verify(stored, "s3cret"))
+			assert.False(t, Verify(stored, ""))
+		})
+	}
+}
+
+func TestNTHash(t *testing.T) {
+	stored, err := Hash(SchemeNTHash, "password")
+	require.NoError(t, err)
+	assert.Equal(t, "{NT}8846f7eaee8fb117ad06bdd830b7586c", stored)
+
+	hash, ok := NTHash(stored)
+	require.True(t, ok)
+	assert.Equal(t, "8846f7eaee8fb117ad06bdd830b7586c", hex.EncodeToString(hash))
+
+	hash, ok = NTHash("password")
+	require.True(t, ok)
+	assert.Equal(t, "8846f7eaee8fb117ad06bdd830b7586c", hex.EncodeToString(hash))
+
+	bcryptHash, err := Hash(SchemeBcrypt, "password")
+	require.NoError(t, err)
+	_, ok = NTHash(bcryptHash)
+	assert.False(t, ok)
+}
+
+func TestIdentify(t *testing.T) {
+	tests := []struct {
+		stored   string
+		expected Scheme
+	}{
+		{"secret", SchemeCleartext},
+		{"", SchemeCleartext},
+		{"{nt}8846F7EAEE8FB117AD06BDD830B7586C", SchemeNTHash},
+		{"{NT}not-a-hash", SchemeCleartext},
+		{"$2y$10$" + strings.Repeat("a", 53), SchemeBcrypt},
+		{"$6$salt$hash", SchemeSHA512Crypt},
+		{"{CLEAR}$6$salt$hash", SchemeCleartext},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Identify(tt.stored), tt.stored)
+	}
+}
+
+func TestCleartext(t *testing.T) {
+	plain, ok := Cleartext("secret")
+	assert.True(t, ok)
+	assert.Equal(t, "secret", plain)
+
+	_, ok = Cleartext("{NT}8846f7eaee8fb117ad06bdd830b7586c")
+	assert.False(t, ok)
+}
+
+func TestHashCleartextLookingLikeHash(t *testing.T) {
+	for _, password := range []string{"$2a$10$" + strings.Repeat("a", 53), "$6$salt$hash", "{NT}8846f7eaee8fb117ad06bdd830b7586c", "{CLEAR}secret"} {
+		stored, err := Hash(SchemeCleartext, password)
+		require.NoError(t, err)
+		assert.Equal(t, "{CLEAR}"+password, stored)
+		assert.Equal(t, SchemeCleartext, Identify(stored))
+		assert.True(t, Verify(stored, password))
+		assert.False(t, Verify(stored, stored))
+
+		plain, ok := Cleartext(stored)
+		assert.True(t, ok)
+		assert.Equal(t, password, plain)
+
+		want, err := ntHash(password)
+		require.NoError(t, err)
+		hash, ok := NTHash(stored)
+		assert.True(t, ok)
+		assert.Equal(t, want, hash)
+	}
+
+	// Other cleartext passwords are stored as is
+	stored, err := Hash(SchemeCleartext, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", stored)
+}
+
+func TestParseScheme(t *testing.T) {
+	scheme, err := ParseScheme("")
+	require.NoError(t, err)
+	assert.Equal(t, SchemeCleartext, scheme)
+
+	scheme, err = ParseScheme("BCRYPT")
+	require.NoError(t, err)
+	assert.Equal(t, SchemeBcrypt, scheme)
+
+	_, err = ParseScheme("md5")
+	assert.Error(t, err)
+}
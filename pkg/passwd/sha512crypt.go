@@ -0,0 +1,148 @@
+package passwd
+
+import (
+	"crypto/sha512"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SHA-512-crypt as specified in https://www.akkadia.org/drepper/SHA-crypt.txt
+
+const (
+	sha512CryptPrefix  = "$6$"
+	sha512RoundsPrefix = "rounds="
+	sha512SaltLen      = 16
+	sha512RoundsDef    = 5000
+	sha512RoundsMin    = 1000
+	sha512RoundsMax    = 999999999
+	cryptAlphabet      = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var errInvalidSHA512Crypt = errors.New("invalid sha512-crypt hash")
+
+// sha512CryptEncodeOrder lists the digest bytes packed into each group of
+// four output characters.
+var sha512CryptEncodeOrder = [][3]int{
+	{0, 21, 42}, {22, 43, 1}, {44, 2, 23}, {3, 24, 45}, {25, 46, 4},
+	{47, 5, 26}, {6, 27, 48}, {28, 49, 7}, {50, 8, 29}, {9, 30, 51},
+	{31, 52, 10}, {53, 11, 32}, {12, 33, 54}, {34, 55, 13}, {56, 14, 35},
+	{15, 36, 57}, {37, 58, 16}, {59, 17, 38}, {18, 39, 60}, {40, 61, 19},
+	{62, 20, 41},
+}
+
+// sha512Crypt hashes key with the salt and rounds settings of setting, which
+// is either a full hash or just its "$6$[rounds=N$]salt" prefix.
+func sha512Crypt(key []byte, setting string) (string, error) {
+	if !strings.HasPrefix(setting, sha512CryptPrefix) {
+		return "", errInvalidSHA512Crypt
+	}
+	rest := setting[len(sha512CryptPrefix):]
+
+	rounds, customRounds := sha512RoundsDef, false
+	if strings.HasPrefix(rest, sha512RoundsPrefix) {
+		end := strings.IndexByte(rest, '$')
+		if end < 0 {
+			return "", errInvalidSHA512Crypt
+		}
+		n, err := strconv.Atoi(rest[len(sha512RoundsPrefix):end])
+		if err != nil {
+			return "", errInvalidSHA512Crypt
+		}
+		rounds = min(max(n, sha512RoundsMin), sha512RoundsMax)
+		customRounds = true
+		rest = rest[end+1:]
+	}
+
+	salt := rest
+	if end := strings.IndexByte(salt, '$'); end >= 0 {
+		salt = salt[:end]
+	}
+	if len(salt) > sha512SaltLen {
+		salt = salt[:sha512SaltLen]
+	}
+	s := []byte(salt)
+
+	alt := sha512.New()
+	alt.Write(key)
+	alt.Write(s)
+	alt.Write(key)
+	altSum := alt.Sum(nil)
+
+	a := sha512.New()
+	a.Write(key)
+	a.Write(s)
+	for n := len(key); n > 0; n -= 64 {
+		a.Write(altSum[:min(n, 64)])
+	}
+	for n := len(key); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			a.Write(altSum)
+		} else {
+			a.Write(key)
+		}
+	}
+	sum := a.Sum(nil)
+
+	dp := sha512.New()
+	for range key {
+		dp.Write(key)
+	}
+	p := repeatTo(dp.Sum(nil), len(key))
+
+	ds := sha512.New()
+	for i := 0; i < 16+int(sum[0]); i++ {
+		ds.Write(s)
+	}
+	sp := repeatTo(ds.Sum(nil), len(s))
+
+	for i := 0; i < rounds; i++ {
+		c := sha512.New()
+		if i&1 != 0 {
+			c.Write(p)
+		} else {
+			c.Write(sum)
+		}
+		if i%3 != 0 {
+			c.Write(sp)
+		}
+		if i%7 != 0 {
+			c.Write(p)
+		}
+		if i&1 != 0 {
+			c.Write(sum)
+		} else {
+			c.Write(p)
+		}
+		sum = c.Sum(nil)
+	}
+
+	var b strings.Builder
+	b.WriteString(sha512CryptPrefix)
+	if customRounds {
+		b.WriteString(sha512RoundsPrefix + strconv.Itoa(rounds) + "$")
+	}
+	b.WriteString(salt)
+	b.WriteByte('$')
+	for _, g := range sha512CryptEncodeOrder {
+		encode24(&b, sum[g[0]], sum[g[1]], sum[g[2]], 4)
+	}
+	encode24(&b, 0, 0, sum[63], 2)
+	return b.String(), nil
+}
+
+func repeatTo(src []byte, n int) []byte {
+	out := make([]byte, n)
+	for i := 0; i < n; i += len(src) {
+		copy(out[i:], src)
+	}
+	return out
+}
+
+func encode24(b *strings.Builder, b2, b1, b0 byte, n int) {
+	w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+	for ; n > 0; n-- {
+		b.WriteByte(cryptAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
@@ -0,0 +1,155 @@
+// Package totp implements RFC 6238 time-based one-time passwords with the
+// parameters every authenticator app understands: HMAC-SHA1, 30 second steps
+// and 6 digits. Secrets are exchanged as unpadded base32 strings.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 default, required by authenticator apps
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Digits is the length of a code
+	Digits = 6
+	// Period is the lifetime of a time step
+	Period = 30 * time.Second
+	// Skew is the number of steps accepted before and after the current one
+	Skew = 1
+
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random 160-bit secret.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// ParseSecret decodes a base32 secret, ignoring case, spaces and padding.
+func ParseSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(normalized, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid totp secret: empty")
+	}
+	return key, nil
+}
+
+// Counter returns the time step containing t.
+func Counter(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns the code of secret for the time step containing t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := ParseSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, Counter(t)), nil
+}
+
+// Validate checks code against the steps around t and returns the matched
+// step. Callers that must refuse replays remember the step, see Verifier.
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+	key, err := ParseSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	now := Counter(t)
+	for counter := now - Skew; counter <= now+Skew; counter++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, counter)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// URI returns the otpauth:// provisioning URI rendered as QR code by
+// authenticator apps.
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	query := url.Values{}
+	query.Set("secret", secret)
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period/time.Second)))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Verifier validates codes and refuses a code whose time step was already
+// used by the same account, so an observed code cannot be replayed.
+type Verifier struct {
+	mu   sync.Mutex
+	used map[string]int64
+	now  func() time.Time
+}
+
+// NewVerifier creates a Verifier using the system clock.
+func NewVerifier() *Verifier {
+	return &Verifier{used: make(map[string]int64), now: time.Now}
+}
+
+// Verify reports whether code is valid for secret and not yet used by account.
+func (v *Verifier) Verify(account, secret, code string) bool {
+	now := v.now()
+	counter, ok := Validate(secret, code, now)
+	if !ok {
+		return false
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if last, seen := v.used[account]; seen && counter <= last {
+		return false
+	}
+	v.used[account] = counter
+
+	// Forget steps that can no longer be replayed
+	oldest := Counter(now) - Skew
+	for name, last := range v.used {
+		if last < oldest {
+			delete(v.used, name)
+		}
+	}
+	return true
+}
+
+// hotp computes the RFC 4226 code for counter.
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter)) //nolint:gosec // time steps are positive
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
@@ -0,0 +1,108 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Base32 of the RFC 6238 SHA1 test key "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeVectors(t *testing.T) {
+	// RFC 6238 Appendix B, truncated to 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		code, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "time %d", tt.unix)
+	}
+
+	// Secrets are accepted in lower case, with spaces and padding
+	code, err := Code("gezd gnbv gy3t qojq gezd gnbv gy3t qojq==", time.Unix(59, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+
+	_, err = Code("not base32!", time.Now())
+	assert.Error(t, err)
+	_, err = Code("", time.Now())
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	counter, ok := Validate(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, Counter(now), counter)
+
+	// One step of clock drift is tolerated in both directions
+	_, ok = Validate(rfcSecret, code, now.Add(Period))
+	assert.True(t, ok)
+	_, ok = Validate(rfcSecret, code, now.Add(-Period))
+	assert.True(t, ok)
+	_, ok = Validate(rfcSecret, code, now.Add(3*Period))
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "000000", now)
+	assert.False(t, ok)
+	_, ok = Validate(rfcSecret, code[:5], now)
+	assert.False(t, ok)
+	_, ok = Validate("", code, now)
+	assert.False(t, ok)
+}
+
+func TestVerifierRefusesReplay(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	v := NewVerifier()
+	v.now = func() time.Time { return now }
+
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	assert.True(t, v.Verify("alice", rfcSecret, code))
+	assert.False(t, v.Verify("alice", rfcSecret, code), "replayed code")
+	assert.True(t, v.Verify("bob", rfcSecret, code), "steps are tracked per account")
+
+	// An older code is refused once a newer one was used
+	previous, err := Code(rfcSecret, now.Add(-Period))
+	require.NoError(t, err)
+	assert.False(t, v.Verify("alice", rfcSecret, previous))
+
+	now = now.Add(Period)
+	next, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+	assert.True(t, v.Verify("alice", rfcSecret, next))
+}
+
+func TestGenerateSecretAndURI(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	key, err := ParseSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, secretSize)
+
+	uri := URI("ToughRADIUS", "alice@example.com", secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/ToughRADIUS:alice@example.com?"))
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, secret, parsed.Query().Get("secret"))
+	assert.Equal(t, "ToughRADIUS", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
@@ -0,0 +1,10 @@
+// Package share embeds the bundled FreeRADIUS dictionaries so the RADIUS
+// service can decode vendor attributes without a dictionary directory on disk.
+package share
+
+import "embed"
+
+// Dictionaries holds the "dictionary" root file and every file it includes.
+//
+//go:embed dictionary dictionary.*
+var Dictionaries embed.FS